@@ -0,0 +1,77 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blob
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+const TableBlob = "blob"
+
+// blob is the record of DbStore.
+type blob struct {
+	BlobKey     string `gorm:"primary_key"`
+	ContentType string `gorm:"type:varchar(100);not null"`
+	Data        []byte `gorm:"type:mediumblob"`
+	UpdateTime  time.Time
+}
+
+func (blob) TableName() string {
+	return TableBlob
+}
+
+// DbStore saves blobs in the blob table of the database.
+type DbStore struct {
+	db *gorm.DB
+}
+
+func NewDbStore(db *gorm.DB) *DbStore {
+	return &DbStore{db: db}
+}
+
+func (s *DbStore) Put(ctx context.Context, key string, b *Blob) error {
+	return s.db.Save(&blob{
+		BlobKey:     key,
+		ContentType: b.ContentType,
+		Data:        b.Data,
+		UpdateTime:  b.UpdateTime,
+	}).Error
+}
+
+func (s *DbStore) Get(ctx context.Context, key string) (*Blob, error) {
+	var record blob
+	if err := s.db.Where("blob_key = ?", key).Take(&record).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &Blob{
+		Data:        record.Data,
+		ContentType: record.ContentType,
+		UpdateTime:  record.UpdateTime,
+	}, nil
+}
+
+func (s *DbStore) Delete(ctx context.Context, key string) error {
+	return s.db.Where("blob_key = ?", key).Delete(&blob{}).Error
+}
+
+var _ Store = (*DbStore)(nil)
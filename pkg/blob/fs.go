@@ -0,0 +1,121 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+)
+
+var reKey = regexp.MustCompile(`^[a-zA-Z0-9_-]+(/[a-zA-Z0-9_-]+)*$`)
+
+// FsStore saves a blob as a file under the directory and its content type
+// in a sidecar file with the ".meta" suffix.
+type FsStore struct {
+	dir string
+}
+
+type fsMeta struct {
+	ContentType string    `json:"content_type"`
+	UpdateTime  time.Time `json:"update_time"`
+}
+
+func NewFsStore(dir string) *FsStore {
+	return &FsStore{dir: dir}
+}
+
+func (s *FsStore) getPath(key string) (string, error) {
+	if !reKey.MatchString(key) {
+		return "", fmt.Errorf("invalid blob key [%s]", key)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
+
+func (s *FsStore) Put(ctx context.Context, key string, blob *Blob) error {
+	path, err := s.getPath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	meta, err := json.Marshal(fsMeta{ContentType: blob.ContentType, UpdateTime: blob.UpdateTime})
+	if err != nil {
+		return err
+	}
+	// write to temporary files first, so readers never see a partial blob
+	if err := ioutil.WriteFile(path+".tmp", blob.Data, 0600); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(path+".meta.tmp", meta, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return err
+	}
+	return os.Rename(path+".meta.tmp", path+".meta")
+}
+
+func (s *FsStore) Get(ctx context.Context, key string) (*Blob, error) {
+	path, err := s.getPath(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	var meta fsMeta
+	content, err := ioutil.ReadFile(path + ".meta")
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if len(content) > 0 {
+		if err := json.Unmarshal(content, &meta); err != nil {
+			return nil, err
+		}
+	}
+	return &Blob{
+		Data:        data,
+		ContentType: meta.ContentType,
+		UpdateTime:  meta.UpdateTime,
+	}, nil
+}
+
+func (s *FsStore) Delete(ctx context.Context, key string) error {
+	path, err := s.getPath(key)
+	if err != nil {
+		return err
+	}
+	for _, p := range []string{path, path + ".meta"} {
+		if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+var _ Store = (*FsStore)(nil)
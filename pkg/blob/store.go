@@ -0,0 +1,60 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+
+	"kubesphere.io/im/pkg/config"
+)
+
+const (
+	StoreDb = "db"
+	StoreFs = "fs"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+type Blob struct {
+	Data        []byte
+	ContentType string
+	UpdateTime  time.Time
+}
+
+// Store keeps binary objects such as avatars by key,
+// a key is a slash separated path such as "avatar/uid-xxx".
+type Store interface {
+	Put(ctx context.Context, key string, blob *Blob) error
+	// Get returns ErrNotFound if the key does not exist.
+	Get(ctx context.Context, key string) (*Blob, error)
+	Delete(ctx context.Context, key string) error
+}
+
+func NewStore(cfg config.BlobConfig, db *gorm.DB) (Store, error) {
+	switch cfg.Store {
+	case StoreDb, "":
+		return NewDbStore(db), nil
+	case StoreFs:
+		return NewFsStore(cfg.Dir), nil
+	}
+	return nil, fmt.Errorf("unsupported blob store [%s]", cfg.Store)
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blob
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"github.com/stretchr/testify/require"
+
+	"kubesphere.io/im/pkg/config"
+)
+
+func TestFsStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "blob")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := NewStore(config.BlobConfig{Store: StoreFs, Dir: dir}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = store.Get(ctx, "avatar/uid-1")
+	require.Equal(t, ErrNotFound, err)
+
+	now := time.Now().Round(time.Second)
+	require.NoError(t, store.Put(ctx, "avatar/uid-1", &Blob{Data: []byte("png"), ContentType: "image/png", UpdateTime: now}))
+	b, err := store.Get(ctx, "avatar/uid-1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("png"), b.Data)
+	require.Equal(t, "image/png", b.ContentType)
+	require.True(t, now.Equal(b.UpdateTime))
+
+	require.NoError(t, store.Delete(ctx, "avatar/uid-1"))
+	_, err = store.Get(ctx, "avatar/uid-1")
+	require.Equal(t, ErrNotFound, err)
+	require.NoError(t, store.Delete(ctx, "avatar/uid-1"))
+
+	require.Error(t, store.Put(ctx, "../escape", &Blob{Data: []byte("x")}))
+}
+
+func TestDbStore(t *testing.T) {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	// every connection opens another in-memory database
+	db.DB().SetMaxOpenConns(1)
+	require.NoError(t, db.AutoMigrate(&blob{}).Error)
+
+	store, err := NewStore(config.BlobConfig{Store: StoreDb}, db)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = store.Get(ctx, "avatar/uid-1")
+	require.Equal(t, ErrNotFound, err)
+
+	now := time.Now().Round(time.Second)
+	require.NoError(t, store.Put(ctx, "avatar/uid-1", &Blob{Data: []byte("png"), ContentType: "image/png", UpdateTime: now}))
+	require.NoError(t, store.Put(ctx, "avatar/uid-2", &Blob{Data: []byte("gif"), ContentType: "image/gif", UpdateTime: now}))
+	b, err := store.Get(ctx, "avatar/uid-1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("png"), b.Data)
+	require.Equal(t, "image/png", b.ContentType)
+	require.True(t, now.Equal(b.UpdateTime))
+
+	// a put replaces the blob
+	require.NoError(t, store.Put(ctx, "avatar/uid-1", &Blob{Data: []byte("jpg"), ContentType: "image/jpeg", UpdateTime: now}))
+	b, err = store.Get(ctx, "avatar/uid-1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("jpg"), b.Data)
+	require.Equal(t, "image/jpeg", b.ContentType)
+
+	require.NoError(t, store.Delete(ctx, "avatar/uid-1"))
+	_, err = store.Get(ctx, "avatar/uid-1")
+	require.Equal(t, ErrNotFound, err)
+	require.NoError(t, store.Delete(ctx, "avatar/uid-1"))
+
+	// other blobs are kept
+	b, err = store.Get(ctx, "avatar/uid-2")
+	require.NoError(t, err)
+	require.Equal(t, []byte("gif"), b.Data)
+}
+
+func TestUnsupportedStore(t *testing.T) {
+	_, err := NewStore(config.BlobConfig{Store: "unknown"}, nil)
+	require.Error(t, err)
+}
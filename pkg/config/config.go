@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/BurntSushi/toml"
 	"github.com/koding/multiconfig"
@@ -32,7 +33,17 @@ import (
 const EnvPrefix = "IM"
 
 type Config struct {
-	DB DBConfig
+	DB           DBConfig
+	Sms          SmsConfig
+	Smtp         SmtpConfig
+	Notifier     NotifierConfig
+	Blob         BlobConfig
+	Avatar       AvatarConfig
+	Login        LoginConfig
+	Inactivity   InactivityConfig
+	DynamicGroup DynamicGroupConfig
+	Membership   MembershipConfig
+	GroupLimit   GroupLimitConfig
 
 	Host        string `default:"im-service"`
 	Port        int    `default:"9119"`
@@ -40,6 +51,24 @@ type Config struct {
 	TlsCertFile string `default:"server.cert"`
 	TlsKeyFile  string `default:"server.key"`
 	LogLevel    string `default:"DEBUG"`
+
+	// UsernameReusePolicy controls whether the username and email of a
+	// deleted user can be taken by a new user: "after_delete" or "never".
+	UsernameReusePolicy string `default:"after_delete"`
+
+	// UniqueGroupNames rejects a group named after an active sibling,
+	// so that a name path identifies one group
+	UniqueGroupNames bool `default:"true"`
+
+	EmailVerificationExpire time.Duration `default:"24h"`
+	InviteExpire            time.Duration `default:"72h"`
+
+	// phone numbers without international prefix belong to this country
+	PhoneDefaultCountryCode string        `default:"86"`
+	PhoneCodeLength         int           `default:"6"`
+	PhoneCodeExpire         time.Duration `default:"5m"`
+	PhoneCodeInterval       time.Duration `default:"1m"`
+	PhoneCodeMaxAttempts    int           `default:"5"`
 }
 
 type DBConfig struct {
@@ -52,6 +81,90 @@ type DBConfig struct {
 	LogModeEnable bool   `default:"false"`
 }
 
+type SmsConfig struct {
+	Sender string `default:"log"` // log or file
+	File   string `default:"/tmp/im-sms.log"`
+}
+
+type SmtpConfig struct {
+	Host     string `default:"localhost"`
+	Port     int    `default:"25"`
+	Username string `default:""`
+	Password string `default:""`
+	From     string `default:"noreply@kubesphere.io"`
+
+	// Timeout bounds the connection and the whole SMTP session
+	Timeout time.Duration `default:"30s"`
+}
+
+type NotifierConfig struct {
+	// TemplateDir overrides the built-in templates, a template is
+	// looked up as <TemplateDir>/<locale>/<name>.tmpl
+	TemplateDir   string `default:""`
+	DefaultLocale string `default:"en"`
+
+	PollInterval    time.Duration `default:"10s"`
+	BatchSize       int           `default:"100"`
+	RetryBackoff    time.Duration `default:"30s"`
+	MaxRetryBackoff time.Duration `default:"1h"`
+	MaxAttempts     int           `default:"8"`
+}
+
+type BlobConfig struct {
+	Store string `default:"db"` // db or fs
+	Dir   string `default:"/tmp/im-blob"`
+}
+
+type AvatarConfig struct {
+	MaxSize      int    `default:"1048576"`
+	ContentTypes string `default:"image/png,image/jpeg,image/gif,image/webp"` // comma separated
+}
+
+type LoginConfig struct {
+	// HistoryRetention is how long the login history is kept, 0 keeps it forever
+	HistoryRetention     time.Duration `default:"2160h"`
+	HistoryCleanInterval time.Duration `default:"1h"`
+	// TrustedProxies are the gateways whose x-forwarded-for is used as the client ip
+	// of the login history, comma separated ip addresses or cidr blocks
+	TrustedProxies string `default:""`
+}
+
+type InactivityConfig struct {
+	// Threshold disables the active users that have not logged in for the duration,
+	// the creation time is used if the user never logged in, 0 turns the policy off
+	Threshold time.Duration `default:"0"`
+	// WarnBefore sends a warning mail the duration before the user is disabled, 0 sends no warning
+	WarnBefore    time.Duration `default:"168h"`
+	CheckInterval time.Duration `default:"1h"`
+}
+
+type DynamicGroupConfig struct {
+	// ReconcileInterval recomputes the members of all dynamic groups periodically,
+	// besides the updates when a user is created or modified
+	ReconcileInterval time.Duration `default:"1h"`
+}
+
+// GroupLimitConfig is the default limits of groups, a group may override them, 0 means no limit.
+type GroupLimitConfig struct {
+	// MaxMembers limits the users bound to a group directly, dynamic groups excepted
+	MaxMembers int `default:"10000"`
+	// MaxChildGroups limits the direct sub groups of a group
+	MaxChildGroups int `default:"1000"`
+	// MaxDepth limits the levels of a group tree, the root included
+	MaxDepth int `default:"20"`
+}
+
+type MembershipConfig struct {
+	// SweepInterval removes the expired group memberships periodically
+	SweepInterval time.Duration `default:"1m"`
+	// MaxNestingDepth limits the levels of nested member groups followed by the
+	// transitive membership check, 0 means no limit
+	MaxNestingDepth int `default:"10"`
+	// NestedGroupCacheTTL keeps the nested member groups of a group for the transitive
+	// membership check, the changes made through other instances show up after it
+	NestedGroupCacheTTL time.Duration `default:"10s"`
+}
+
 func (m *Config) Clone() *Config {
 	q := *m
 	return &q
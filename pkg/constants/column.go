@@ -33,21 +33,102 @@ const (
 	ColumnGroupPathLevel = "group_path_level"
 	ColumnDescription    = "description"
 	ColumnExtra          = "extra"
+
+	ColumnEmailVerified      = "email_verified"
+	ColumnEmailVerifyTime    = "email_verify_time"
+	ColumnId                 = "id"
+	ColumnKind               = "kind"
+	ColumnConsumeTime        = "consume_time"
+	ColumnTokenHash          = "token_hash"
+	ColumnPhoneVerified      = "phone_verified"
+	ColumnPhoneVerifyTime    = "phone_verify_time"
+	ColumnAttempts           = "attempts"
+	ColumnNotificationId     = "notification_id"
+	ColumnNextAttemptTime    = "next_attempt_time"
+	ColumnLastError          = "last_error"
+	ColumnSendTime           = "send_time"
+	ColumnInviteId           = "invite_id"
+	ColumnAttributeId        = "attribute_id"
+	ColumnTarget             = "target"
+	ColumnName               = "name"
+	ColumnDisplayName        = "display_name"
+	ColumnGivenName          = "given_name"
+	ColumnFamilyName         = "family_name"
+	ColumnLocale             = "locale"
+	ColumnTimezone           = "timezone"
+	ColumnAvatarUpdateTime   = "avatar_update_time"
+	ColumnLoginId            = "login_id"
+	ColumnLastLoginTime      = "last_login_time"
+	ColumnSuccess            = "success"
+	ColumnInactivityExempt   = "inactivity_exempt"
+	ColumnInactivityWarnTime = "inactivity_warn_time"
+	ColumnMergedInto         = "merged_into"
+	ColumnAnonymizeTime      = "anonymize_time"
+	ColumnClientIp           = "client_ip"
+	ColumnUserAgent          = "user_agent"
+	ColumnRecipient          = "recipient"
+	ColumnSubject            = "subject"
+	ColumnBody               = "body"
+	ColumnAncestorGroupId    = "ancestor_group_id"
+	ColumnDescendantGroupId  = "descendant_group_id"
+	ColumnDepth              = "depth"
+	ColumnMemberGroupId      = "member_group_id"
+	ColumnMembershipRule     = "membership_rule"
+	ColumnExpireTime         = "expire_time"
+	ColumnBindingId          = "binding_id"
+	ColumnReason             = "reason"
+	ColumnMaxMembers         = "max_members"
+	ColumnMaxChildGroups     = "max_child_groups"
+	ColumnMaxDepth           = "max_depth"
+	ColumnGroupNamePath      = "group_name_path"
 )
 
 const (
-	TableUserGroupBinding = "user_group_binding"
-	TableUser             = "user"
-	TableGroup            = "group"
+	TableUserGroupBinding  = "user_group_binding"
+	TableUser              = "user"
+	TableGroup             = "group"
+	TableUserVerification  = "user_verification"
+	TableNotification      = "notification"
+	TableUserInvite        = "user_invite"
+	TableAttribute         = "attribute"
+	TableLoginHistory      = "login_history"
+	TableGroupClosure      = "group_closure"
+	TableGroupMember       = "group_member"
+	TableMembershipRemoval = "membership_removal"
 )
 
+// columns guarded by unique indexes, used to name the conflicting field
+var UniqueIndexColumns = map[string]string{
+	"user_active_username_uidx":         ColumnUsername,
+	"user_active_email_uidx":            ColumnEmail,
+	"user_verification_token_hash_uidx": ColumnTokenHash,
+	"user_invite_token_hash_uidx":       ColumnTokenHash,
+	"attribute_target_name_uidx":        ColumnName,
+	"group_member_uidx":                 ColumnMemberGroupId,
+}
+
 // columns that can be search through sql '=' operator
 var IndexedColumns = map[string][]string{
 	TableUser: {
-		ColumnUserId, ColumnEmail, ColumnPhoneNumber, ColumnStatus,
+		ColumnUserId, ColumnEmail, ColumnPhoneNumber, ColumnStatus, ColumnEmailVerified,
 	},
 	TableGroup: {
-		ColumnGroupId, ColumnParentGroupId, ColumnGroupPath, ColumnStatus,
+		ColumnGroupId, ColumnParentGroupId, ColumnGroupPath, ColumnGroupNamePath, ColumnStatus,
+	},
+	TableUserInvite: {
+		ColumnInviteId, ColumnUserId, ColumnEmail, ColumnStatus,
+	},
+	TableAttribute: {
+		ColumnAttributeId, ColumnTarget, ColumnName,
+	},
+	TableLoginHistory: {
+		ColumnLoginId, ColumnUserId,
+	},
+	TableUserGroupBinding: {
+		ColumnGroupId, ColumnUserId,
+	},
+	TableMembershipRemoval: {
+		ColumnGroupId, ColumnUserId,
 	},
 }
 
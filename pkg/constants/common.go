@@ -20,13 +20,111 @@ const (
 	PrefixGroupId            = "gid-"
 	PrefixUserId             = "uid-"
 	PrefixUserGroupBindingId = "bid-"
+	PrefixUserVerificationId = "vid-"
+	PrefixNotificationId     = "nid-"
+	PrefixInviteId           = "iid-"
+	PrefixAttributeId        = "aid-"
+	PrefixLoginId            = "lid-"
+	PrefixGroupMemberId      = "gmid-"
 )
 
 const (
-	GroupPathSep = "."
+	GroupPathSep     = "."
+	GroupNamePathSep = "/"
+)
+
+const (
+	BlobPrefixAvatar = "avatar/"
 )
 
 const (
 	StatusActive  = "active"
 	StatusDeleted = "deleted"
+	StatusPending = "pending"
+	// disabled users can not login until they are active again
+	StatusDisabled = "disabled"
+)
+
+const (
+	UsernameReusePolicyAfterDelete = "after_delete"
+	UsernameReusePolicyNever       = "never"
+)
+
+const (
+	VerificationKindEmail = "email"
+	VerificationKindPhone = "phone"
+)
+
+const (
+	NotificationStatusPending = "pending"
+	NotificationStatusSent    = "sent"
+	NotificationStatusFailed  = "failed"
+)
+
+const (
+	NotificationKindEmail = "email"
+)
+
+const (
+	TemplateEmailVerification = "email_verification"
+	TemplateUserInvite        = "user_invite"
+	TemplateInactivityWarning = "inactivity_warning"
+)
+
+const (
+	InviteStatusPending  = "pending"
+	InviteStatusAccepted = "accepted"
+	InviteStatusRevoked  = "revoked"
+)
+
+// reasons of the removed group memberships
+const (
+	RemoveReasonExpired = "expired"
+)
+
+const (
+	MergeConflictKeepTarget = "keep_target"
+	MergeConflictKeepSource = "keep_source"
+	MergeConflictFail       = "fail"
+)
+
+// MaxMergeRedirects limits the merged users followed by GetUser
+const MaxMergeRedirects = 10
+
+const (
+	AttributeTypeString     = "string"
+	AttributeTypeInt        = "int"
+	AttributeTypeBool       = "bool"
+	AttributeTypeEnum       = "enum"
+	AttributeTypeDate       = "date"
+	AttributeTypeStringList = "string_list"
+)
+
+var AttributeTypes = []string{
+	AttributeTypeString,
+	AttributeTypeInt,
+	AttributeTypeBool,
+	AttributeTypeEnum,
+	AttributeTypeDate,
+	AttributeTypeStringList,
+}
+
+// attributes are defined for the extra of users or groups
+var AttributeTargets = []string{
+	TableUser,
+	TableGroup,
+}
+
+const (
+	AttributeDateLayout = "2006-01-02"
+)
+
+const (
+	FilterOpEq       = "eq"
+	FilterOpNe       = "ne"
+	FilterOpGt       = "gt"
+	FilterOpGte      = "gte"
+	FilterOpLt       = "lt"
+	FilterOpLte      = "lte"
+	FilterOpContains = "contains"
 )
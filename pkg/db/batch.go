@@ -0,0 +1,82 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+)
+
+// sqlite3 allows 999 placeholders in a statement by default, the lowest of the databases
+const maxBatchPlaceholders = 999
+
+// BatchInsert inserts the values of the same model with multi-row insert statements,
+// the rows are split so that a statement never exceeds the placeholder limit.
+func BatchInsert(db *gorm.DB, values []interface{}) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	scope := db.NewScope(values[0])
+	var columns []string
+	for _, field := range scope.Fields() {
+		if isInsertField(field) {
+			columns = append(columns, scope.Quote(field.DBName))
+		}
+	}
+
+	var rows [][]interface{}
+	for _, value := range values {
+		var row []interface{}
+		for _, field := range db.NewScope(value).Fields() {
+			if isInsertField(field) {
+				row = append(row, field.Field.Interface())
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES ?", scope.QuotedTableName(), strings.Join(columns, ","))
+	for _, batch := range splitRows(rows, maxBatchPlaceholders/len(columns)) {
+		// gorm expands [][]interface{} into (?,?),(?,?)
+		if err := db.Exec(query, batch).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isInsertField(field *gorm.Field) bool {
+	return field.IsNormal && !field.IsIgnored
+}
+
+func splitRows(rows [][]interface{}, size int) [][][]interface{} {
+	if size < 1 {
+		size = 1
+	}
+	var batches [][][]interface{}
+	for len(rows) > size {
+		batches = append(batches, rows[:size])
+		rows = rows[size:]
+	}
+	if len(rows) > 0 {
+		batches = append(batches, rows)
+	}
+	return batches
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package db
+
+import (
+	"testing"
+
+	. "kubesphere.io/im/pkg/util/assert"
+)
+
+func TestSplitRows(t *testing.T) {
+	var rows [][]interface{}
+	for i := 0; i < 5; i++ {
+		rows = append(rows, []interface{}{i})
+	}
+
+	batches := splitRows(rows, 2)
+	Assert(t, len(batches) == 3)
+	Assert(t, len(batches[0]) == 2)
+	Assert(t, len(batches[2]) == 1)
+	Assert(t, batches[2][0][0] == 4)
+
+	batches = splitRows(rows, 5)
+	Assert(t, len(batches) == 1)
+
+	batches = splitRows(rows, 0)
+	Assert(t, len(batches) == 5)
+
+	batches = splitRows(nil, 2)
+	Assert(t, len(batches) == 0)
+}
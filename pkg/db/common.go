@@ -116,6 +116,11 @@ func getReqValue(param interface{}) interface{} {
 			return nil
 		}
 		return []int32{value.GetValue()}
+	case *wrappers.BoolValue:
+		if value == nil {
+			return nil
+		}
+		return []bool{value.GetValue()}
 	case []string:
 		var values []string
 		for _, v := range value {
@@ -170,15 +175,14 @@ func (c *Chain) BuildFilterConditions(req Request, tableName string, exclude ...
 	return c.buildFilterConditions(req, tableName, exclude...)
 }
 
+// BuildRootGroupIdConditions keeps the groups in the subtrees of the root groups, the roots included.
 func (c *Chain) BuildRootGroupIdConditions(rootGroupIds []string) *Chain {
 	if len(rootGroupIds) > 0 {
-		var conditions []string
-		for _, v := range rootGroupIds {
-			likeV := "%" + stringutil.SimplifyString(v) + "%"
-			conditions = append(conditions, constants.ColumnGroupPath+" LIKE '"+likeV+"'")
-		}
-		condition := strings.Join(conditions, " OR ")
-		c.DB = c.DB.Where(condition)
+		c.DB = c.DB.Where(constants.ColumnGroupId+" in (?)", c.DB.New().
+			Table(constants.TableGroupClosure).
+			Select(constants.ColumnDescendantGroupId).
+			Where(constants.ColumnAncestorGroupId+" in (?)", stringutil.SimplifyStringList(rootGroupIds)).
+			SubQuery())
 	}
 	return c
 }
@@ -246,3 +250,12 @@ func (c *Chain) AddQueryOrderDir(req Request, defaultColumn string) *Chain {
 	c.DB = c.Order(defaultColumn + " " + order)
 	return c
 }
+
+// ForUpdate locks the selected rows until the transaction ends,
+// sqlite3 locks the whole database on write and has no such clause.
+func (c *Chain) ForUpdate() *Chain {
+	if c.Dialect().GetName() != DialectSqlite {
+		c.DB = c.Set("gorm:query_option", "FOR UPDATE")
+	}
+	return c
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package db
+
+import (
+	"fmt"
+	"regexp"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/util/jsonutil"
+)
+
+const (
+	DialectMysql    = "mysql"
+	DialectPostgres = "postgres"
+	DialectSqlite   = "sqlite3"
+)
+
+// ExtraCondition filters a key of the json extra column,
+// Value is an int64, bool or string, List means the key holds a json array
+// and only supports the contains operator.
+type ExtraCondition struct {
+	Key   string
+	Op    string
+	Value interface{}
+	List  bool
+}
+
+var reExtraKey = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+var extraOperators = map[string]string{
+	constants.FilterOpEq:  "=",
+	constants.FilterOpNe:  "!=",
+	constants.FilterOpGt:  ">",
+	constants.FilterOpGte: ">=",
+	constants.FilterOpLt:  "<",
+	constants.FilterOpLte: "<=",
+}
+
+// BuildExtraConditions translates the conditions into json path expressions of
+// the dialect, such as JSON_EXTRACT(extra, '$.level') > 3 of mysql.
+func (c *Chain) BuildExtraConditions(conditions []ExtraCondition) *Chain {
+	dialect := c.Dialect().GetName()
+	for _, condition := range conditions {
+		query, value, err := getExtraCondition(dialect, condition)
+		if err != nil {
+			c.DB.AddError(err)
+			return c
+		}
+		c.DB = c.Where(query, value)
+	}
+	return c
+}
+
+func getExtraCondition(dialect string, condition ExtraCondition) (string, interface{}, error) {
+	key := condition.Key
+	if !reExtraKey.MatchString(key) {
+		return "", nil, fmt.Errorf("invalid extra key [%s]", key)
+	}
+	column := constants.ColumnExtra
+
+	if condition.List {
+		if condition.Op != constants.FilterOpContains {
+			return "", nil, fmt.Errorf("unsupported operator [%s] of list [%s]", condition.Op, key)
+		}
+		switch dialect {
+		case DialectMysql:
+			return fmt.Sprintf("JSON_CONTAINS(%s, JSON_QUOTE(?), '$.%s')", column, key), condition.Value, nil
+		case DialectPostgres:
+			return fmt.Sprintf("(%s::jsonb -> '%s') @> ?::jsonb", column, key), jsonutil.ToString([]interface{}{condition.Value}), nil
+		case DialectSqlite:
+			return fmt.Sprintf("EXISTS (SELECT 1 FROM json_each(%s, '$.%s') WHERE json_each.value = ?)", column, key), condition.Value, nil
+		}
+		return "", nil, fmt.Errorf("unsupported dialect [%s]", dialect)
+	}
+
+	// path extracts the value of the key as text, number or boolean
+	var path string
+	value := condition.Value
+	switch dialect {
+	case DialectMysql:
+		path = fmt.Sprintf("JSON_UNQUOTE(JSON_EXTRACT(%s, '$.%s'))", column, key)
+		switch v := value.(type) {
+		case int64:
+			path = fmt.Sprintf("JSON_EXTRACT(%s, '$.%s')", column, key)
+		case bool:
+			// json booleans are unquoted as "true" or "false"
+			value = fmt.Sprint(v)
+		}
+	case DialectPostgres:
+		path = fmt.Sprintf("%s::jsonb ->> '%s'", column, key)
+		switch value.(type) {
+		case int64:
+			path = "(" + path + ")::numeric"
+		case bool:
+			path = "(" + path + ")::boolean"
+		}
+	case DialectSqlite:
+		path = fmt.Sprintf("json_extract(%s, '$.%s')", column, key)
+		// json booleans are extracted as 1 or 0
+		if v, ok := value.(bool); ok {
+			value = 0
+			if v {
+				value = 1
+			}
+		}
+	default:
+		return "", nil, fmt.Errorf("unsupported dialect [%s]", dialect)
+	}
+
+	if condition.Op == constants.FilterOpContains {
+		s, ok := condition.Value.(string)
+		if !ok {
+			return "", nil, fmt.Errorf("unsupported operator [%s] of [%s]", condition.Op, key)
+		}
+		return path + " LIKE ?", "%" + s + "%", nil
+	}
+	operator, ok := extraOperators[condition.Op]
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported operator [%s] of [%s]", condition.Op, key)
+	}
+	if _, ok := condition.Value.(bool); ok && operator != "=" && operator != "!=" {
+		return "", nil, fmt.Errorf("unsupported operator [%s] of [%s]", condition.Op, key)
+	}
+	return fmt.Sprintf("%s %s ?", path, operator), value, nil
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package db
+
+import (
+	"testing"
+
+	"kubesphere.io/im/pkg/constants"
+	. "kubesphere.io/im/pkg/util/assert"
+)
+
+func TestGetExtraCondition(t *testing.T) {
+	var tests = []struct {
+		dialect   string
+		condition ExtraCondition
+		query     string
+		value     interface{}
+	}{
+		{
+			dialect:   DialectMysql,
+			condition: ExtraCondition{Key: "level", Op: constants.FilterOpGte, Value: int64(3)},
+			query:     "JSON_EXTRACT(extra, '$.level') >= ?",
+			value:     int64(3),
+		},
+		{
+			dialect:   DialectMysql,
+			condition: ExtraCondition{Key: "vip", Op: constants.FilterOpEq, Value: true},
+			query:     "JSON_UNQUOTE(JSON_EXTRACT(extra, '$.vip')) = ?",
+			value:     "true",
+		},
+		{
+			dialect:   DialectMysql,
+			condition: ExtraCondition{Key: "tags", Op: constants.FilterOpContains, Value: "a", List: true},
+			query:     "JSON_CONTAINS(extra, JSON_QUOTE(?), '$.tags')",
+			value:     "a",
+		},
+		{
+			dialect:   DialectPostgres,
+			condition: ExtraCondition{Key: "level", Op: constants.FilterOpLt, Value: int64(3)},
+			query:     "(extra::jsonb ->> 'level')::numeric < ?",
+			value:     int64(3),
+		},
+		{
+			dialect:   DialectPostgres,
+			condition: ExtraCondition{Key: "tags", Op: constants.FilterOpContains, Value: "a", List: true},
+			query:     "(extra::jsonb -> 'tags') @> ?::jsonb",
+			value:     `["a"]`,
+		},
+		{
+			dialect:   DialectSqlite,
+			condition: ExtraCondition{Key: "title", Op: constants.FilterOpContains, Value: "dev"},
+			query:     "json_extract(extra, '$.title') LIKE ?",
+			value:     "%dev%",
+		},
+		{
+			dialect:   DialectSqlite,
+			condition: ExtraCondition{Key: "vip", Op: constants.FilterOpNe, Value: false},
+			query:     "json_extract(extra, '$.vip') != ?",
+			value:     0,
+		},
+	}
+	for _, v := range tests {
+		query, value, err := getExtraCondition(v.dialect, v.condition)
+		Assertf(t, err == nil, "get condition failed: %v", err)
+		Assertf(t, query == v.query, "expect = %q, got = %q", v.query, query)
+		Assertf(t, value == v.value, "expect = %v, got = %v", v.value, value)
+	}
+
+	for _, condition := range []ExtraCondition{
+		{Key: "a'b", Op: constants.FilterOpEq, Value: "x"},
+		{Key: "tags", Op: constants.FilterOpEq, Value: "x", List: true},
+		{Key: "vip", Op: constants.FilterOpGt, Value: true},
+		{Key: "level", Op: constants.FilterOpContains, Value: int64(1)},
+	} {
+		_, _, err := getExtraCondition(DialectMysql, condition)
+		Assertf(t, err != nil, "expect error of %+v", condition)
+	}
+}
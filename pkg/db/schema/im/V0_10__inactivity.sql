@@ -0,0 +1,5 @@
+ALTER TABLE user
+  ADD COLUMN inactivity_warn_time timestamp NULL DEFAULT NULL;
+
+ALTER TABLE `group`
+  ADD COLUMN inactivity_exempt tinyint(1) NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+ALTER TABLE user
+  ADD COLUMN merged_into varchar(50) NOT NULL DEFAULT '';
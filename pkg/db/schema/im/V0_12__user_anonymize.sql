@@ -0,0 +1,2 @@
+ALTER TABLE user
+  ADD COLUMN anonymize_time timestamp NULL DEFAULT NULL;
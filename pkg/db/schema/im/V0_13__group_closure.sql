@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS group_closure (
+  ancestor_group_id   varchar(50) NOT NULL,
+  descendant_group_id varchar(50) NOT NULL,
+  depth               int(11)     NOT NULL,
+  PRIMARY KEY (ancestor_group_id, descendant_group_id)
+);
+CREATE INDEX group_closure_descendant_group_id_idx
+  ON group_closure (descendant_group_id, depth);
+
+INSERT INTO group_closure (ancestor_group_id, descendant_group_id, depth)
+SELECT a.group_id, d.group_id, d.group_path_level - a.group_path_level
+FROM `group` a
+  JOIN `group` d
+    ON d.group_id = a.group_id
+    OR LEFT(d.group_path, CHAR_LENGTH(a.group_path) + 1) = CONCAT(a.group_path, '.');
+
+-- the closure makes the path a display value, it no longer limits the depth of the tree
+DROP INDEX group_group_path_idx
+  ON `group`;
+ALTER TABLE `group`
+  MODIFY COLUMN group_path varchar(2000) NOT NULL;
+CREATE INDEX group_group_path_idx
+  ON `group` (group_path(191));
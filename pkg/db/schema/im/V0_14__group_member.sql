@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS group_member (
+  id              varchar(50) NOT NULL,
+  group_id        varchar(50) NOT NULL,
+  member_group_id varchar(50) NOT NULL,
+  create_time     timestamp   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX group_member_uidx
+  ON group_member (group_id, member_group_id);
+CREATE INDEX group_member_member_group_id_idx
+  ON group_member (member_group_id);
@@ -0,0 +1,2 @@
+ALTER TABLE `group`
+  ADD COLUMN membership_rule varchar(1000) NOT NULL DEFAULT '';
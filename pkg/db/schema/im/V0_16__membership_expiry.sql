@@ -0,0 +1,18 @@
+ALTER TABLE user_group_binding
+  ADD COLUMN expire_time timestamp NULL DEFAULT NULL;
+CREATE INDEX user_group_binding_expire_time_idx
+  ON user_group_binding (expire_time);
+
+CREATE TABLE IF NOT EXISTS membership_removal (
+  binding_id  varchar(50) NOT NULL,
+  group_id    varchar(50) NOT NULL,
+  user_id     varchar(50) NOT NULL,
+  reason      varchar(50) NOT NULL,
+  expire_time timestamp   NULL DEFAULT NULL,
+  create_time timestamp   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (binding_id)
+);
+CREATE INDEX membership_removal_group_id_create_time_idx
+  ON membership_removal (group_id, create_time);
+CREATE INDEX membership_removal_user_id_create_time_idx
+  ON membership_removal (user_id, create_time);
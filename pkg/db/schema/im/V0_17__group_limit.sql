@@ -0,0 +1,4 @@
+ALTER TABLE `group`
+  ADD COLUMN max_members int NOT NULL DEFAULT 0,
+  ADD COLUMN max_child_groups int NOT NULL DEFAULT 0,
+  ADD COLUMN max_depth int NOT NULL DEFAULT 0;
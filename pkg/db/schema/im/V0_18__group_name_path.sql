@@ -0,0 +1,25 @@
+ALTER TABLE `group`
+  ADD COLUMN group_name_path varchar(4000) NOT NULL DEFAULT '';
+
+-- "/" separates the names in a path and is rejected in group names since then,
+-- it is replaced in the legacy names so that every path splits back into its names
+UPDATE `group`
+SET group_name = REPLACE(group_name, '/', '-')
+WHERE group_name LIKE '%/%';
+
+-- the names of the ancestors from the root, the group itself is in the closure at depth 0
+SET SESSION group_concat_max_len = 65535;
+UPDATE `group` g
+  JOIN (
+    SELECT c.descendant_group_id AS group_id,
+      CONCAT('/', GROUP_CONCAT(a.group_name ORDER BY c.depth DESC SEPARATOR '/')) AS group_name_path
+    FROM group_closure c
+      JOIN `group` a
+        ON a.group_id = c.ancestor_group_id
+    GROUP BY c.descendant_group_id
+  ) p
+    ON p.group_id = g.group_id
+SET g.group_name_path = p.group_name_path;
+
+CREATE INDEX group_group_name_path_idx
+  ON `group` (group_name_path(191));
@@ -0,0 +1,24 @@
+-- phone numbers are saved in E.164 format, such as "+8613800000000", convert the rows saved before,
+-- the same way as phoneutil.NormalizeE164: drop the separators, "00" is the international prefix,
+-- and the other numbers are national numbers of ${phone_default_country_code};
+-- rows that are still not E.164 after that are kept as is
+UPDATE user
+SET phone_number =
+  CASE
+    WHEN REGEXP_REPLACE(phone_number, '[ ().\t-]', '') LIKE '+%'
+      THEN REGEXP_REPLACE(phone_number, '[ ().\t-]', '')
+    WHEN REGEXP_REPLACE(phone_number, '[ ().\t-]', '') LIKE '00%'
+      THEN CONCAT('+', SUBSTRING(REGEXP_REPLACE(phone_number, '[ ().\t-]', ''), 3))
+    ELSE CONCAT('+', TRIM(LEADING '+' FROM '${phone_default_country_code}'),
+                TRIM(LEADING '0' FROM REGEXP_REPLACE(phone_number, '[ ().\t-]', '')))
+  END
+WHERE phone_number <> ''
+  AND phone_number NOT REGEXP '^[+][1-9][0-9]{6,14}$'
+  AND CASE
+        WHEN REGEXP_REPLACE(phone_number, '[ ().\t-]', '') LIKE '+%'
+          THEN REGEXP_REPLACE(phone_number, '[ ().\t-]', '')
+        WHEN REGEXP_REPLACE(phone_number, '[ ().\t-]', '') LIKE '00%'
+          THEN CONCAT('+', SUBSTRING(REGEXP_REPLACE(phone_number, '[ ().\t-]', ''), 3))
+        ELSE CONCAT('+', TRIM(LEADING '+' FROM '${phone_default_country_code}'),
+                    TRIM(LEADING '0' FROM REGEXP_REPLACE(phone_number, '[ ().\t-]', '')))
+      END REGEXP '^[+][1-9][0-9]{6,14}$';
@@ -0,0 +1,10 @@
+-- username and email are unique among users that are not deleted,
+-- a deleted user keeps its data but no longer blocks reuse
+ALTER TABLE user
+  ADD COLUMN active_username varchar(50) GENERATED ALWAYS AS (IF(status = 'deleted', NULL, username)) VIRTUAL,
+  ADD COLUMN active_email varchar(50) GENERATED ALWAYS AS (IF(status = 'deleted' OR email = '', NULL, email)) VIRTUAL;
+
+CREATE UNIQUE INDEX user_active_username_uidx
+  ON user (active_username);
+CREATE UNIQUE INDEX user_active_email_uidx
+  ON user (active_email);
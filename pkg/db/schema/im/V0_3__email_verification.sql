@@ -0,0 +1,22 @@
+ALTER TABLE user
+  ADD COLUMN email_verified    tinyint(1) NOT NULL DEFAULT 0,
+  ADD COLUMN email_verify_time timestamp  NULL     DEFAULT NULL;
+
+CREATE INDEX user_email_verified_idx
+  ON user (email_verified);
+
+CREATE TABLE IF NOT EXISTS user_verification (
+  id           varchar(50)  NOT NULL,
+  user_id      varchar(50)  NOT NULL,
+  kind         varchar(50)  NOT NULL,
+  target       varchar(255) NOT NULL,
+  token_hash   varchar(64)  NOT NULL,
+  expire_time  timestamp    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  consume_time timestamp    NULL     DEFAULT NULL,
+  create_time  timestamp    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX user_verification_token_hash_uidx
+  ON user_verification (token_hash);
+CREATE INDEX user_verification_user_id_idx
+  ON user_verification (user_id);
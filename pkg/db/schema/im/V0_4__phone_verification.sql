@@ -0,0 +1,6 @@
+ALTER TABLE user
+  ADD COLUMN phone_verified    tinyint(1) NOT NULL DEFAULT 0,
+  ADD COLUMN phone_verify_time timestamp  NULL     DEFAULT NULL;
+
+ALTER TABLE user_verification
+  ADD COLUMN attempts int(11) NOT NULL DEFAULT 0;
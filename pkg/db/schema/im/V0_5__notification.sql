@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS notification (
+  notification_id   varchar(50)   NOT NULL,
+  kind              varchar(50)   NOT NULL,
+  recipient         varchar(255)  NOT NULL,
+  subject           varchar(255)  NOT NULL,
+  body              text          NOT NULL,
+  status            varchar(50)   NOT NULL,
+  attempts          int(11)       NOT NULL DEFAULT 0,
+  last_error        varchar(1000) NOT NULL DEFAULT '',
+  next_attempt_time timestamp     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  send_time         timestamp     NULL     DEFAULT NULL,
+  create_time       timestamp     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  status_time       timestamp     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (notification_id)
+);
+CREATE INDEX notification_status_next_attempt_time_idx
+  ON notification (status, next_attempt_time);
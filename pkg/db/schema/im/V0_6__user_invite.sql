@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS user_invite (
+  invite_id   varchar(50)  NOT NULL,
+  user_id     varchar(50)  NOT NULL,
+  email       varchar(50)  NOT NULL,
+  group_ids   json         DEFAULT NULL,
+  token_hash  varchar(64)  NOT NULL,
+  status      varchar(50)  NOT NULL,
+  expire_time timestamp    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  create_time timestamp    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  status_time timestamp    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (invite_id)
+);
+CREATE UNIQUE INDEX user_invite_token_hash_uidx
+  ON user_invite (token_hash);
+CREATE INDEX user_invite_user_id_idx
+  ON user_invite (user_id);
+CREATE INDEX user_invite_email_idx
+  ON user_invite (email);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS attribute (
+  attribute_id varchar(50)   NOT NULL,
+  target       varchar(50)   NOT NULL,
+  name         varchar(50)   NOT NULL,
+  type         varchar(50)   NOT NULL,
+  required     tinyint(1)    NOT NULL DEFAULT 0,
+  `unique`     tinyint(1)    NOT NULL DEFAULT 0,
+  enum_values  json                   DEFAULT NULL,
+  description  varchar(1000) NOT NULL,
+  create_time  timestamp     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (attribute_id)
+);
+CREATE UNIQUE INDEX attribute_target_name_uidx
+  ON attribute (target, name);
@@ -0,0 +1,15 @@
+ALTER TABLE user
+  ADD COLUMN display_name       varchar(100) NOT NULL DEFAULT '',
+  ADD COLUMN given_name         varchar(50)  NOT NULL DEFAULT '',
+  ADD COLUMN family_name        varchar(50)  NOT NULL DEFAULT '',
+  ADD COLUMN locale             varchar(50)  NOT NULL DEFAULT '',
+  ADD COLUMN timezone           varchar(50)  NOT NULL DEFAULT '',
+  ADD COLUMN avatar_update_time timestamp    NULL     DEFAULT NULL;
+
+CREATE TABLE IF NOT EXISTS `blob` (
+  blob_key     varchar(255) NOT NULL,
+  content_type varchar(100) NOT NULL,
+  data         mediumblob            DEFAULT NULL,
+  update_time  timestamp    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (blob_key)
+);
@@ -0,0 +1,16 @@
+ALTER TABLE user
+  ADD COLUMN last_login_time timestamp NULL DEFAULT NULL;
+
+CREATE TABLE IF NOT EXISTS login_history (
+  login_id    varchar(50)  NOT NULL,
+  user_id     varchar(50)  NOT NULL,
+  success     tinyint(1)   NOT NULL,
+  client_ip   varchar(50)  NOT NULL DEFAULT '',
+  user_agent  varchar(255) NOT NULL DEFAULT '',
+  create_time timestamp    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (login_id)
+);
+CREATE INDEX login_history_user_id_create_time_idx
+  ON login_history (user_id, create_time);
+CREATE INDEX login_history_create_time_idx
+  ON login_history (create_time);
@@ -0,0 +1,278 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package gerr translates errors into gRPC status with google.rpc error details,
+// so clients can branch on ErrorInfo.Reason instead of parsing messages.
+package gerr
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/golang/protobuf/proto"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/util/stringutil"
+)
+
+const Domain = "im.kubesphere.io"
+
+const (
+	ReasonNotFound           = "NOT_FOUND"
+	ReasonAlreadyExists      = "ALREADY_EXISTS"
+	ReasonInvalidArgument    = "INVALID_ARGUMENT"
+	ReasonFailedPrecondition = "FAILED_PRECONDITION"
+	ReasonAborted            = "ABORTED"
+	ReasonResourceExhausted  = "RESOURCE_EXHAUSTED"
+	ReasonInternal           = "INTERNAL"
+)
+
+const (
+	MetadataResource = "resource"
+	MetadataId       = "id"
+	MetadataField    = "field"
+	MetadataValue    = "value"
+	MetadataLimit    = "limit"
+)
+
+// mysql error numbers
+const (
+	mysqlErrDupEntry        = 1062
+	mysqlErrLockWaitTimeout = 1205
+	mysqlErrLockDeadlock    = 1213
+	mysqlErrRowIsReferenced = 1451
+	mysqlErrNoReferencedRow = 1452
+)
+
+func New(code codes.Code, reason string, metadata map[string]string, format string, a ...interface{}) error {
+	s, err := status.New(code, fmt.Sprintf(format, a...)).WithDetails(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   Domain,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return status.Errorf(code, format, a...)
+	}
+	return s.Err()
+}
+
+func NewNotFound(resource, id string) error {
+	return New(codes.NotFound, ReasonNotFound, map[string]string{
+		MetadataResource: resource,
+		MetadataId:       id,
+	}, "%s [%s] not found", resource, id)
+}
+
+func NewAlreadyExists(resource, field, value string) error {
+	return New(codes.AlreadyExists, ReasonAlreadyExists, map[string]string{
+		MetadataResource: resource,
+		MetadataField:    field,
+		MetadataValue:    value,
+	}, "%s [%s] already exists", field, value)
+}
+
+// NewInvalidArgument reports a bad field of the request with BadRequest details.
+func NewInvalidArgument(field, format string, a ...interface{}) error {
+	description := fmt.Sprintf(format, a...)
+	s, err := status.New(codes.InvalidArgument, description).WithDetails(
+		&errdetails.ErrorInfo{
+			Reason:   ReasonInvalidArgument,
+			Domain:   Domain,
+			Metadata: map[string]string{MetadataField: field},
+		},
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: field, Description: description},
+			},
+		},
+	)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, description)
+	}
+	return s.Err()
+}
+
+// GetErrorInfo returns nil if the error carries no ErrorInfo.
+func GetErrorInfo(err error) *errdetails.ErrorInfo {
+	s, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+	for _, detail := range s.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	return nil
+}
+
+// FromError converts any error into a gRPC status that carries ErrorInfo,
+// database errors are mapped to the matching codes and unknown errors
+// become Internal without leaking their messages.
+func FromError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if s, ok := status.FromError(err); ok {
+		if GetErrorInfo(err) != nil {
+			return err
+		}
+		// errors of the generated request validators, such as
+		// "invalid field UserId: value '' must not be an empty string"
+		if s.Code() == codes.InvalidArgument && len(s.Details()) == 0 {
+			if match := reInvalidField.FindStringSubmatch(s.Message()); match != nil {
+				return NewInvalidArgument(getFieldPath(match[1]), "%s", s.Message())
+			}
+		}
+		var details []proto.Message
+		details = append(details, &errdetails.ErrorInfo{
+			Reason: GetReason(s.Code()),
+			Domain: Domain,
+		})
+		for _, detail := range s.Details() {
+			if message, ok := detail.(proto.Message); ok {
+				details = append(details, message)
+			}
+		}
+		withDetails, e := status.New(s.Code(), s.Message()).WithDetails(details...)
+		if e != nil {
+			return err
+		}
+		return withDetails.Err()
+	}
+	return fromDBError(err)
+}
+
+var reInvalidField = regexp.MustCompile(`^invalid field ([\w.]+): `)
+var reDuplicateEntry = regexp.MustCompile(`Duplicate entry '(.*)' for key '(.*)'`)
+var reSqliteUnique = regexp.MustCompile(`UNIQUE constraint failed: ([\w.]+)`)
+
+func fromDBError(err error) error {
+	if gorm.IsRecordNotFoundError(err) {
+		return New(codes.NotFound, ReasonNotFound, nil, "record not found")
+	}
+
+	if e, ok := errors.Cause(err).(*mysql.MySQLError); ok {
+		switch e.Number {
+		case mysqlErrDupEntry:
+			var value, key string
+			if match := reDuplicateEntry.FindStringSubmatch(e.Message); match != nil {
+				value, key = match[1], match[2]
+			}
+			return newDuplicateError(key, value)
+		case mysqlErrRowIsReferenced, mysqlErrNoReferencedRow:
+			return New(codes.FailedPrecondition, ReasonFailedPrecondition, nil, "conflict with existing records")
+		case mysqlErrLockDeadlock, mysqlErrLockWaitTimeout:
+			return New(codes.Aborted, ReasonAborted, nil, "concurrent modification, please retry")
+		}
+	}
+
+	// sqlite3 and postgres are matched by message to avoid depending on their drivers
+	message := err.Error()
+	if match := reSqliteUnique.FindStringSubmatch(message); match != nil {
+		return newDuplicateError(match[1], "")
+	}
+	if strings.Contains(message, "duplicate key value violates unique constraint") {
+		return newDuplicateError(getQuoted(message), "")
+	}
+	if strings.Contains(message, "violates foreign key constraint") {
+		return New(codes.FailedPrecondition, ReasonFailedPrecondition, nil, "conflict with existing records")
+	}
+
+	return New(codes.Internal, ReasonInternal, nil, "internal error")
+}
+
+// key is a unique index name, such as "user_active_username_uidx" of mysql,
+// or "user.username" of sqlite3
+func newDuplicateError(key, value string) error {
+	field := constants.UniqueIndexColumns[key]
+	if field == "" {
+		if i := strings.LastIndex(key, "."); i >= 0 {
+			field = constants.UniqueIndexColumns[key[i+1:]]
+			if field == "" {
+				field = key[i+1:]
+			}
+		}
+	}
+	if field == "" {
+		return New(codes.AlreadyExists, ReasonAlreadyExists, nil, "record already exists")
+	}
+	metadata := map[string]string{MetadataField: field}
+	if value != "" {
+		metadata[MetadataValue] = value
+		return New(codes.AlreadyExists, ReasonAlreadyExists, metadata, "%s [%s] already exists", field, value)
+	}
+	return New(codes.AlreadyExists, ReasonAlreadyExists, metadata, "%s already exists", field)
+}
+
+// getFieldPath converts the go field path to the proto one, such as Group.GroupId => group.group_id
+func getFieldPath(s string) string {
+	fields := strings.Split(s, ".")
+	for i, field := range fields {
+		fields[i] = stringutil.CamelCaseToUnderscore(field)
+	}
+	return strings.Join(fields, ".")
+}
+
+func getQuoted(s string) string {
+	start := strings.IndexByte(s, '"')
+	if start < 0 {
+		return ""
+	}
+	end := strings.IndexByte(s[start+1:], '"')
+	if end < 0 {
+		return ""
+	}
+	return s[start+1 : start+1+end]
+}
+
+// GetReason converts the code to reason, such as NotFound => NOT_FOUND.
+func GetReason(code codes.Code) string {
+	var buf strings.Builder
+	var last rune
+	for _, r := range code.String() {
+		if unicode.IsUpper(r) && unicode.IsLower(last) {
+			buf.WriteByte('_')
+		}
+		buf.WriteRune(unicode.ToUpper(r))
+		last = r
+	}
+	return buf.String()
+}
+
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			if _, ok := status.FromError(err); !ok {
+				logger.Errorf(ctx, "Translate error of [%s]: %+v", info.FullMethod, err)
+			}
+			err = FromError(err)
+		}
+		return resp, err
+	}
+}
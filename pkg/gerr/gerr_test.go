@@ -0,0 +1,107 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gerr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetReason(t *testing.T) {
+	require.Equal(t, "OK", GetReason(codes.OK))
+	require.Equal(t, "NOT_FOUND", GetReason(codes.NotFound))
+	require.Equal(t, "FAILED_PRECONDITION", GetReason(codes.FailedPrecondition))
+}
+
+func TestFromError(t *testing.T) {
+	var tests = []struct {
+		err    error
+		code   codes.Code
+		reason string
+		field  string
+	}{
+		{err: gorm.ErrRecordNotFound, code: codes.NotFound, reason: ReasonNotFound},
+		{
+			err:    &mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'test' for key 'user_active_username_uidx'"},
+			code:   codes.AlreadyExists,
+			reason: ReasonAlreadyExists,
+			field:  "username",
+		},
+		{
+			err:    &mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'a@op.com' for key 'user.user_active_email_uidx'"},
+			code:   codes.AlreadyExists,
+			reason: ReasonAlreadyExists,
+			field:  "email",
+		},
+		{
+			err:    errors.New("UNIQUE constraint failed: user.username"),
+			code:   codes.AlreadyExists,
+			reason: ReasonAlreadyExists,
+			field:  "username",
+		},
+		{
+			err:    &mysql.MySQLError{Number: 1451, Message: "Cannot delete or update a parent row"},
+			code:   codes.FailedPrecondition,
+			reason: ReasonFailedPrecondition,
+		},
+		{err: errors.New("Error 2006: MySQL server has gone away"), code: codes.Internal, reason: ReasonInternal},
+		{err: status.Errorf(codes.PermissionDenied, "user not in group"), code: codes.PermissionDenied, reason: "PERMISSION_DENIED"},
+	}
+	for _, v := range tests {
+		err := FromError(v.err)
+		require.Equal(t, v.code, status.Code(err), v.err.Error())
+		info := GetErrorInfo(err)
+		require.NotNil(t, info, v.err.Error())
+		require.Equal(t, v.reason, info.Reason)
+		require.Equal(t, Domain, info.Domain)
+		require.Equal(t, v.field, info.Metadata[MetadataField])
+	}
+
+	require.NoError(t, FromError(nil))
+}
+
+func TestNewInvalidArgument(t *testing.T) {
+	err := NewInvalidArgument("email", "empty email")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// keeps details already attached
+	err = FromError(err)
+	var badRequest *errdetails.BadRequest
+	for _, detail := range status.Convert(err).Details() {
+		if d, ok := detail.(*errdetails.BadRequest); ok {
+			badRequest = d
+		}
+	}
+	require.NotNil(t, badRequest)
+	require.Equal(t, "email", badRequest.FieldViolations[0].Field)
+	require.Equal(t, ReasonInvalidArgument, GetErrorInfo(err).Reason)
+}
+
+func TestFromValidatorError(t *testing.T) {
+	err := FromError(status.Error(codes.InvalidArgument,
+		"invalid field UserId: value '' must be a string conforming to regex \"^[a-zA-Z0-9_-]{2,50}$\""))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, ReasonInvalidArgument, GetErrorInfo(err).Reason)
+	require.Equal(t, "user_id", GetErrorInfo(err).Metadata[MetadataField])
+}
@@ -21,8 +21,11 @@ import (
 
 	"openpitrix.io/logger"
 
+	"kubesphere.io/im/pkg/blob"
 	"kubesphere.io/im/pkg/config"
 	"kubesphere.io/im/pkg/db"
+	"kubesphere.io/im/pkg/notifier"
+	"kubesphere.io/im/pkg/sms"
 )
 
 var global *Config
@@ -41,13 +44,19 @@ func Global() *Config {
 }
 
 type Config struct {
-	Config   *config.Config
-	Database *db.Database
+	Config    *config.Config
+	Database  *db.Database
+	SmsSender sms.SmsSender
+	Notifier  *notifier.Notifier
+	BlobStore blob.Store
 }
 
 func NewConfig(config *config.Config) *Config {
 	c := &Config{Config: config}
 	c.openDatabase()
+	c.setupSmsSender()
+	c.setupNotifier()
+	c.setupBlobStore()
 
 	return c
 }
@@ -60,3 +69,26 @@ func (c *Config) openDatabase() {
 	}
 	c.Database = database
 }
+
+func (c *Config) setupSmsSender() {
+	sender, err := sms.NewSmsSender(c.Config.Sms)
+	if err != nil {
+		logger.Criticalf(nil, "failed to setup sms sender")
+		panic(err)
+	}
+	c.SmsSender = sender
+}
+
+func (c *Config) setupNotifier() {
+	sender := notifier.NewSmtpSender(c.Config.Smtp)
+	c.Notifier = notifier.NewNotifier(c.Database.DB, sender, c.Config.Notifier)
+}
+
+func (c *Config) setupBlobStore() {
+	store, err := blob.NewStore(c.Config.Blob, c.Database.DB)
+	if err != nil {
+		logger.Criticalf(nil, "failed to setup blob store")
+		panic(err)
+	}
+	c.BlobStore = store
+}
@@ -37,6 +37,7 @@ import (
 	"google.golang.org/grpc/status"
 	"openpitrix.io/logger"
 
+	"kubesphere.io/im/pkg/gerr"
 	"kubesphere.io/im/pkg/version"
 )
 
@@ -71,6 +72,7 @@ func (g *GrpcServer) Serve(callback RegisterCallback, opt ...grpc.ServerOption)
 			PermitWithoutStream: true,
 		}),
 		grpc_middleware.WithUnaryServerChain(
+			gerr.UnaryServerInterceptor(),
 			grpc_validator.UnaryServerInterceptor(),
 			g.unaryServerLogInterceptor(),
 			grpc_recovery.UnaryServerInterceptor(
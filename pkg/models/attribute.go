@@ -0,0 +1,150 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/pb"
+	"kubesphere.io/im/pkg/util/idutil"
+	"kubesphere.io/im/pkg/util/jsonutil"
+	"kubesphere.io/im/pkg/util/stringutil"
+)
+
+// Attribute defines a typed key of the extra of users or groups.
+type Attribute struct {
+	AttributeId string  `gorm:"primary_key"`
+	Target      string  `gorm:"type:varchar(50);not null"`
+	Name        string  `gorm:"type:varchar(50);not null"`
+	Type        string  `gorm:"type:varchar(50);not null"`
+	Required    bool    `gorm:"not null"`
+	Unique      bool    `gorm:"not null"`
+	EnumValues  *string `gorm:"type:JSON"`
+	Description string  `gorm:"type:varchar(1000);not null"`
+	CreateTime  time.Time
+}
+
+func NewAttribute(target, name, attributeType string, required, unique bool, enumValues []string, description string) *Attribute {
+	return &Attribute{
+		AttributeId: idutil.GetUuid(constants.PrefixAttributeId),
+		Target:      target,
+		Name:        name,
+		Type:        attributeType,
+		Required:    required,
+		Unique:      unique,
+		EnumValues:  stringutil.NewString(jsonutil.ToString(stringutil.Unique(enumValues))),
+		Description: description,
+		CreateTime:  time.Now(),
+	}
+}
+
+func (p *Attribute) GetEnumValues() []string {
+	var enumValues []string
+	if p.EnumValues != nil && *p.EnumValues != "" {
+		jsonutil.Decode([]byte(*p.EnumValues), &enumValues)
+	}
+	return enumValues
+}
+
+func (p *Attribute) ToPB() *pb.Attribute {
+	if p == nil {
+		return new(pb.Attribute)
+	}
+	var q = &pb.Attribute{
+		AttributeId: p.AttributeId,
+		Target:      p.Target,
+		Name:        p.Name,
+		Type:        p.Type,
+		Required:    p.Required,
+		Unique:      p.Unique,
+		EnumValue:   p.GetEnumValues(),
+		Description: p.Description,
+	}
+	q.CreateTime, _ = ptypes.TimestampProto(p.CreateTime)
+	return q
+}
+
+// ParseValue converts the value of the extra to the json value stored in database:
+// int64 for int, bool for bool, []string for string_list and string for the others.
+// A string_list is written as a json array, such as ["a","b"].
+func (p *Attribute) ParseValue(value string) (interface{}, error) {
+	switch p.Type {
+	case constants.AttributeTypeInt:
+		return strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	case constants.AttributeTypeBool:
+		return strconv.ParseBool(strings.TrimSpace(value))
+	case constants.AttributeTypeDate:
+		t, err := time.Parse(constants.AttributeDateLayout, strings.TrimSpace(value))
+		if err != nil {
+			return nil, err
+		}
+		return t.Format(constants.AttributeDateLayout), nil
+	case constants.AttributeTypeEnum:
+		if !stringutil.Contains(p.GetEnumValues(), value) {
+			return nil, fmt.Errorf("value should be one of %v", p.GetEnumValues())
+		}
+		return value, nil
+	case constants.AttributeTypeStringList:
+		var values []string
+		if err := jsonutil.Decode([]byte(value), &values); err != nil {
+			return nil, fmt.Errorf("value should be a json array of strings")
+		}
+		return values, nil
+	}
+	return value, nil
+}
+
+// DecodeExtra converts the typed json of the extra to the map of the api,
+// numbers and booleans are formatted as strings and lists as json arrays.
+func DecodeExtra(extra *string) (map[string]string, error) {
+	if extra == nil || *extra == "" {
+		return nil, nil
+	}
+	var values map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(*extra)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&values); err != nil {
+		return nil, err
+	}
+	if values == nil {
+		return nil, nil
+	}
+	result := make(map[string]string)
+	for key, value := range values {
+		switch v := value.(type) {
+		case string:
+			result[key] = v
+		case json.Number:
+			result[key] = v.String()
+		case bool:
+			result[key] = strconv.FormatBool(v)
+		case nil:
+			result[key] = ""
+		default:
+			result[key] = jsonutil.ToString(v)
+		}
+	}
+	return result, nil
+}
@@ -32,7 +32,8 @@ import (
 type Group struct {
 	ParentGroupId string `gorm:"type:varchar(50);not null"`
 	GroupId       string `gorm:"primary_key"`
-	GroupPath     string `gorm:"type:varchar(255);not null;unique"`
+	GroupPath     string `gorm:"type:varchar(2000);not null"`
+	GroupNamePath string `gorm:"type:varchar(4000);not null"`
 	GroupName     string `gorm:"type:varchar(50);not null"`
 	Description   string `gorm:"type:varchar(1000);not null"`
 	Status        string `gorm:"type:varchar(50);not null"`
@@ -41,13 +42,20 @@ type Group struct {
 	StatusTime    time.Time
 	Extra         *string `gorm:"type:JSON"`
 
+	InactivityExempt bool
+	MembershipRule   string
+	MaxMembers       int
+	MaxChildGroups   int
+	MaxDepth         int
+
 	// internal
 	GroupPathLevel int
 }
 
 type GroupWithUser struct {
-	Group *Group
-	Users []*User
+	Group        *Group
+	Users        []*User
+	MemberGroups []*Group
 }
 
 func (p *GroupWithUser) ToPB() *pb.GroupWithUser {
@@ -55,9 +63,14 @@ func (p *GroupWithUser) ToPB() *pb.GroupWithUser {
 	for _, user := range p.Users {
 		pbUsers = append(pbUsers, user.ToPB())
 	}
+	var pbMemberGroups []*pb.Group
+	for _, group := range p.MemberGroups {
+		pbMemberGroups = append(pbMemberGroups, group.ToPB())
+	}
 	return &pb.GroupWithUser{
-		Group:   p.Group.ToPB(),
-		UserSet: pbUsers,
+		Group:          p.Group.ToPB(),
+		UserSet:        pbUsers,
+		MemberGroupSet: pbMemberGroups,
 	}
 }
 
@@ -71,6 +84,22 @@ func GetGroupPath(parentGroupPath, groupId string) string {
 	return groupPath
 }
 
+// GetGroupNamePath returns the names from the root separated by "/", such as /engineering/platform.
+func GetGroupNamePath(parentGroupNamePath, groupName string) string {
+	return parentGroupNamePath + constants.GroupNamePathSep + groupName
+}
+
+// PathFrom returns the group ids from the ancestor down to the group.
+func (p *Group) PathFrom(ancestorGroupId string) []string {
+	path := strings.Split(p.GroupPath, constants.GroupPathSep)
+	for i, groupId := range path {
+		if groupId == ancestorGroupId {
+			return path[i:]
+		}
+	}
+	return []string{p.GroupId}
+}
+
 func NewGroup(parentGroupId, parentGroupPath, groupName, description string, extra map[string]string) *Group {
 	groupId := idutil.GetUuid(constants.PrefixGroupId)
 	groupPath := GetGroupPath(parentGroupPath, groupId)
@@ -100,24 +129,27 @@ func (p *Group) ToProtoMessage() (*pb.Group, error) {
 		ParentGroupId: p.ParentGroupId,
 		GroupId:       p.GroupId,
 		GroupPath:     p.GroupPath,
+		GroupNamePath: p.GroupNamePath,
 		GroupName:     p.GroupName,
 		Description:   p.Description,
 		Status:        p.Status,
+
+		InactivityExempt: p.InactivityExempt,
+		MembershipRule:   p.MembershipRule,
+		MaxMembers:       uint32(p.MaxMembers),
+		MaxChildGroups:   uint32(p.MaxChildGroups),
+		MaxDepth:         uint32(p.MaxDepth),
 	}
 
 	q.CreateTime, _ = ptypes.TimestampProto(p.CreateTime)
 	q.UpdateTime, _ = ptypes.TimestampProto(p.UpdateTime)
 	q.StatusTime, _ = ptypes.TimestampProto(p.StatusTime)
 
-	if p.Extra != nil && *p.Extra != "" {
-		if q.Extra == nil {
-			q.Extra = make(map[string]string)
-		}
-		err := jsonutil.Decode([]byte(*p.Extra), &q.Extra)
-		if err != nil {
-			return q, err
-		}
+	extra, err := DecodeExtra(p.Extra)
+	if err != nil {
+		return q, err
 	}
+	q.Extra = extra
 	return q, nil
 }
 
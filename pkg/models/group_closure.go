@@ -0,0 +1,42 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+// GroupClosure relates a group to each of its ancestors, the group itself included at depth 0.
+type GroupClosure struct {
+	AncestorGroupId   string `gorm:"type:varchar(50);primary_key"`
+	DescendantGroupId string `gorm:"type:varchar(50);primary_key"`
+	Depth             int
+}
+
+// NewGroupClosures returns the closure rows of a new group, parentClosures are the rows
+// whose descendant is the parent of the group.
+func NewGroupClosures(groupId string, parentClosures []*GroupClosure) []*GroupClosure {
+	closures := []*GroupClosure{{
+		AncestorGroupId:   groupId,
+		DescendantGroupId: groupId,
+		Depth:             0,
+	}}
+	for _, closure := range parentClosures {
+		closures = append(closures, &GroupClosure{
+			AncestorGroupId:   closure.AncestorGroupId,
+			DescendantGroupId: groupId,
+			Depth:             closure.Depth + 1,
+		})
+	}
+	return closures
+}
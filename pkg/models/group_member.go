@@ -0,0 +1,58 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/pb"
+	"kubesphere.io/im/pkg/util/idutil"
+)
+
+// GroupMember binds a group as a member of another group, the users of the member
+// group are effectively in the group besides the hierarchy.
+type GroupMember struct {
+	Id            string    `gorm:"type:varchar(50);primary_key"`
+	GroupId       string    `gorm:"type:varchar(50);not null"`
+	MemberGroupId string    `gorm:"type:varchar(50);not null"`
+	CreateTime    time.Time `gorm:"default CURRENT_TIMESTAMP"`
+}
+
+func NewGroupMember(groupId, memberGroupId string) *GroupMember {
+	return &GroupMember{
+		Id:            idutil.GetUuid(constants.PrefixGroupMemberId),
+		GroupId:       groupId,
+		MemberGroupId: memberGroupId,
+		CreateTime:    time.Now(),
+	}
+}
+
+func (p *GroupMember) ToPB() *pb.GroupMember {
+	if p == nil {
+		return new(pb.GroupMember)
+	}
+	var q = &pb.GroupMember{
+		Id:            p.Id,
+		GroupId:       p.GroupId,
+		MemberGroupId: p.MemberGroupId,
+	}
+	q.CreateTime, _ = ptypes.TimestampProto(p.CreateTime)
+	return q
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/pb"
+	"kubesphere.io/im/pkg/util/idutil"
+)
+
+// LoginHistory records the outcome of a password check of the user.
+type LoginHistory struct {
+	LoginId    string `gorm:"primary_key"`
+	UserId     string `gorm:"type:varchar(50);not null"`
+	Success    bool   `gorm:"not null"`
+	ClientIp   string `gorm:"type:varchar(50);not null"`
+	UserAgent  string `gorm:"type:varchar(255);not null"`
+	CreateTime time.Time
+}
+
+func NewLoginHistory(userId string, success bool, clientIp, userAgent string) *LoginHistory {
+	if len(userAgent) > 255 {
+		userAgent = userAgent[:255]
+	}
+	return &LoginHistory{
+		LoginId:    idutil.GetUuid(constants.PrefixLoginId),
+		UserId:     userId,
+		Success:    success,
+		ClientIp:   clientIp,
+		UserAgent:  userAgent,
+		CreateTime: time.Now(),
+	}
+}
+
+func (p *LoginHistory) ToPB() *pb.LoginHistory {
+	if p == nil {
+		return new(pb.LoginHistory)
+	}
+	var q = &pb.LoginHistory{
+		LoginId:   p.LoginId,
+		UserId:    p.UserId,
+		Success:   p.Success,
+		ClientIp:  p.ClientIp,
+		UserAgent: p.UserAgent,
+	}
+	q.CreateTime, _ = ptypes.TimestampProto(p.CreateTime)
+	return q
+}
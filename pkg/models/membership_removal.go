@@ -0,0 +1,63 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"kubesphere.io/im/pkg/pb"
+)
+
+// MembershipRemoval records why a group membership was removed by the service.
+type MembershipRemoval struct {
+	BindingId  string `gorm:"primary_key"`
+	GroupId    string `gorm:"type:varchar(50);not null"`
+	UserId     string `gorm:"type:varchar(50);not null"`
+	Reason     string `gorm:"type:varchar(50);not null"`
+	ExpireTime *time.Time
+	CreateTime time.Time
+}
+
+func NewMembershipRemoval(binding *UserGroupBinding, reason string) *MembershipRemoval {
+	return &MembershipRemoval{
+		BindingId:  binding.Id,
+		GroupId:    binding.GroupId,
+		UserId:     binding.UserId,
+		Reason:     reason,
+		ExpireTime: binding.ExpireTime,
+		CreateTime: time.Now(),
+	}
+}
+
+func (p *MembershipRemoval) ToPB() *pb.MembershipRemoval {
+	if p == nil {
+		return new(pb.MembershipRemoval)
+	}
+	var q = &pb.MembershipRemoval{
+		BindingId: p.BindingId,
+		GroupId:   p.GroupId,
+		UserId:    p.UserId,
+		Reason:    p.Reason,
+	}
+	if p.ExpireTime != nil {
+		q.ExpireTime, _ = ptypes.TimestampProto(*p.ExpireTime)
+	}
+	q.CreateTime, _ = ptypes.TimestampProto(p.CreateTime)
+	return q
+}
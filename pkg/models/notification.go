@@ -0,0 +1,78 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/pb"
+	"kubesphere.io/im/pkg/util/idutil"
+)
+
+// Notification is a message in the outbox, it is kept pending until
+// it is delivered or runs out of attempts.
+type Notification struct {
+	NotificationId  string `gorm:"primary_key"`
+	Kind            string `gorm:"type:varchar(50);not null"`
+	Recipient       string `gorm:"type:varchar(255);not null"`
+	Subject         string `gorm:"type:varchar(255);not null"`
+	Body            string `gorm:"type:text;not null"`
+	Status          string `gorm:"type:varchar(50);not null"`
+	Attempts        int    `gorm:"not null"`
+	LastError       string `gorm:"type:varchar(1000);not null"`
+	NextAttemptTime time.Time
+	SendTime        *time.Time
+	CreateTime      time.Time
+	StatusTime      time.Time
+}
+
+func NewNotification(kind, recipient, subject, body string) *Notification {
+	now := time.Now()
+	return &Notification{
+		NotificationId:  idutil.GetUuid(constants.PrefixNotificationId),
+		Kind:            kind,
+		Recipient:       recipient,
+		Subject:         subject,
+		Body:            body,
+		Status:          constants.NotificationStatusPending,
+		NextAttemptTime: now,
+		CreateTime:      now,
+		StatusTime:      now,
+	}
+}
+
+// ToPB returns the metadata of the notification without the body.
+func (p *Notification) ToPB() *pb.Notification {
+	if p == nil {
+		return new(pb.Notification)
+	}
+	var q = &pb.Notification{
+		NotificationId: p.NotificationId,
+		Kind:           p.Kind,
+		Recipient:      p.Recipient,
+		Subject:        p.Subject,
+		Status:         p.Status,
+	}
+	q.CreateTime, _ = ptypes.TimestampProto(p.CreateTime)
+	if p.SendTime != nil {
+		q.SendTime, _ = ptypes.TimestampProto(*p.SendTime)
+	}
+	return q
+}
@@ -31,8 +31,8 @@ import (
 
 type User struct {
 	UserId      string `gorm:"primary_key"`
-	Username    string `gorm:"type:varchar(50);not null;unique;"`
-	Email       string `gorm:"type:varchar(50);not null;unique"`
+	Username    string `gorm:"type:varchar(50);not null"`
+	Email       string `gorm:"type:varchar(50);not null"`
 	PhoneNumber string `gorm:"type:varchar(50);not null"`
 	Description string `gorm:"type:varchar(1000);not null"`
 	Password    string `gorm:"type:varchar(128);not null"`
@@ -41,11 +41,29 @@ type User struct {
 	UpdateTime  time.Time
 	StatusTime  time.Time
 	Extra       *string `gorm:"type:JSON"`
+
+	EmailVerified   bool
+	EmailVerifyTime *time.Time
+	PhoneVerified   bool
+	PhoneVerifyTime *time.Time
+
+	DisplayName      string `gorm:"type:varchar(100);not null"`
+	GivenName        string `gorm:"type:varchar(50);not null"`
+	FamilyName       string `gorm:"type:varchar(50);not null"`
+	Locale           string `gorm:"type:varchar(50);not null"`
+	Timezone         string `gorm:"type:varchar(50);not null"`
+	AvatarUpdateTime *time.Time
+	LastLoginTime    *time.Time
+
+	InactivityWarnTime *time.Time
+	MergedInto         string `gorm:"type:varchar(50);not null"`
+	AnonymizeTime      *time.Time
 }
 
 type UserWithGroup struct {
-	User   *User
-	Groups []*Group
+	User     *User
+	Groups   []*Group
+	Bindings []*UserGroupBinding
 }
 
 func (p *UserWithGroup) ToPB() *pb.UserWithGroup {
@@ -53,9 +71,14 @@ func (p *UserWithGroup) ToPB() *pb.UserWithGroup {
 	for _, group := range p.Groups {
 		pbGroups = append(pbGroups, group.ToPB())
 	}
+	var pbBindings []*pb.UserGroupBinding
+	for _, binding := range p.Bindings {
+		pbBindings = append(pbBindings, binding.ToPB())
+	}
 	return &pb.UserWithGroup{
-		User:     p.User.ToPB(),
-		GroupSet: pbGroups,
+		User:       p.User.ToPB(),
+		GroupSet:   pbGroups,
+		BindingSet: pbBindings,
 	}
 }
 
@@ -88,6 +111,55 @@ func NewUser(username, email, phoneNumber, description, password string, extra m
 	return user
 }
 
+// RuleFields are the columns of the user that membership rules of dynamic groups refer to,
+// besides the keys of the extra as "extra.<key>".
+var RuleFields = []string{
+	constants.ColumnUsername,
+	constants.ColumnEmail,
+	constants.ColumnPhoneNumber,
+	constants.ColumnDescription,
+	constants.ColumnStatus,
+	constants.ColumnDisplayName,
+	constants.ColumnGivenName,
+	constants.ColumnFamilyName,
+	constants.ColumnLocale,
+	constants.ColumnTimezone,
+}
+
+// GetRuleFields returns the values the membership rules of dynamic groups are evaluated with.
+func (p *User) GetRuleFields() map[string]string {
+	fields := map[string]string{
+		constants.ColumnUsername:    p.Username,
+		constants.ColumnEmail:       p.Email,
+		constants.ColumnPhoneNumber: p.PhoneNumber,
+		constants.ColumnDescription: p.Description,
+		constants.ColumnStatus:      p.Status,
+		constants.ColumnDisplayName: p.DisplayName,
+		constants.ColumnGivenName:   p.GivenName,
+		constants.ColumnFamilyName:  p.FamilyName,
+		constants.ColumnLocale:      p.Locale,
+		constants.ColumnTimezone:    p.Timezone,
+	}
+	extra, _ := DecodeExtra(p.Extra)
+	for key, value := range extra {
+		fields[constants.ColumnExtra+"."+key] = value
+	}
+	return fields
+}
+
+// GetLastActiveTime returns the time of the last login, or the creation time if the
+// user never logged in; a user enabled again is active since the status changes.
+func (p *User) GetLastActiveTime() time.Time {
+	lastActiveTime := p.CreateTime
+	if p.LastLoginTime != nil {
+		lastActiveTime = *p.LastLoginTime
+	}
+	if p.StatusTime.After(lastActiveTime) {
+		lastActiveTime = p.StatusTime
+	}
+	return lastActiveTime
+}
+
 func (p *User) ToPB() *pb.User {
 	q, _ := p.ToProtoMessage()
 	return q
@@ -110,14 +182,35 @@ func (p *User) ToProtoMessage() (*pb.User, error) {
 	q.UpdateTime, _ = ptypes.TimestampProto(p.UpdateTime)
 	q.StatusTime, _ = ptypes.TimestampProto(p.StatusTime)
 
-	if p.Extra != nil && *p.Extra != "" {
-		if q.Extra == nil {
-			q.Extra = make(map[string]string)
-		}
-		err := jsonutil.Decode([]byte(*p.Extra), &q.Extra)
-		if err != nil {
-			return q, err
-		}
+	q.EmailVerified = p.EmailVerified
+	if p.EmailVerifyTime != nil {
+		q.EmailVerifyTime, _ = ptypes.TimestampProto(*p.EmailVerifyTime)
+	}
+	q.PhoneVerified = p.PhoneVerified
+	if p.PhoneVerifyTime != nil {
+		q.PhoneVerifyTime, _ = ptypes.TimestampProto(*p.PhoneVerifyTime)
+	}
+
+	q.DisplayName = p.DisplayName
+	q.GivenName = p.GivenName
+	q.FamilyName = p.FamilyName
+	q.Locale = p.Locale
+	q.Timezone = p.Timezone
+	if p.AvatarUpdateTime != nil {
+		q.AvatarUpdateTime, _ = ptypes.TimestampProto(*p.AvatarUpdateTime)
+	}
+	if p.LastLoginTime != nil {
+		q.LastLoginTime, _ = ptypes.TimestampProto(*p.LastLoginTime)
+	}
+	q.MergedInto = p.MergedInto
+	if p.AnonymizeTime != nil {
+		q.AnonymizeTime, _ = ptypes.TimestampProto(*p.AnonymizeTime)
+	}
+
+	extra, err := DecodeExtra(p.Extra)
+	if err != nil {
+		return q, err
 	}
+	q.Extra = extra
 	return q, nil
 }
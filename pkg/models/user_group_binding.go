@@ -19,7 +19,10 @@ package models
 import (
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
+
 	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/pb"
 	"kubesphere.io/im/pkg/util/idutil"
 )
 
@@ -28,6 +31,8 @@ type UserGroupBinding struct {
 	GroupId    string    `gorm:"type:varchar(50);not null"`
 	UserId     string    `gorm:"type:varchar(50);not null"`
 	CreateTime time.Time `gorm:"default CURRENT_TIMESTAMP"`
+	// the binding is removed by the sweeper once expired, nil never expires
+	ExpireTime *time.Time
 }
 
 func NewUserGroupBinding(userId, groupId string) *UserGroupBinding {
@@ -38,3 +43,19 @@ func NewUserGroupBinding(userId, groupId string) *UserGroupBinding {
 		CreateTime: time.Now(),
 	}
 }
+
+func (p *UserGroupBinding) ToPB() *pb.UserGroupBinding {
+	if p == nil {
+		return new(pb.UserGroupBinding)
+	}
+	var q = &pb.UserGroupBinding{
+		BindingId: p.Id,
+		GroupId:   p.GroupId,
+		UserId:    p.UserId,
+	}
+	q.CreateTime, _ = ptypes.TimestampProto(p.CreateTime)
+	if p.ExpireTime != nil {
+		q.ExpireTime, _ = ptypes.TimestampProto(*p.ExpireTime)
+	}
+	return q
+}
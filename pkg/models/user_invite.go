@@ -0,0 +1,86 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/pb"
+	"kubesphere.io/im/pkg/util/idutil"
+	"kubesphere.io/im/pkg/util/jsonutil"
+	"kubesphere.io/im/pkg/util/stringutil"
+)
+
+// UserInvite holds a pending user until the invite token is accepted,
+// GroupIds are the groups the user joins on acceptance.
+type UserInvite struct {
+	InviteId   string  `gorm:"primary_key"`
+	UserId     string  `gorm:"type:varchar(50);not null"`
+	Email      string  `gorm:"type:varchar(50);not null"`
+	GroupIds   *string `gorm:"type:JSON"`
+	TokenHash  string  `gorm:"type:varchar(64);not null;unique"`
+	Status     string  `gorm:"type:varchar(50);not null"`
+	ExpireTime time.Time
+	CreateTime time.Time
+	StatusTime time.Time
+}
+
+// NewUserInvite returns the record to be saved and the plain token
+// to be delivered to the invitee.
+func NewUserInvite(userId, email string, groupIds []string, expire time.Duration) (*UserInvite, string) {
+	token := idutil.GetSecret()
+	now := time.Now()
+	return &UserInvite{
+		InviteId:   idutil.GetUuid(constants.PrefixInviteId),
+		UserId:     userId,
+		Email:      email,
+		GroupIds:   stringutil.NewString(jsonutil.ToString(groupIds)),
+		TokenHash:  GetTokenHash(token),
+		Status:     constants.InviteStatusPending,
+		ExpireTime: now.Add(expire),
+		CreateTime: now,
+		StatusTime: now,
+	}, token
+}
+
+func (p *UserInvite) GetGroupIds() []string {
+	var groupIds []string
+	if p.GroupIds != nil && *p.GroupIds != "" {
+		jsonutil.Decode([]byte(*p.GroupIds), &groupIds)
+	}
+	return groupIds
+}
+
+func (p *UserInvite) ToPB() *pb.Invite {
+	if p == nil {
+		return new(pb.Invite)
+	}
+	var q = &pb.Invite{
+		InviteId: p.InviteId,
+		UserId:   p.UserId,
+		Email:    p.Email,
+		GroupId:  p.GetGroupIds(),
+		Status:   p.Status,
+	}
+	q.ExpireTime, _ = ptypes.TimestampProto(p.ExpireTime)
+	q.CreateTime, _ = ptypes.TimestampProto(p.CreateTime)
+	q.StatusTime, _ = ptypes.TimestampProto(p.StatusTime)
+	return q
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/pb"
+	"kubesphere.io/im/pkg/util/idutil"
+)
+
+// UserVerification is a single-use token that proves the user owns Target,
+// only the hash of the token is stored.
+type UserVerification struct {
+	Id          string `gorm:"type:varchar(50);primary_key"`
+	UserId      string `gorm:"type:varchar(50);not null"`
+	Kind        string `gorm:"type:varchar(50);not null"`
+	Target      string `gorm:"type:varchar(255);not null"`
+	TokenHash   string `gorm:"type:varchar(64);not null;unique"`
+	ExpireTime  time.Time
+	ConsumeTime *time.Time
+	CreateTime  time.Time
+	Attempts    int `gorm:"not null"`
+}
+
+func GetTokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func newUserVerification(userId, kind, target string, expire time.Duration) *UserVerification {
+	now := time.Now()
+	return &UserVerification{
+		Id:         idutil.GetUuid(constants.PrefixUserVerificationId),
+		UserId:     userId,
+		Kind:       kind,
+		Target:     target,
+		ExpireTime: now.Add(expire),
+		CreateTime: now,
+	}
+}
+
+// NewUserVerification returns the record to be saved and the plain token
+// to be delivered to the user.
+func NewUserVerification(userId, kind, target string, expire time.Duration) (*UserVerification, string) {
+	token := idutil.GetSecret()
+	verification := newUserVerification(userId, kind, target, expire)
+	verification.TokenHash = GetTokenHash(token)
+	return verification, token
+}
+
+// NewUserPhoneVerification returns the record to be saved and the numeric
+// code to be sent to phoneNumber.
+func NewUserPhoneVerification(userId, phoneNumber string, codeLength int, expire time.Duration) (*UserVerification, string) {
+	code := idutil.GetNumericCode(codeLength)
+	verification := newUserVerification(userId, constants.VerificationKindPhone, phoneNumber, expire)
+	verification.TokenHash = verification.GetCodeHash(code)
+	return verification, code
+}
+
+// codes are short and repeatable, so they are salted with the verification id
+func (p *UserVerification) GetCodeHash(code string) string {
+	return GetTokenHash(p.Id + ":" + code)
+}
+
+// ToPB returns the metadata of the verification without the token hash.
+func (p *UserVerification) ToPB() *pb.Token {
+	if p == nil {
+		return new(pb.Token)
+	}
+	var q = &pb.Token{
+		Id:       p.Id,
+		Kind:     p.Kind,
+		Target:   p.Target,
+		Attempts: uint32(p.Attempts),
+	}
+	q.CreateTime, _ = ptypes.TimestampProto(p.CreateTime)
+	q.ExpireTime, _ = ptypes.TimestampProto(p.ExpireTime)
+	if p.ConsumeTime != nil {
+		q.ConsumeTime, _ = ptypes.TimestampProto(*p.ConsumeTime)
+	}
+	return q
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/config"
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/models"
+	"kubesphere.io/im/pkg/util/stringutil"
+)
+
+// Notifier renders messages into the outbox and delivers them in background,
+// a message that fails to send is retried with exponential backoff.
+type Notifier struct {
+	db        *gorm.DB
+	sender    MailSender
+	templates *Templates
+	cfg       config.NotifierConfig
+}
+
+func NewNotifier(db *gorm.DB, sender MailSender, cfg config.NotifierConfig) *Notifier {
+	return &Notifier{
+		db:        db,
+		sender:    sender,
+		templates: NewTemplates(cfg.TemplateDir, cfg.DefaultLocale),
+		cfg:       cfg,
+	}
+}
+
+// SendMail saves the rendered mail into the outbox, it is delivered by Serve.
+func (n *Notifier) SendMail(ctx context.Context, to, templateName, locale string, data interface{}) (*models.Notification, error) {
+	return n.SendMailTx(ctx, n.db, to, templateName, locale, data)
+}
+
+// SendMailTx saves the rendered mail into the outbox within tx,
+// so the mail is delivered only if tx is committed.
+func (n *Notifier) SendMailTx(ctx context.Context, tx *gorm.DB, to, templateName, locale string, data interface{}) (*models.Notification, error) {
+	subject, body, err := n.templates.Render(templateName, locale, data)
+	if err != nil {
+		logger.Errorf(ctx, "Render template [%s] failed: %+v", templateName, err)
+		return nil, err
+	}
+
+	notification := models.NewNotification(constants.NotificationKindEmail, to, subject, body)
+	if err := tx.Create(notification).Error; err != nil {
+		logger.Errorf(ctx, "Insert notification failed: %+v", err)
+		return nil, err
+	}
+	return notification, nil
+}
+
+func (n *Notifier) Serve(ctx context.Context) {
+	ticker := time.NewTicker(n.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		if err := n.ProcessOutbox(ctx); err != nil {
+			logger.Errorf(ctx, "Process notification outbox failed: %+v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessOutbox sends one batch of due notifications.
+func (n *Notifier) ProcessOutbox(ctx context.Context) error {
+	var notifications []*models.Notification
+	if err := n.db.Table(constants.TableNotification).
+		Where(constants.ColumnStatus+" = ?", constants.NotificationStatusPending).
+		Where(constants.ColumnNextAttemptTime+" <= ?", time.Now()).
+		Order(constants.ColumnNextAttemptTime).
+		Limit(n.cfg.BatchSize).
+		Find(&notifications).Error; err != nil {
+		return err
+	}
+
+	for _, notification := range notifications {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := n.deliver(ctx, notification); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *Notifier) deliver(ctx context.Context, notification *models.Notification) error {
+	// claim the notification and schedule the next attempt before sending,
+	// so a crash during sending retries later instead of losing the message
+	attempts := notification.Attempts + 1
+	result := n.db.Table(constants.TableNotification).
+		Where(constants.ColumnNotificationId+" = ?", notification.NotificationId).
+		Where(constants.ColumnStatus+" = ?", constants.NotificationStatusPending).
+		Where(constants.ColumnAttempts+" = ?", notification.Attempts).
+		Updates(map[string]interface{}{
+			constants.ColumnAttempts:        attempts,
+			constants.ColumnNextAttemptTime: time.Now().Add(GetBackoff(n.cfg.RetryBackoff, n.cfg.MaxRetryBackoff, attempts)),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// claimed by another instance
+		return nil
+	}
+
+	err := n.sender.Send(ctx, &Mail{
+		To:      notification.Recipient,
+		Subject: notification.Subject,
+		Body:    notification.Body,
+	})
+
+	// the body holds plaintext tokens, it is cleared once the notification is done
+	now := time.Now()
+	attributes := make(map[string]interface{})
+	if err == nil {
+		attributes[constants.ColumnBody] = ""
+		attributes[constants.ColumnStatus] = constants.NotificationStatusSent
+		attributes[constants.ColumnStatusTime] = now
+		attributes[constants.ColumnSendTime] = now
+		attributes[constants.ColumnLastError] = ""
+	} else {
+		logger.Warnf(ctx, "Send notification [%s] failed, attempts [%d]: %+v", notification.NotificationId, attempts, err)
+		attributes[constants.ColumnLastError] = stringutil.Truncate(err.Error(), 1000)
+		if attempts >= n.cfg.MaxAttempts {
+			logger.Errorf(ctx, "Notification [%s] failed after [%d] attempts", notification.NotificationId, attempts)
+			attributes[constants.ColumnStatus] = constants.NotificationStatusFailed
+			attributes[constants.ColumnBody] = ""
+			attributes[constants.ColumnStatusTime] = now
+		}
+	}
+
+	return n.db.Table(constants.TableNotification).
+		Where(constants.ColumnNotificationId+" = ?", notification.NotificationId).
+		Updates(attributes).Error
+}
+
+// GetBackoff returns the delay before the next attempt, it doubles on every
+// failed attempt and never exceeds max.
+func GetBackoff(base, max time.Duration, attempts int) time.Duration {
+	backoff := base
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= max {
+			return max
+		}
+	}
+	if backoff > max {
+		return max
+	}
+	return backoff
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+
+	"kubesphere.io/im/pkg/config"
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/models"
+	. "kubesphere.io/im/pkg/util/assert"
+)
+
+func TestGetBackoff(t *testing.T) {
+	var tests = []struct {
+		attempts int
+		expect   time.Duration
+	}{
+		{attempts: 1, expect: 30 * time.Second},
+		{attempts: 2, expect: time.Minute},
+		{attempts: 3, expect: 2 * time.Minute},
+		{attempts: 8, expect: time.Hour},
+		{attempts: 100, expect: time.Hour},
+	}
+	for _, v := range tests {
+		got := GetBackoff(30*time.Second, time.Hour, v.attempts)
+		Assertf(t, got == v.expect, "attempts = %d, expect = %s, got = %s", v.attempts, v.expect, got)
+	}
+}
+
+type fakeSender struct {
+	err   error
+	mails []*Mail
+}
+
+func (s *fakeSender) Send(ctx context.Context, m *Mail) error {
+	s.mails = append(s.mails, m)
+	return s.err
+}
+
+func newTestNotifier(t *testing.T, sender MailSender) *Notifier {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	Assert(t, err == nil, err)
+	// every connection opens another in-memory database
+	db.DB().SetMaxOpenConns(1)
+	db.SingularTable(true)
+	Assert(t, db.AutoMigrate(&models.Notification{}).Error == nil)
+
+	return NewNotifier(db, sender, config.NotifierConfig{
+		BatchSize:       10,
+		RetryBackoff:    time.Minute,
+		MaxRetryBackoff: time.Hour,
+		MaxAttempts:     2,
+	})
+}
+
+func getTestNotification(t *testing.T, n *Notifier, notificationId string) *models.Notification {
+	notification := &models.Notification{NotificationId: notificationId}
+	Assert(t, n.db.Take(notification).Error == nil)
+	return notification
+}
+
+func TestProcessOutboxSent(t *testing.T) {
+	sender := &fakeSender{}
+	n := newTestNotifier(t, sender)
+	defer n.db.Close()
+	notification := models.NewNotification(constants.NotificationKindEmail, "a@op.com", "subject", "body")
+	Assert(t, n.db.Create(notification).Error == nil)
+
+	Assert(t, n.ProcessOutbox(context.Background()) == nil)
+	Assert(t, len(sender.mails) == 1)
+	Assert(t, sender.mails[0].To == "a@op.com" && sender.mails[0].Body == "body")
+
+	got := getTestNotification(t, n, notification.NotificationId)
+	Assertf(t, got.Status == constants.NotificationStatusSent, "status = %s", got.Status)
+	Assert(t, got.Attempts == 1)
+	Assert(t, got.SendTime != nil)
+	Assert(t, got.Body == "", "body is kept after sent")
+
+	// a sent notification is not delivered again
+	Assert(t, n.ProcessOutbox(context.Background()) == nil)
+	Assert(t, len(sender.mails) == 1)
+}
+
+func TestProcessOutboxRetryFailed(t *testing.T) {
+	sender := &fakeSender{err: errors.New("smtp down")}
+	n := newTestNotifier(t, sender)
+	defer n.db.Close()
+	notification := models.NewNotification(constants.NotificationKindEmail, "a@op.com", "subject", "body")
+	Assert(t, n.db.Create(notification).Error == nil)
+
+	Assert(t, n.ProcessOutbox(context.Background()) == nil)
+	got := getTestNotification(t, n, notification.NotificationId)
+	Assertf(t, got.Status == constants.NotificationStatusPending, "status = %s", got.Status)
+	Assert(t, got.Attempts == 1)
+	Assert(t, got.LastError == "smtp down")
+	Assert(t, got.Body == "body", "body is cleared before retry")
+	Assert(t, got.NextAttemptTime.After(time.Now()))
+
+	// the retry is not due before the backoff
+	Assert(t, n.ProcessOutbox(context.Background()) == nil)
+	Assert(t, len(sender.mails) == 1)
+
+	Assert(t, n.db.Table(constants.TableNotification).
+		Where(constants.ColumnNotificationId+" = ?", notification.NotificationId).
+		UpdateColumn(constants.ColumnNextAttemptTime, time.Now().Add(-time.Second)).Error == nil)
+	Assert(t, n.ProcessOutbox(context.Background()) == nil)
+	Assert(t, len(sender.mails) == 2)
+	got = getTestNotification(t, n, notification.NotificationId)
+	Assertf(t, got.Status == constants.NotificationStatusFailed, "status = %s", got.Status)
+	Assert(t, got.Attempts == 2)
+	Assert(t, got.Body == "", "body is kept after failed")
+
+	// a failed notification is given up
+	Assert(t, n.db.Table(constants.TableNotification).
+		Where(constants.ColumnNotificationId+" = ?", notification.NotificationId).
+		UpdateColumn(constants.ColumnNextAttemptTime, time.Now().Add(-time.Second)).Error == nil)
+	Assert(t, n.ProcessOutbox(context.Background()) == nil)
+	Assert(t, len(sender.mails) == 2)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"io/ioutil"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"kubesphere.io/im/pkg/config"
+	"kubesphere.io/im/pkg/notifier/smtptest"
+)
+
+func TestSmtpSender(t *testing.T) {
+	server, err := smtptest.NewServer()
+	require.NoError(t, err)
+	defer server.Close()
+
+	sender := NewSmtpSender(config.SmtpConfig{
+		Host: server.Host(),
+		Port: server.Port(),
+		From: "noreply@kubesphere.io",
+	})
+
+	ctx := context.Background()
+	m := &Mail{
+		To:      "test@op.com",
+		Subject: "验证您的邮箱地址",
+		Body:    "Hello test,\n\ntoken: abc\n",
+	}
+	require.NoError(t, sender.Send(ctx, m))
+
+	messages := server.Messages()
+	require.Len(t, messages, 1)
+	require.Equal(t, "noreply@kubesphere.io", messages[0].From)
+	require.Equal(t, []string{"test@op.com"}, messages[0].To)
+
+	msg, err := mail.ReadMessage(strings.NewReader(messages[0].Data))
+	require.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	require.Equal(t, m.Subject, subject)
+	body, err := ioutil.ReadAll(quotedprintable.NewReader(msg.Body))
+	require.NoError(t, err)
+	require.Equal(t, m.Body, string(body))
+
+	// transient failure is reported to the caller
+	server.FailNext(1)
+	require.Error(t, sender.Send(ctx, m))
+	require.NoError(t, sender.Send(ctx, m))
+	require.Len(t, server.Messages(), 2)
+}
+
+func TestSmtpSenderTimeout(t *testing.T) {
+	// the server accepts the connection but never greets
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	sender := NewSmtpSender(config.SmtpConfig{
+		Host:    "127.0.0.1",
+		Port:    listener.Addr().(*net.TCPAddr).Port,
+		From:    "noreply@kubesphere.io",
+		Timeout: 100 * time.Millisecond,
+	})
+
+	start := time.Now()
+	err = sender.Send(context.Background(), &Mail{To: "test@op.com", Subject: "subject", Body: "body"})
+	require.Error(t, err)
+	require.True(t, time.Since(start) < 5*time.Second, "send is not bounded by the timeout")
+}
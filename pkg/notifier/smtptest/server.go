@@ -0,0 +1,192 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package smtptest provides an in-process SMTP server for testing mail delivery.
+package smtptest
+
+import (
+	"io/ioutil"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type Message struct {
+	From string
+	To   []string
+	Data string
+}
+
+// Server accepts every mail and keeps it in memory, it supports
+// plain SMTP only, without AUTH and STARTTLS.
+type Server struct {
+	listener net.Listener
+	mutex    sync.Mutex
+	messages []*Message
+	failNext int
+	wg       sync.WaitGroup
+}
+
+// NewServer starts a server listening on a random local port.
+func NewServer() (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{listener: listener}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+func (s *Server) Host() string {
+	return s.listener.Addr().(*net.TCPAddr).IP.String()
+}
+
+func (s *Server) Port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+// Messages returns the accepted messages in order of arrival.
+func (s *Server) Messages() []*Message {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]*Message(nil), s.messages...)
+}
+
+// FailNext rejects the next n messages with a transient error.
+func (s *Server) FailNext(n int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.failNext = n
+}
+
+func (s *Server) Close() error {
+	err := s.listener.Close()
+	s.wg.Wait()
+	return err
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handle(conn)
+		}()
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer conn.Close()
+
+	c := textproto.NewConn(conn)
+	reply := func(code int, msg string) bool {
+		return c.PrintfLine("%d %s", code, msg) == nil
+	}
+
+	if !reply(220, "smtptest ESMTP") {
+		return
+	}
+
+	var current *Message
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg := line, ""
+		if i := strings.IndexByte(line, ' '); i >= 0 {
+			verb, arg = line[:i], strings.TrimSpace(line[i+1:])
+		}
+
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			if c.PrintfLine("250-smtptest") != nil || !reply(250, "8BITMIME") {
+				return
+			}
+		case "HELO", "NOOP":
+			reply(250, "OK")
+		case "RSET":
+			current = nil
+			reply(250, "OK")
+		case "MAIL":
+			current = &Message{From: getAddress(arg, "FROM:")}
+			reply(250, "OK")
+		case "RCPT":
+			if current == nil {
+				reply(503, "need MAIL command")
+				continue
+			}
+			current.To = append(current.To, getAddress(arg, "TO:"))
+			reply(250, "OK")
+		case "DATA":
+			if current == nil || len(current.To) == 0 {
+				reply(503, "need RCPT command")
+				continue
+			}
+			if !reply(354, "end data with <CR><LF>.<CR><LF>") {
+				return
+			}
+			data, err := ioutil.ReadAll(c.DotReader())
+			if err != nil {
+				return
+			}
+			current.Data = string(data)
+			if s.accept(current) {
+				reply(250, "OK")
+			} else {
+				reply(451, "temporary failure")
+			}
+			current = nil
+		case "QUIT":
+			reply(221, "bye")
+			return
+		default:
+			reply(502, "command "+strconv.Quote(verb)+" not implemented")
+		}
+	}
+}
+
+func (s *Server) accept(m *Message) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.failNext > 0 {
+		s.failNext--
+		return false
+	}
+	s.messages = append(s.messages, m)
+	return true
+}
+
+// "FROM:<a@b.com> SIZE=10" => "a@b.com"
+func getAddress(arg, prefix string) string {
+	if len(arg) >= len(prefix) && strings.EqualFold(arg[:len(prefix)], prefix) {
+		arg = arg[len(prefix):]
+	}
+	arg = strings.TrimSpace(arg)
+	if i := strings.IndexByte(arg, ' '); i >= 0 {
+		arg = arg[:i]
+	}
+	return strings.Trim(arg, "<>")
+}
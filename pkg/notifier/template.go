@@ -0,0 +1,167 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"kubesphere.io/im/pkg/constants"
+)
+
+// A template defines "subject" and "body", for example:
+//
+//	{{define "subject"}}Hello{{end}}
+//	{{define "body"}}Hello {{.Username}}{{end}}
+var builtinTemplates = map[string]map[string]string{
+	"en": {
+		constants.TemplateEmailVerification: `{{define "subject"}}Verify your email address{{end}}
+{{define "body"}}Hello {{.Username}},
+
+Please use the following token to verify your email address {{.Email}}:
+
+{{.Token}}
+
+The token expires at {{.ExpireTime.Format "2006-01-02 15:04:05 MST"}}.
+{{end}}`,
+		constants.TemplateUserInvite: `{{define "subject"}}You have been invited{{end}}
+{{define "body"}}Hello,
+
+You have been invited to create an account with {{.Email}}.
+Please use the following token to accept the invite and choose your username and password:
+
+{{.Token}}
+
+The invite expires at {{.ExpireTime.Format "2006-01-02 15:04:05 MST"}}.
+{{end}}`,
+		constants.TemplateInactivityWarning: `{{define "subject"}}Your account will be disabled{{end}}
+{{define "body"}}Hello {{.Username}},
+
+Your account has not been used for a long time and will be disabled at {{.DisableTime.Format "2006-01-02 15:04:05 MST"}}.
+Please login before then to keep it active.
+{{end}}`,
+	},
+	"zh": {
+		constants.TemplateEmailVerification: `{{define "subject"}}验证您的邮箱地址{{end}}
+{{define "body"}}{{.Username}}，您好：
+
+请使用以下令牌验证您的邮箱地址 {{.Email}}：
+
+{{.Token}}
+
+令牌将于 {{.ExpireTime.Format "2006-01-02 15:04:05 MST"}} 过期。
+{{end}}`,
+		constants.TemplateUserInvite: `{{define "subject"}}您收到了一份邀请{{end}}
+{{define "body"}}您好：
+
+您被邀请使用邮箱 {{.Email}} 创建账号，请使用以下令牌接受邀请并设置用户名和密码：
+
+{{.Token}}
+
+邀请将于 {{.ExpireTime.Format "2006-01-02 15:04:05 MST"}} 过期。
+{{end}}`,
+		constants.TemplateInactivityWarning: `{{define "subject"}}您的账号即将被停用{{end}}
+{{define "body"}}{{.Username}}，您好：
+
+您的账号已长时间未使用，将于 {{.DisableTime.Format "2006-01-02 15:04:05 MST"}} 被停用，
+请在此之前登录以保持账号可用。
+{{end}}`,
+	},
+}
+
+type Templates struct {
+	dir           string
+	defaultLocale string
+}
+
+func NewTemplates(dir, defaultLocale string) *Templates {
+	return &Templates{dir: dir, defaultLocale: defaultLocale}
+}
+
+// Render looks up the template by locale, then by its language ("zh" for "zh_CN"),
+// then by the default locale, files in the template dir take precedence over
+// the built-in templates of the same locale.
+func (t *Templates) Render(name, locale string, data interface{}) (subject, body string, err error) {
+	text, err := t.lookup(name, locale)
+	if err != nil {
+		return "", "", err
+	}
+
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", "", fmt.Errorf("parse template [%s] failed: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, "subject", data); err != nil {
+		return "", "", fmt.Errorf("render subject of template [%s] failed: %v", name, err)
+	}
+	subject = strings.TrimSpace(buf.String())
+
+	buf.Reset()
+	if err := tmpl.ExecuteTemplate(&buf, "body", data); err != nil {
+		return "", "", fmt.Errorf("render body of template [%s] failed: %v", name, err)
+	}
+	body = buf.String()
+
+	return subject, body, nil
+}
+
+func (t *Templates) lookup(name, locale string) (string, error) {
+	for _, l := range t.getLocales(locale) {
+		if t.dir != "" {
+			content, err := ioutil.ReadFile(filepath.Join(t.dir, l, name+".tmpl"))
+			if err == nil {
+				return string(content), nil
+			}
+			if !os.IsNotExist(err) {
+				return "", err
+			}
+		}
+		if text, ok := builtinTemplates[l][name]; ok {
+			return text, nil
+		}
+	}
+	return "", fmt.Errorf("template [%s] not found for locale [%s]", name, locale)
+}
+
+func (t *Templates) getLocales(locale string) []string {
+	var locales []string
+	add := func(l string) {
+		if l == "" || strings.ContainsAny(l, `/\.`) {
+			return
+		}
+		for _, exist := range locales {
+			if exist == l {
+				return
+			}
+		}
+		locales = append(locales, l)
+	}
+
+	locale = strings.Replace(locale, "-", "_", -1)
+	add(locale)
+	add(strings.SplitN(locale, "_", 2)[0])
+	add(t.defaultLocale)
+	add(strings.SplitN(t.defaultLocale, "_", 2)[0])
+	return locales
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"kubesphere.io/im/pkg/constants"
+)
+
+func TestTemplates(t *testing.T) {
+	data := map[string]interface{}{
+		"Username":   "test",
+		"Email":      "test@op.com",
+		"Token":      "abc",
+		"ExpireTime": time.Now(),
+	}
+
+	templates := NewTemplates("", "en")
+
+	subject, body, err := templates.Render(constants.TemplateEmailVerification, "", data)
+	require.NoError(t, err)
+	require.Equal(t, "Verify your email address", subject)
+	require.Contains(t, body, "abc")
+
+	// falls back to the language of the locale
+	subject, _, err = templates.Render(constants.TemplateEmailVerification, "zh-CN", data)
+	require.NoError(t, err)
+	require.Equal(t, "验证您的邮箱地址", subject)
+
+	// falls back to the default locale
+	subject, _, err = templates.Render(constants.TemplateEmailVerification, "fr", data)
+	require.NoError(t, err)
+	require.Equal(t, "Verify your email address", subject)
+
+	subject, body, err = templates.Render(constants.TemplateInactivityWarning, "", map[string]interface{}{
+		"Username": "test", "DisableTime": time.Now(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Your account will be disabled", subject)
+	require.Contains(t, body, "test")
+
+	_, _, err = templates.Render("unknown", "en", data)
+	require.Error(t, err)
+
+	_, _, err = templates.Render(constants.TemplateEmailVerification, "en", map[string]interface{}{})
+	require.Error(t, err)
+}
+
+func TestTemplatesOverride(t *testing.T) {
+	dir, err := ioutil.TempDir("", "templates")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "fr"), 0755))
+	require.NoError(t, ioutil.WriteFile(
+		filepath.Join(dir, "fr", constants.TemplateEmailVerification+".tmpl"),
+		[]byte(`{{define "subject"}} Vérifiez votre adresse {{end}}{{define "body"}}Bonjour {{.Username}}{{end}}`),
+		0644,
+	))
+
+	templates := NewTemplates(dir, "en")
+
+	subject, body, err := templates.Render(constants.TemplateEmailVerification, "fr_FR", map[string]string{"Username": "test"})
+	require.NoError(t, err)
+	require.Equal(t, "Vérifiez votre adresse", subject)
+	require.True(t, strings.HasPrefix(body, "Bonjour test"))
+
+	// locales without override still use the built-in templates
+	subject, _, err = templates.Render(constants.TemplateEmailVerification, "zh", map[string]interface{}{
+		"Username": "test", "Email": "test@op.com", "Token": "abc", "ExpireTime": time.Now(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "验证您的邮箱地址", subject)
+}
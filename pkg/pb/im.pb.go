@@ -8,9 +8,13 @@ import (
 	math "math"
 
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	_ "github.com/mwitkow/go-proto-validators"
 	context "golang.org/x/net/context"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
 	grpc "google.golang.org/grpc"
 )
 
@@ -96,13 +100,21 @@ func (m *GetVersionResponse) GetVersion() string {
 }
 
 type CreateGroupRequest struct {
-	ParentGroupId        string            `protobuf:"bytes,1,opt,name=parent_group_id,json=parentGroupId,proto3" json:"parent_group_id,omitempty"`
-	GroupName            string            `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
-	Description          string            `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Extra                map[string]string `protobuf:"bytes,4,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	ParentGroupId    string            `protobuf:"bytes,1,opt,name=parent_group_id,json=parentGroupId,proto3" json:"parent_group_id,omitempty"`
+	GroupName        string            `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Description      string            `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Extra            map[string]string `protobuf:"bytes,4,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	InactivityExempt bool              `protobuf:"varint,5,opt,name=inactivity_exempt,json=inactivityExempt,proto3" json:"inactivity_exempt,omitempty"`
+	// makes a dynamic group whose members are the users matching the rule, see PreviewRule;
+	// the members are added in background once the group is created or the rule is changed
+	MembershipRule string `protobuf:"bytes,6,opt,name=membership_rule,json=membershipRule,proto3" json:"membership_rule,omitempty"`
+	// limits overriding the global defaults, 0 uses the default
+	MaxMembers           uint32   `protobuf:"varint,7,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"`
+	MaxChildGroups       uint32   `protobuf:"varint,8,opt,name=max_child_groups,json=maxChildGroups,proto3" json:"max_child_groups,omitempty"`
+	MaxDepth             uint32   `protobuf:"varint,9,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CreateGroupRequest) Reset()         { *m = CreateGroupRequest{} }
@@ -158,6 +170,41 @@ func (m *CreateGroupRequest) GetExtra() map[string]string {
 	return nil
 }
 
+func (m *CreateGroupRequest) GetInactivityExempt() bool {
+	if m != nil {
+		return m.InactivityExempt
+	}
+	return false
+}
+
+func (m *CreateGroupRequest) GetMembershipRule() string {
+	if m != nil {
+		return m.MembershipRule
+	}
+	return ""
+}
+
+func (m *CreateGroupRequest) GetMaxMembers() uint32 {
+	if m != nil {
+		return m.MaxMembers
+	}
+	return 0
+}
+
+func (m *CreateGroupRequest) GetMaxChildGroups() uint32 {
+	if m != nil {
+		return m.MaxChildGroups
+	}
+	return 0
+}
+
+func (m *CreateGroupRequest) GetMaxDepth() uint32 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
 type CreateGroupResponse struct {
 	GroupId              string   `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -198,7 +245,13 @@ func (m *CreateGroupResponse) GetGroupId() string {
 }
 
 type DeleteGroupsRequest struct {
-	GroupId              []string `protobuf:"bytes,1,rep,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupId []string `protobuf:"bytes,1,rep,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// delete the sub groups too and remove the members instead of failing
+	Cascade bool `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	// with cascade, the removed members join this group if they are not in it yet
+	MoveMembersTo string `protobuf:"bytes,3,opt,name=move_members_to,json=moveMembersTo,proto3" json:"move_members_to,omitempty"`
+	// check and report what would be deleted without deleting it
+	DryRun               bool     `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -236,11 +289,35 @@ func (m *DeleteGroupsRequest) GetGroupId() []string {
 	return nil
 }
 
+func (m *DeleteGroupsRequest) GetCascade() bool {
+	if m != nil {
+		return m.Cascade
+	}
+	return false
+}
+
+func (m *DeleteGroupsRequest) GetMoveMembersTo() string {
+	if m != nil {
+		return m.MoveMembersTo
+	}
+	return ""
+}
+
+func (m *DeleteGroupsRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 type DeleteGroupsResponse struct {
-	GroupId              []string `protobuf:"bytes,1,rep,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	GroupId              []string            `protobuf:"bytes,1,rep,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	BindingSet           []*UserGroupBinding `protobuf:"bytes,2,rep,name=binding_set,json=bindingSet,proto3" json:"binding_set,omitempty"`
+	DryRun               bool                `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	GroupMemberSet       []*GroupMember      `protobuf:"bytes,4,rep,name=group_member_set,json=groupMemberSet,proto3" json:"group_member_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *DeleteGroupsResponse) Reset()         { *m = DeleteGroupsResponse{} }
@@ -275,22 +352,187 @@ func (m *DeleteGroupsResponse) GetGroupId() []string {
 	return nil
 }
 
+func (m *DeleteGroupsResponse) GetBindingSet() []*UserGroupBinding {
+	if m != nil {
+		return m.BindingSet
+	}
+	return nil
+}
+
+func (m *DeleteGroupsResponse) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *DeleteGroupsResponse) GetGroupMemberSet() []*GroupMember {
+	if m != nil {
+		return m.GroupMemberSet
+	}
+	return nil
+}
+
+type GroupMember struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId              string               `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	MemberGroupId        string               `protobuf:"bytes,3,opt,name=member_group_id,json=memberGroupId,proto3" json:"member_group_id,omitempty"`
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GroupMember) Reset()         { *m = GroupMember{} }
+func (m *GroupMember) String() string { return proto.CompactTextString(m) }
+func (*GroupMember) ProtoMessage()    {}
+func (*GroupMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{6}
+}
+
+func (m *GroupMember) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupMember.Unmarshal(m, b)
+}
+func (m *GroupMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupMember.Marshal(b, m, deterministic)
+}
+func (m *GroupMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupMember.Merge(m, src)
+}
+func (m *GroupMember) XXX_Size() int {
+	return xxx_messageInfo_GroupMember.Size(m)
+}
+func (m *GroupMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupMember proto.InternalMessageInfo
+
+func (m *GroupMember) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GroupMember) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *GroupMember) GetMemberGroupId() string {
+	if m != nil {
+		return m.MemberGroupId
+	}
+	return ""
+}
+
+func (m *GroupMember) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+type UserGroupBinding struct {
+	BindingId            string               `protobuf:"bytes,1,opt,name=binding_id,json=bindingId,proto3" json:"binding_id,omitempty"`
+	GroupId              string               `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId               string               `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	ExpireTime           *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UserGroupBinding) Reset()         { *m = UserGroupBinding{} }
+func (m *UserGroupBinding) String() string { return proto.CompactTextString(m) }
+func (*UserGroupBinding) ProtoMessage()    {}
+func (*UserGroupBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{7}
+}
+
+func (m *UserGroupBinding) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserGroupBinding.Unmarshal(m, b)
+}
+func (m *UserGroupBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserGroupBinding.Marshal(b, m, deterministic)
+}
+func (m *UserGroupBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserGroupBinding.Merge(m, src)
+}
+func (m *UserGroupBinding) XXX_Size() int {
+	return xxx_messageInfo_UserGroupBinding.Size(m)
+}
+func (m *UserGroupBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserGroupBinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserGroupBinding proto.InternalMessageInfo
+
+func (m *UserGroupBinding) GetBindingId() string {
+	if m != nil {
+		return m.BindingId
+	}
+	return ""
+}
+
+func (m *UserGroupBinding) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *UserGroupBinding) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *UserGroupBinding) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *UserGroupBinding) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
 type ModifyGroupRequest struct {
-	GroupId              string            `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	ParentGroupId        string            `protobuf:"bytes,2,opt,name=parent_group_id,json=parentGroupId,proto3" json:"parent_group_id,omitempty"`
-	GroupName            string            `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
-	Description          string            `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Extra                map[string]string `protobuf:"bytes,5,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	GroupId       string            `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ParentGroupId string            `protobuf:"bytes,2,opt,name=parent_group_id,json=parentGroupId,proto3" json:"parent_group_id,omitempty"`
+	GroupName     string            `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Description   string            `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Extra         map[string]string `protobuf:"bytes,5,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// fields to write, such as "description" or "extra.key", a listed key missing from extra is deleted;
+	// fields that are not empty are written if absent
+	UpdateMask       *field_mask.FieldMask `protobuf:"bytes,6,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	InactivityExempt bool                  `protobuf:"varint,7,opt,name=inactivity_exempt,json=inactivityExempt,proto3" json:"inactivity_exempt,omitempty"`
+	// an empty rule written by the update mask makes the group static and keeps the members
+	MembershipRule string `protobuf:"bytes,8,opt,name=membership_rule,json=membershipRule,proto3" json:"membership_rule,omitempty"`
+	// a 0 limit written by the update mask restores the global default
+	MaxMembers           uint32   `protobuf:"varint,9,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"`
+	MaxChildGroups       uint32   `protobuf:"varint,10,opt,name=max_child_groups,json=maxChildGroups,proto3" json:"max_child_groups,omitempty"`
+	MaxDepth             uint32   `protobuf:"varint,11,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ModifyGroupRequest) Reset()         { *m = ModifyGroupRequest{} }
 func (m *ModifyGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyGroupRequest) ProtoMessage()    {}
 func (*ModifyGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{6}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{8}
 }
 
 func (m *ModifyGroupRequest) XXX_Unmarshal(b []byte) error {
@@ -346,6 +588,48 @@ func (m *ModifyGroupRequest) GetExtra() map[string]string {
 	return nil
 }
 
+func (m *ModifyGroupRequest) GetUpdateMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+func (m *ModifyGroupRequest) GetInactivityExempt() bool {
+	if m != nil {
+		return m.InactivityExempt
+	}
+	return false
+}
+
+func (m *ModifyGroupRequest) GetMembershipRule() string {
+	if m != nil {
+		return m.MembershipRule
+	}
+	return ""
+}
+
+func (m *ModifyGroupRequest) GetMaxMembers() uint32 {
+	if m != nil {
+		return m.MaxMembers
+	}
+	return 0
+}
+
+func (m *ModifyGroupRequest) GetMaxChildGroups() uint32 {
+	if m != nil {
+		return m.MaxChildGroups
+	}
+	return 0
+}
+
+func (m *ModifyGroupRequest) GetMaxDepth() uint32 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
 type ModifyGroupResponse struct {
 	GroupId              string   `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -357,7 +641,7 @@ func (m *ModifyGroupResponse) Reset()         { *m = ModifyGroupResponse{} }
 func (m *ModifyGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyGroupResponse) ProtoMessage()    {}
 func (*ModifyGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{7}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{9}
 }
 
 func (m *ModifyGroupResponse) XXX_Unmarshal(b []byte) error {
@@ -385,85 +669,202 @@ func (m *ModifyGroupResponse) GetGroupId() string {
 	return ""
 }
 
-type Group struct {
-	ParentGroupId        string               `protobuf:"bytes,1,opt,name=parent_group_id,json=parentGroupId,proto3" json:"parent_group_id,omitempty"`
-	GroupId              string               `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	GroupPath            string               `protobuf:"bytes,3,opt,name=group_path,json=groupPath,proto3" json:"group_path,omitempty"`
-	GroupName            string               `protobuf:"bytes,4,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
-	Description          string               `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Status               string               `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	Extra                map[string]string    `protobuf:"bytes,7,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	CreateTime           *timestamp.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	UpdateTime           *timestamp.Timestamp `protobuf:"bytes,9,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	StatusTime           *timestamp.Timestamp `protobuf:"bytes,10,opt,name=status_time,json=statusTime,proto3" json:"status_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type MoveGroupRequest struct {
+	GroupId              string   `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ParentGroupId        string   `protobuf:"bytes,2,opt,name=parent_group_id,json=parentGroupId,proto3" json:"parent_group_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Group) Reset()         { *m = Group{} }
-func (m *Group) String() string { return proto.CompactTextString(m) }
-func (*Group) ProtoMessage()    {}
-func (*Group) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{8}
+func (m *MoveGroupRequest) Reset()         { *m = MoveGroupRequest{} }
+func (m *MoveGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*MoveGroupRequest) ProtoMessage()    {}
+func (*MoveGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{10}
 }
 
-func (m *Group) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Group.Unmarshal(m, b)
+func (m *MoveGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveGroupRequest.Unmarshal(m, b)
 }
-func (m *Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Group.Marshal(b, m, deterministic)
+func (m *MoveGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveGroupRequest.Marshal(b, m, deterministic)
 }
-func (m *Group) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Group.Merge(m, src)
+func (m *MoveGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveGroupRequest.Merge(m, src)
 }
-func (m *Group) XXX_Size() int {
-	return xxx_messageInfo_Group.Size(m)
+func (m *MoveGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_MoveGroupRequest.Size(m)
 }
-func (m *Group) XXX_DiscardUnknown() {
-	xxx_messageInfo_Group.DiscardUnknown(m)
+func (m *MoveGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveGroupRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Group proto.InternalMessageInfo
+var xxx_messageInfo_MoveGroupRequest proto.InternalMessageInfo
 
-func (m *Group) GetParentGroupId() string {
+func (m *MoveGroupRequest) GetGroupId() string {
 	if m != nil {
-		return m.ParentGroupId
+		return m.GroupId
 	}
 	return ""
 }
 
-func (m *Group) GetGroupId() string {
+func (m *MoveGroupRequest) GetParentGroupId() string {
 	if m != nil {
-		return m.GroupId
+		return m.ParentGroupId
 	}
 	return ""
 }
 
-func (m *Group) GetGroupPath() string {
+type MoveGroupResponse struct {
+	GroupId              string   `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupPath            string   `protobuf:"bytes,2,opt,name=group_path,json=groupPath,proto3" json:"group_path,omitempty"`
+	AffectedCount        uint32   `protobuf:"varint,3,opt,name=affected_count,json=affectedCount,proto3" json:"affected_count,omitempty"`
+	GroupNamePath        string   `protobuf:"bytes,4,opt,name=group_name_path,json=groupNamePath,proto3" json:"group_name_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveGroupResponse) Reset()         { *m = MoveGroupResponse{} }
+func (m *MoveGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MoveGroupResponse) ProtoMessage()    {}
+func (*MoveGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{11}
+}
+
+func (m *MoveGroupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveGroupResponse.Unmarshal(m, b)
+}
+func (m *MoveGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveGroupResponse.Marshal(b, m, deterministic)
+}
+func (m *MoveGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveGroupResponse.Merge(m, src)
+}
+func (m *MoveGroupResponse) XXX_Size() int {
+	return xxx_messageInfo_MoveGroupResponse.Size(m)
+}
+func (m *MoveGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveGroupResponse proto.InternalMessageInfo
+
+func (m *MoveGroupResponse) GetGroupId() string {
 	if m != nil {
-		return m.GroupPath
+		return m.GroupId
 	}
 	return ""
 }
 
-func (m *Group) GetGroupName() string {
+func (m *MoveGroupResponse) GetGroupPath() string {
 	if m != nil {
-		return m.GroupName
+		return m.GroupPath
 	}
 	return ""
 }
 
-func (m *Group) GetDescription() string {
+func (m *MoveGroupResponse) GetAffectedCount() uint32 {
 	if m != nil {
-		return m.Description
+		return m.AffectedCount
 	}
-	return ""
+	return 0
 }
 
-func (m *Group) GetStatus() string {
+func (m *MoveGroupResponse) GetGroupNamePath() string {
 	if m != nil {
-		return m.Status
+		return m.GroupNamePath
+	}
+	return ""
+}
+
+type Group struct {
+	ParentGroupId    string               `protobuf:"bytes,1,opt,name=parent_group_id,json=parentGroupId,proto3" json:"parent_group_id,omitempty"`
+	GroupId          string               `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupPath        string               `protobuf:"bytes,3,opt,name=group_path,json=groupPath,proto3" json:"group_path,omitempty"`
+	GroupName        string               `protobuf:"bytes,4,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Description      string               `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Status           string               `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Extra            map[string]string    `protobuf:"bytes,7,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CreateTime       *timestamp.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime       *timestamp.Timestamp `protobuf:"bytes,9,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	StatusTime       *timestamp.Timestamp `protobuf:"bytes,10,opt,name=status_time,json=statusTime,proto3" json:"status_time,omitempty"`
+	InactivityExempt bool                 `protobuf:"varint,11,opt,name=inactivity_exempt,json=inactivityExempt,proto3" json:"inactivity_exempt,omitempty"`
+	MembershipRule   string               `protobuf:"bytes,12,opt,name=membership_rule,json=membershipRule,proto3" json:"membership_rule,omitempty"`
+	// limits overriding the global defaults, 0 uses the default
+	MaxMembers           uint32   `protobuf:"varint,13,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"`
+	MaxChildGroups       uint32   `protobuf:"varint,14,opt,name=max_child_groups,json=maxChildGroups,proto3" json:"max_child_groups,omitempty"`
+	MaxDepth             uint32   `protobuf:"varint,15,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	GroupNamePath        string   `protobuf:"bytes,16,opt,name=group_name_path,json=groupNamePath,proto3" json:"group_name_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Group) Reset()         { *m = Group{} }
+func (m *Group) String() string { return proto.CompactTextString(m) }
+func (*Group) ProtoMessage()    {}
+func (*Group) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{12}
+}
+
+func (m *Group) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Group.Unmarshal(m, b)
+}
+func (m *Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Group.Marshal(b, m, deterministic)
+}
+func (m *Group) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Group.Merge(m, src)
+}
+func (m *Group) XXX_Size() int {
+	return xxx_messageInfo_Group.Size(m)
+}
+func (m *Group) XXX_DiscardUnknown() {
+	xxx_messageInfo_Group.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Group proto.InternalMessageInfo
+
+func (m *Group) GetParentGroupId() string {
+	if m != nil {
+		return m.ParentGroupId
+	}
+	return ""
+}
+
+func (m *Group) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *Group) GetGroupPath() string {
+	if m != nil {
+		return m.GroupPath
+	}
+	return ""
+}
+
+func (m *Group) GetGroupName() string {
+	if m != nil {
+		return m.GroupName
+	}
+	return ""
+}
+
+func (m *Group) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Group) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
 	return ""
 }
@@ -496,9 +897,52 @@ func (m *Group) GetStatusTime() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *Group) GetInactivityExempt() bool {
+	if m != nil {
+		return m.InactivityExempt
+	}
+	return false
+}
+
+func (m *Group) GetMembershipRule() string {
+	if m != nil {
+		return m.MembershipRule
+	}
+	return ""
+}
+
+func (m *Group) GetMaxMembers() uint32 {
+	if m != nil {
+		return m.MaxMembers
+	}
+	return 0
+}
+
+func (m *Group) GetMaxChildGroups() uint32 {
+	if m != nil {
+		return m.MaxChildGroups
+	}
+	return 0
+}
+
+func (m *Group) GetMaxDepth() uint32 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
+func (m *Group) GetGroupNamePath() string {
+	if m != nil {
+		return m.GroupNamePath
+	}
+	return ""
+}
+
 type GroupWithUser struct {
 	Group                *Group   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
 	UserSet              []*User  `protobuf:"bytes,2,rep,name=user_set,json=userSet,proto3" json:"user_set,omitempty"`
+	MemberGroupSet       []*Group `protobuf:"bytes,3,rep,name=member_group_set,json=memberGroupSet,proto3" json:"member_group_set,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -508,7 +952,7 @@ func (m *GroupWithUser) Reset()         { *m = GroupWithUser{} }
 func (m *GroupWithUser) String() string { return proto.CompactTextString(m) }
 func (*GroupWithUser) ProtoMessage()    {}
 func (*GroupWithUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{9}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{13}
 }
 
 func (m *GroupWithUser) XXX_Unmarshal(b []byte) error {
@@ -543,8 +987,17 @@ func (m *GroupWithUser) GetUserSet() []*User {
 	return nil
 }
 
+func (m *GroupWithUser) GetMemberGroupSet() []*Group {
+	if m != nil {
+		return m.MemberGroupSet
+	}
+	return nil
+}
+
 type GetGroupRequest struct {
-	GroupId              string   `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// for GetGroupWithUser, the users of sub groups and nested member groups are included
+	ExpandNested         bool     `protobuf:"varint,2,opt,name=expand_nested,json=expandNested,proto3" json:"expand_nested,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -554,7 +1007,7 @@ func (m *GetGroupRequest) Reset()         { *m = GetGroupRequest{} }
 func (m *GetGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGroupRequest) ProtoMessage()    {}
 func (*GetGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{10}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{14}
 }
 
 func (m *GetGroupRequest) XXX_Unmarshal(b []byte) error {
@@ -582,6 +1035,13 @@ func (m *GetGroupRequest) GetGroupId() string {
 	return ""
 }
 
+func (m *GetGroupRequest) GetExpandNested() bool {
+	if m != nil {
+		return m.ExpandNested
+	}
+	return false
+}
+
 type GetGroupResponse struct {
 	Group                *Group   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -593,7 +1053,7 @@ func (m *GetGroupResponse) Reset()         { *m = GetGroupResponse{} }
 func (m *GetGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*GetGroupResponse) ProtoMessage()    {}
 func (*GetGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{11}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{15}
 }
 
 func (m *GetGroupResponse) XXX_Unmarshal(b []byte) error {
@@ -621,6 +1081,46 @@ func (m *GetGroupResponse) GetGroup() *Group {
 	return nil
 }
 
+type GetGroupByNamePathRequest struct {
+	// names from the root separated by "/", such as /engineering/platform/sre
+	GroupNamePath        string   `protobuf:"bytes,1,opt,name=group_name_path,json=groupNamePath,proto3" json:"group_name_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetGroupByNamePathRequest) Reset()         { *m = GetGroupByNamePathRequest{} }
+func (m *GetGroupByNamePathRequest) String() string { return proto.CompactTextString(m) }
+func (*GetGroupByNamePathRequest) ProtoMessage()    {}
+func (*GetGroupByNamePathRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{16}
+}
+
+func (m *GetGroupByNamePathRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGroupByNamePathRequest.Unmarshal(m, b)
+}
+func (m *GetGroupByNamePathRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGroupByNamePathRequest.Marshal(b, m, deterministic)
+}
+func (m *GetGroupByNamePathRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGroupByNamePathRequest.Merge(m, src)
+}
+func (m *GetGroupByNamePathRequest) XXX_Size() int {
+	return xxx_messageInfo_GetGroupByNamePathRequest.Size(m)
+}
+func (m *GetGroupByNamePathRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGroupByNamePathRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGroupByNamePathRequest proto.InternalMessageInfo
+
+func (m *GetGroupByNamePathRequest) GetGroupNamePath() string {
+	if m != nil {
+		return m.GroupNamePath
+	}
+	return ""
+}
+
 type GetGroupWithUserResponse struct {
 	Group                *GroupWithUser `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -632,7 +1132,7 @@ func (m *GetGroupWithUserResponse) Reset()         { *m = GetGroupWithUserRespon
 func (m *GetGroupWithUserResponse) String() string { return proto.CompactTextString(m) }
 func (*GetGroupWithUserResponse) ProtoMessage()    {}
 func (*GetGroupWithUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{12}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{17}
 }
 
 func (m *GetGroupWithUserResponse) XXX_Unmarshal(b []byte) error {
@@ -660,28 +1160,197 @@ func (m *GetGroupWithUserResponse) GetGroup() *GroupWithUser {
 	return nil
 }
 
-type ListGroupsRequest struct {
-	SearchWord           []string `protobuf:"bytes,1,rep,name=search_word,json=searchWord,proto3" json:"search_word,omitempty"`
-	SortKey              string   `protobuf:"bytes,2,opt,name=sort_key,json=sortKey,proto3" json:"sort_key,omitempty"`
-	Reverse              bool     `protobuf:"varint,3,opt,name=reverse,proto3" json:"reverse,omitempty"`
-	Offset               uint32   `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit                uint32   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
-	RootGroupId          []string `protobuf:"bytes,6,rep,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
-	ParentGroupId        []string `protobuf:"bytes,7,rep,name=parent_group_id,json=parentGroupId,proto3" json:"parent_group_id,omitempty"`
-	GroupId              []string `protobuf:"bytes,8,rep,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	GroupPath            []string `protobuf:"bytes,9,rep,name=group_path,json=groupPath,proto3" json:"group_path,omitempty"`
-	GroupName            []string `protobuf:"bytes,10,rep,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
-	Status               []string `protobuf:"bytes,11,rep,name=status,proto3" json:"status,omitempty"`
+type GetGroupTreeRequest struct {
+	// empty for the trees of all root groups
+	RootGroupId string `protobuf:"bytes,1,opt,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
+	// levels below the root to return, 0 for all, 1 to expand one level at a time
+	MaxDepth             uint32   `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	WithMemberCount      bool     `protobuf:"varint,3,opt,name=with_member_count,json=withMemberCount,proto3" json:"with_member_count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *GetGroupTreeRequest) Reset()         { *m = GetGroupTreeRequest{} }
+func (m *GetGroupTreeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetGroupTreeRequest) ProtoMessage()    {}
+func (*GetGroupTreeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{18}
+}
+
+func (m *GetGroupTreeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGroupTreeRequest.Unmarshal(m, b)
+}
+func (m *GetGroupTreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGroupTreeRequest.Marshal(b, m, deterministic)
+}
+func (m *GetGroupTreeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGroupTreeRequest.Merge(m, src)
+}
+func (m *GetGroupTreeRequest) XXX_Size() int {
+	return xxx_messageInfo_GetGroupTreeRequest.Size(m)
+}
+func (m *GetGroupTreeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGroupTreeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGroupTreeRequest proto.InternalMessageInfo
+
+func (m *GetGroupTreeRequest) GetRootGroupId() string {
+	if m != nil {
+		return m.RootGroupId
+	}
+	return ""
+}
+
+func (m *GetGroupTreeRequest) GetMaxDepth() uint32 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
+func (m *GetGroupTreeRequest) GetWithMemberCount() bool {
+	if m != nil {
+		return m.WithMemberCount
+	}
+	return false
+}
+
+type GroupTreeNode struct {
+	Group                *Group                `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Children             []*GroupTreeNode      `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	ChildCount           uint32                `protobuf:"varint,3,opt,name=child_count,json=childCount,proto3" json:"child_count,omitempty"`
+	DirectMemberCount    *wrappers.UInt32Value `protobuf:"bytes,4,opt,name=direct_member_count,json=directMemberCount,proto3" json:"direct_member_count,omitempty"`
+	TotalMemberCount     *wrappers.UInt32Value `protobuf:"bytes,5,opt,name=total_member_count,json=totalMemberCount,proto3" json:"total_member_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GroupTreeNode) Reset()         { *m = GroupTreeNode{} }
+func (m *GroupTreeNode) String() string { return proto.CompactTextString(m) }
+func (*GroupTreeNode) ProtoMessage()    {}
+func (*GroupTreeNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{19}
+}
+
+func (m *GroupTreeNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupTreeNode.Unmarshal(m, b)
+}
+func (m *GroupTreeNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupTreeNode.Marshal(b, m, deterministic)
+}
+func (m *GroupTreeNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupTreeNode.Merge(m, src)
+}
+func (m *GroupTreeNode) XXX_Size() int {
+	return xxx_messageInfo_GroupTreeNode.Size(m)
+}
+func (m *GroupTreeNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupTreeNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupTreeNode proto.InternalMessageInfo
+
+func (m *GroupTreeNode) GetGroup() *Group {
+	if m != nil {
+		return m.Group
+	}
+	return nil
+}
+
+func (m *GroupTreeNode) GetChildren() []*GroupTreeNode {
+	if m != nil {
+		return m.Children
+	}
+	return nil
+}
+
+func (m *GroupTreeNode) GetChildCount() uint32 {
+	if m != nil {
+		return m.ChildCount
+	}
+	return 0
+}
+
+func (m *GroupTreeNode) GetDirectMemberCount() *wrappers.UInt32Value {
+	if m != nil {
+		return m.DirectMemberCount
+	}
+	return nil
+}
+
+func (m *GroupTreeNode) GetTotalMemberCount() *wrappers.UInt32Value {
+	if m != nil {
+		return m.TotalMemberCount
+	}
+	return nil
+}
+
+type GetGroupTreeResponse struct {
+	NodeSet              []*GroupTreeNode `protobuf:"bytes,1,rep,name=node_set,json=nodeSet,proto3" json:"node_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetGroupTreeResponse) Reset()         { *m = GetGroupTreeResponse{} }
+func (m *GetGroupTreeResponse) String() string { return proto.CompactTextString(m) }
+func (*GetGroupTreeResponse) ProtoMessage()    {}
+func (*GetGroupTreeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{20}
+}
+
+func (m *GetGroupTreeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGroupTreeResponse.Unmarshal(m, b)
+}
+func (m *GetGroupTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGroupTreeResponse.Marshal(b, m, deterministic)
+}
+func (m *GetGroupTreeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGroupTreeResponse.Merge(m, src)
+}
+func (m *GetGroupTreeResponse) XXX_Size() int {
+	return xxx_messageInfo_GetGroupTreeResponse.Size(m)
+}
+func (m *GetGroupTreeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGroupTreeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGroupTreeResponse proto.InternalMessageInfo
+
+func (m *GetGroupTreeResponse) GetNodeSet() []*GroupTreeNode {
+	if m != nil {
+		return m.NodeSet
+	}
+	return nil
+}
+
+type ListGroupsRequest struct {
+	SearchWord           []string           `protobuf:"bytes,1,rep,name=search_word,json=searchWord,proto3" json:"search_word,omitempty"`
+	SortKey              string             `protobuf:"bytes,2,opt,name=sort_key,json=sortKey,proto3" json:"sort_key,omitempty"`
+	Reverse              bool               `protobuf:"varint,3,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Offset               uint32             `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint32             `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	RootGroupId          []string           `protobuf:"bytes,6,rep,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
+	ParentGroupId        []string           `protobuf:"bytes,7,rep,name=parent_group_id,json=parentGroupId,proto3" json:"parent_group_id,omitempty"`
+	GroupId              []string           `protobuf:"bytes,8,rep,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupPath            []string           `protobuf:"bytes,9,rep,name=group_path,json=groupPath,proto3" json:"group_path,omitempty"`
+	GroupName            []string           `protobuf:"bytes,10,rep,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Status               []string           `protobuf:"bytes,11,rep,name=status,proto3" json:"status,omitempty"`
+	AttributeFilter      []*AttributeFilter `protobuf:"bytes,12,rep,name=attribute_filter,json=attributeFilter,proto3" json:"attribute_filter,omitempty"`
+	GroupNamePath        []string           `protobuf:"bytes,13,rep,name=group_name_path,json=groupNamePath,proto3" json:"group_name_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
 func (m *ListGroupsRequest) Reset()         { *m = ListGroupsRequest{} }
 func (m *ListGroupsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListGroupsRequest) ProtoMessage()    {}
 func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{13}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{21}
 }
 
 func (m *ListGroupsRequest) XXX_Unmarshal(b []byte) error {
@@ -779,6 +1448,20 @@ func (m *ListGroupsRequest) GetStatus() []string {
 	return nil
 }
 
+func (m *ListGroupsRequest) GetAttributeFilter() []*AttributeFilter {
+	if m != nil {
+		return m.AttributeFilter
+	}
+	return nil
+}
+
+func (m *ListGroupsRequest) GetGroupNamePath() []string {
+	if m != nil {
+		return m.GroupNamePath
+	}
+	return nil
+}
+
 type ListGroupsResponse struct {
 	Total                uint32   `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
 	GroupSet             []*Group `protobuf:"bytes,2,rep,name=group_set,json=groupSet,proto3" json:"group_set,omitempty"`
@@ -791,7 +1474,7 @@ func (m *ListGroupsResponse) Reset()         { *m = ListGroupsResponse{} }
 func (m *ListGroupsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListGroupsResponse) ProtoMessage()    {}
 func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{14}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{22}
 }
 
 func (m *ListGroupsResponse) XXX_Unmarshal(b []byte) error {
@@ -838,7 +1521,7 @@ func (m *ListGroupsWithUserResponse) Reset()         { *m = ListGroupsWithUserRe
 func (m *ListGroupsWithUserResponse) String() string { return proto.CompactTextString(m) }
 func (*ListGroupsWithUserResponse) ProtoMessage()    {}
 func (*ListGroupsWithUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{15}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{23}
 }
 
 func (m *ListGroupsWithUserResponse) XXX_Unmarshal(b []byte) error {
@@ -880,6 +1563,11 @@ type CreateUserRequest struct {
 	Description          string            `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	Password             string            `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
 	Extra                map[string]string `protobuf:"bytes,6,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DisplayName          string            `protobuf:"bytes,7,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	GivenName            string            `protobuf:"bytes,8,opt,name=given_name,json=givenName,proto3" json:"given_name,omitempty"`
+	FamilyName           string            `protobuf:"bytes,9,opt,name=family_name,json=familyName,proto3" json:"family_name,omitempty"`
+	Locale               string            `protobuf:"bytes,10,opt,name=locale,proto3" json:"locale,omitempty"`
+	Timezone             string            `protobuf:"bytes,11,opt,name=timezone,proto3" json:"timezone,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -889,7 +1577,7 @@ func (m *CreateUserRequest) Reset()         { *m = CreateUserRequest{} }
 func (m *CreateUserRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateUserRequest) ProtoMessage()    {}
 func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{16}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{24}
 }
 
 func (m *CreateUserRequest) XXX_Unmarshal(b []byte) error {
@@ -952,18 +1640,53 @@ func (m *CreateUserRequest) GetExtra() map[string]string {
 	return nil
 }
 
-type CreateUserResponse struct {
-	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CreateUserRequest) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *CreateUserRequest) GetGivenName() string {
+	if m != nil {
+		return m.GivenName
+	}
+	return ""
+}
+
+func (m *CreateUserRequest) GetFamilyName() string {
+	if m != nil {
+		return m.FamilyName
+	}
+	return ""
+}
+
+func (m *CreateUserRequest) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+func (m *CreateUserRequest) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+type CreateUserResponse struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CreateUserResponse) Reset()         { *m = CreateUserResponse{} }
 func (m *CreateUserResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateUserResponse) ProtoMessage()    {}
 func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{17}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{25}
 }
 
 func (m *CreateUserResponse) XXX_Unmarshal(b []byte) error {
@@ -1002,7 +1725,7 @@ func (m *DeleteUsersRequest) Reset()         { *m = DeleteUsersRequest{} }
 func (m *DeleteUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteUsersRequest) ProtoMessage()    {}
 func (*DeleteUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{18}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{26}
 }
 
 func (m *DeleteUsersRequest) XXX_Unmarshal(b []byte) error {
@@ -1041,7 +1764,7 @@ func (m *DeleteUsersResponse) Reset()         { *m = DeleteUsersResponse{} }
 func (m *DeleteUsersResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteUsersResponse) ProtoMessage()    {}
 func (*DeleteUsersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{19}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{27}
 }
 
 func (m *DeleteUsersResponse) XXX_Unmarshal(b []byte) error {
@@ -1070,22 +1793,31 @@ func (m *DeleteUsersResponse) GetUserId() []string {
 }
 
 type ModifyUserRequest struct {
-	UserId               string            `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Email                string            `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	PhoneNumber          string            `protobuf:"bytes,4,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	Description          string            `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Extra                map[string]string `protobuf:"bytes,7,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	UserId      string            `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username    string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email       string            `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	PhoneNumber string            `protobuf:"bytes,4,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Description string            `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Extra       map[string]string `protobuf:"bytes,7,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// fields to write, such as "description" or "extra.key", a listed key missing from extra is deleted;
+	// fields that are not empty are written if absent
+	UpdateMask           *field_mask.FieldMask `protobuf:"bytes,8,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	DisplayName          string                `protobuf:"bytes,9,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	GivenName            string                `protobuf:"bytes,10,opt,name=given_name,json=givenName,proto3" json:"given_name,omitempty"`
+	FamilyName           string                `protobuf:"bytes,11,opt,name=family_name,json=familyName,proto3" json:"family_name,omitempty"`
+	Locale               string                `protobuf:"bytes,12,opt,name=locale,proto3" json:"locale,omitempty"`
+	Timezone             string                `protobuf:"bytes,13,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Status               string                `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *ModifyUserRequest) Reset()         { *m = ModifyUserRequest{} }
 func (m *ModifyUserRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyUserRequest) ProtoMessage()    {}
 func (*ModifyUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{20}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{28}
 }
 
 func (m *ModifyUserRequest) XXX_Unmarshal(b []byte) error {
@@ -1148,6 +1880,55 @@ func (m *ModifyUserRequest) GetExtra() map[string]string {
 	return nil
 }
 
+func (m *ModifyUserRequest) GetUpdateMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+func (m *ModifyUserRequest) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *ModifyUserRequest) GetGivenName() string {
+	if m != nil {
+		return m.GivenName
+	}
+	return ""
+}
+
+func (m *ModifyUserRequest) GetFamilyName() string {
+	if m != nil {
+		return m.FamilyName
+	}
+	return ""
+}
+
+func (m *ModifyUserRequest) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+func (m *ModifyUserRequest) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+func (m *ModifyUserRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
 type ModifyUserResponse struct {
 	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1159,7 +1940,7 @@ func (m *ModifyUserResponse) Reset()         { *m = ModifyUserResponse{} }
 func (m *ModifyUserResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyUserResponse) ProtoMessage()    {}
 func (*ModifyUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{21}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{29}
 }
 
 func (m *ModifyUserResponse) XXX_Unmarshal(b []byte) error {
@@ -1198,6 +1979,19 @@ type User struct {
 	CreateTime           *timestamp.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	UpdateTime           *timestamp.Timestamp `protobuf:"bytes,9,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
 	StatusTime           *timestamp.Timestamp `protobuf:"bytes,10,opt,name=status_time,json=statusTime,proto3" json:"status_time,omitempty"`
+	EmailVerified        bool                 `protobuf:"varint,11,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	EmailVerifyTime      *timestamp.Timestamp `protobuf:"bytes,12,opt,name=email_verify_time,json=emailVerifyTime,proto3" json:"email_verify_time,omitempty"`
+	PhoneVerified        bool                 `protobuf:"varint,13,opt,name=phone_verified,json=phoneVerified,proto3" json:"phone_verified,omitempty"`
+	PhoneVerifyTime      *timestamp.Timestamp `protobuf:"bytes,14,opt,name=phone_verify_time,json=phoneVerifyTime,proto3" json:"phone_verify_time,omitempty"`
+	DisplayName          string               `protobuf:"bytes,15,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	GivenName            string               `protobuf:"bytes,16,opt,name=given_name,json=givenName,proto3" json:"given_name,omitempty"`
+	FamilyName           string               `protobuf:"bytes,17,opt,name=family_name,json=familyName,proto3" json:"family_name,omitempty"`
+	Locale               string               `protobuf:"bytes,18,opt,name=locale,proto3" json:"locale,omitempty"`
+	Timezone             string               `protobuf:"bytes,19,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	AvatarUpdateTime     *timestamp.Timestamp `protobuf:"bytes,20,opt,name=avatar_update_time,json=avatarUpdateTime,proto3" json:"avatar_update_time,omitempty"`
+	LastLoginTime        *timestamp.Timestamp `protobuf:"bytes,21,opt,name=last_login_time,json=lastLoginTime,proto3" json:"last_login_time,omitempty"`
+	MergedInto           string               `protobuf:"bytes,22,opt,name=merged_into,json=mergedInto,proto3" json:"merged_into,omitempty"`
+	AnonymizeTime        *timestamp.Timestamp `protobuf:"bytes,23,opt,name=anonymize_time,json=anonymizeTime,proto3" json:"anonymize_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1207,7 +2001,7 @@ func (m *User) Reset()         { *m = User{} }
 func (m *User) String() string { return proto.CompactTextString(m) }
 func (*User) ProtoMessage()    {}
 func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{22}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{30}
 }
 
 func (m *User) XXX_Unmarshal(b []byte) error {
@@ -1298,19 +2092,111 @@ func (m *User) GetStatusTime() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *User) GetEmailVerified() bool {
+	if m != nil {
+		return m.EmailVerified
+	}
+	return false
+}
+
+func (m *User) GetEmailVerifyTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EmailVerifyTime
+	}
+	return nil
+}
+
+func (m *User) GetPhoneVerified() bool {
+	if m != nil {
+		return m.PhoneVerified
+	}
+	return false
+}
+
+func (m *User) GetPhoneVerifyTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.PhoneVerifyTime
+	}
+	return nil
+}
+
+func (m *User) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *User) GetGivenName() string {
+	if m != nil {
+		return m.GivenName
+	}
+	return ""
+}
+
+func (m *User) GetFamilyName() string {
+	if m != nil {
+		return m.FamilyName
+	}
+	return ""
+}
+
+func (m *User) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+func (m *User) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+func (m *User) GetAvatarUpdateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.AvatarUpdateTime
+	}
+	return nil
+}
+
+func (m *User) GetLastLoginTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastLoginTime
+	}
+	return nil
+}
+
+func (m *User) GetMergedInto() string {
+	if m != nil {
+		return m.MergedInto
+	}
+	return ""
+}
+
+func (m *User) GetAnonymizeTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.AnonymizeTime
+	}
+	return nil
+}
+
 type UserWithGroup struct {
-	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	GroupSet             []*Group `protobuf:"bytes,2,rep,name=group_set,json=groupSet,proto3" json:"group_set,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	User                 *User               `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	GroupSet             []*Group            `protobuf:"bytes,2,rep,name=group_set,json=groupSet,proto3" json:"group_set,omitempty"`
+	BindingSet           []*UserGroupBinding `protobuf:"bytes,3,rep,name=binding_set,json=bindingSet,proto3" json:"binding_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *UserWithGroup) Reset()         { *m = UserWithGroup{} }
 func (m *UserWithGroup) String() string { return proto.CompactTextString(m) }
 func (*UserWithGroup) ProtoMessage()    {}
 func (*UserWithGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{23}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{31}
 }
 
 func (m *UserWithGroup) XXX_Unmarshal(b []byte) error {
@@ -1345,8 +2231,16 @@ func (m *UserWithGroup) GetGroupSet() []*Group {
 	return nil
 }
 
+func (m *UserWithGroup) GetBindingSet() []*UserGroupBinding {
+	if m != nil {
+		return m.BindingSet
+	}
+	return nil
+}
+
 type GetUserRequest struct {
 	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FollowMerged         bool     `protobuf:"varint,2,opt,name=follow_merged,json=followMerged,proto3" json:"follow_merged,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1356,7 +2250,7 @@ func (m *GetUserRequest) Reset()         { *m = GetUserRequest{} }
 func (m *GetUserRequest) String() string { return proto.CompactTextString(m) }
 func (*GetUserRequest) ProtoMessage()    {}
 func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{24}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{32}
 }
 
 func (m *GetUserRequest) XXX_Unmarshal(b []byte) error {
@@ -1384,6 +2278,13 @@ func (m *GetUserRequest) GetUserId() string {
 	return ""
 }
 
+func (m *GetUserRequest) GetFollowMerged() bool {
+	if m != nil {
+		return m.FollowMerged
+	}
+	return false
+}
+
 type GetUserResponse struct {
 	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1395,7 +2296,7 @@ func (m *GetUserResponse) Reset()         { *m = GetUserResponse{} }
 func (m *GetUserResponse) String() string { return proto.CompactTextString(m) }
 func (*GetUserResponse) ProtoMessage()    {}
 func (*GetUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{25}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{33}
 }
 
 func (m *GetUserResponse) XXX_Unmarshal(b []byte) error {
@@ -1434,7 +2335,7 @@ func (m *GetUserWithGroupResponse) Reset()         { *m = GetUserWithGroupRespon
 func (m *GetUserWithGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*GetUserWithGroupResponse) ProtoMessage()    {}
 func (*GetUserWithGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{26}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{34}
 }
 
 func (m *GetUserWithGroupResponse) XXX_Unmarshal(b []byte) error {
@@ -1463,28 +2364,30 @@ func (m *GetUserWithGroupResponse) GetUser() *UserWithGroup {
 }
 
 type ListUsersRequest struct {
-	SearchWord           []string `protobuf:"bytes,1,rep,name=search_word,json=searchWord,proto3" json:"search_word,omitempty"`
-	SortKey              string   `protobuf:"bytes,2,opt,name=sort_key,json=sortKey,proto3" json:"sort_key,omitempty"`
-	Reverse              bool     `protobuf:"varint,3,opt,name=reverse,proto3" json:"reverse,omitempty"`
-	Offset               uint32   `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit                uint32   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
-	RootGroupId          []string `protobuf:"bytes,6,rep,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
-	GroupId              []string `protobuf:"bytes,7,rep,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	UserId               []string `protobuf:"bytes,8,rep,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Username             []string `protobuf:"bytes,9,rep,name=username,proto3" json:"username,omitempty"`
-	Email                []string `protobuf:"bytes,10,rep,name=email,proto3" json:"email,omitempty"`
-	PhoneNumber          []string `protobuf:"bytes,11,rep,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	Status               []string `protobuf:"bytes,12,rep,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SearchWord           []string            `protobuf:"bytes,1,rep,name=search_word,json=searchWord,proto3" json:"search_word,omitempty"`
+	SortKey              string              `protobuf:"bytes,2,opt,name=sort_key,json=sortKey,proto3" json:"sort_key,omitempty"`
+	Reverse              bool                `protobuf:"varint,3,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Offset               uint32              `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint32              `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	RootGroupId          []string            `protobuf:"bytes,6,rep,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
+	GroupId              []string            `protobuf:"bytes,7,rep,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId               []string            `protobuf:"bytes,8,rep,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username             []string            `protobuf:"bytes,9,rep,name=username,proto3" json:"username,omitempty"`
+	Email                []string            `protobuf:"bytes,10,rep,name=email,proto3" json:"email,omitempty"`
+	PhoneNumber          []string            `protobuf:"bytes,11,rep,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Status               []string            `protobuf:"bytes,12,rep,name=status,proto3" json:"status,omitempty"`
+	EmailVerified        *wrappers.BoolValue `protobuf:"bytes,13,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	AttributeFilter      []*AttributeFilter  `protobuf:"bytes,14,rep,name=attribute_filter,json=attributeFilter,proto3" json:"attribute_filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *ListUsersRequest) Reset()         { *m = ListUsersRequest{} }
 func (m *ListUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListUsersRequest) ProtoMessage()    {}
 func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{27}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{35}
 }
 
 func (m *ListUsersRequest) XXX_Unmarshal(b []byte) error {
@@ -1589,6 +2492,20 @@ func (m *ListUsersRequest) GetStatus() []string {
 	return nil
 }
 
+func (m *ListUsersRequest) GetEmailVerified() *wrappers.BoolValue {
+	if m != nil {
+		return m.EmailVerified
+	}
+	return nil
+}
+
+func (m *ListUsersRequest) GetAttributeFilter() []*AttributeFilter {
+	if m != nil {
+		return m.AttributeFilter
+	}
+	return nil
+}
+
 type ListUsersResponse struct {
 	Total                uint32   `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
 	UserSet              []*User  `protobuf:"bytes,2,rep,name=user_set,json=userSet,proto3" json:"user_set,omitempty"`
@@ -1601,7 +2518,7 @@ func (m *ListUsersResponse) Reset()         { *m = ListUsersResponse{} }
 func (m *ListUsersResponse) String() string { return proto.CompactTextString(m) }
 func (*ListUsersResponse) ProtoMessage()    {}
 func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{28}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{36}
 }
 
 func (m *ListUsersResponse) XXX_Unmarshal(b []byte) error {
@@ -1648,7 +2565,7 @@ func (m *ListUsersWithGroupResponse) Reset()         { *m = ListUsersWithGroupRe
 func (m *ListUsersWithGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*ListUsersWithGroupResponse) ProtoMessage()    {}
 func (*ListUsersWithGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{29}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{37}
 }
 
 func (m *ListUsersWithGroupResponse) XXX_Unmarshal(b []byte) error {
@@ -1684,18 +2601,20 @@ func (m *ListUsersWithGroupResponse) GetUserSet() []*UserWithGroup {
 }
 
 type JoinGroupRequest struct {
-	GroupId              []string `protobuf:"bytes,1,rep,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	UserId               []string `protobuf:"bytes,2,rep,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	GroupId []string `protobuf:"bytes,1,rep,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId  []string `protobuf:"bytes,2,rep,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// the created bindings are removed once expired, empty never expires
+	ExpireTime           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *JoinGroupRequest) Reset()         { *m = JoinGroupRequest{} }
 func (m *JoinGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*JoinGroupRequest) ProtoMessage()    {}
 func (*JoinGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{30}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{38}
 }
 
 func (m *JoinGroupRequest) XXX_Unmarshal(b []byte) error {
@@ -1730,6 +2649,13 @@ func (m *JoinGroupRequest) GetUserId() []string {
 	return nil
 }
 
+func (m *JoinGroupRequest) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
 type JoinGroupResponse struct {
 	GroupId              []string `protobuf:"bytes,1,rep,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	UserId               []string `protobuf:"bytes,2,rep,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1742,7 +2668,7 @@ func (m *JoinGroupResponse) Reset()         { *m = JoinGroupResponse{} }
 func (m *JoinGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*JoinGroupResponse) ProtoMessage()    {}
 func (*JoinGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{31}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{39}
 }
 
 func (m *JoinGroupResponse) XXX_Unmarshal(b []byte) error {
@@ -1789,7 +2715,7 @@ func (m *LeaveGroupRequest) Reset()         { *m = LeaveGroupRequest{} }
 func (m *LeaveGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*LeaveGroupRequest) ProtoMessage()    {}
 func (*LeaveGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{32}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{40}
 }
 
 func (m *LeaveGroupRequest) XXX_Unmarshal(b []byte) error {
@@ -1836,7 +2762,7 @@ func (m *LeaveGroupResponse) Reset()         { *m = LeaveGroupResponse{} }
 func (m *LeaveGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*LeaveGroupResponse) ProtoMessage()    {}
 func (*LeaveGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{33}
+	return fileDescriptor_36f2114a3e4ddb9e, []int{41}
 }
 
 func (m *LeaveGroupResponse) XXX_Unmarshal(b []byte) error {
@@ -1871,900 +2797,6075 @@ func (m *LeaveGroupResponse) GetUserId() []string {
 	return nil
 }
 
-type ModifyPasswordRequest struct {
-	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+type AddGroupMembersRequest struct {
+	GroupId              string   `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	MemberGroupId        []string `protobuf:"bytes,2,rep,name=member_group_id,json=memberGroupId,proto3" json:"member_group_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ModifyPasswordRequest) Reset()         { *m = ModifyPasswordRequest{} }
-func (m *ModifyPasswordRequest) String() string { return proto.CompactTextString(m) }
-func (*ModifyPasswordRequest) ProtoMessage()    {}
-func (*ModifyPasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{34}
+func (m *AddGroupMembersRequest) Reset()         { *m = AddGroupMembersRequest{} }
+func (m *AddGroupMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*AddGroupMembersRequest) ProtoMessage()    {}
+func (*AddGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{42}
 }
 
-func (m *ModifyPasswordRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ModifyPasswordRequest.Unmarshal(m, b)
+func (m *AddGroupMembersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddGroupMembersRequest.Unmarshal(m, b)
 }
-func (m *ModifyPasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ModifyPasswordRequest.Marshal(b, m, deterministic)
+func (m *AddGroupMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddGroupMembersRequest.Marshal(b, m, deterministic)
 }
-func (m *ModifyPasswordRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ModifyPasswordRequest.Merge(m, src)
+func (m *AddGroupMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddGroupMembersRequest.Merge(m, src)
 }
-func (m *ModifyPasswordRequest) XXX_Size() int {
-	return xxx_messageInfo_ModifyPasswordRequest.Size(m)
+func (m *AddGroupMembersRequest) XXX_Size() int {
+	return xxx_messageInfo_AddGroupMembersRequest.Size(m)
 }
-func (m *ModifyPasswordRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ModifyPasswordRequest.DiscardUnknown(m)
+func (m *AddGroupMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddGroupMembersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ModifyPasswordRequest proto.InternalMessageInfo
+var xxx_messageInfo_AddGroupMembersRequest proto.InternalMessageInfo
 
-func (m *ModifyPasswordRequest) GetUserId() string {
+func (m *AddGroupMembersRequest) GetGroupId() string {
 	if m != nil {
-		return m.UserId
+		return m.GroupId
 	}
 	return ""
 }
 
-func (m *ModifyPasswordRequest) GetPassword() string {
+func (m *AddGroupMembersRequest) GetMemberGroupId() []string {
 	if m != nil {
-		return m.Password
+		return m.MemberGroupId
 	}
-	return ""
+	return nil
 }
 
-type ModifyPasswordResponse struct {
-	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+type AddGroupMembersResponse struct {
+	GroupId              string   `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	MemberGroupId        []string `protobuf:"bytes,2,rep,name=member_group_id,json=memberGroupId,proto3" json:"member_group_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ModifyPasswordResponse) Reset()         { *m = ModifyPasswordResponse{} }
-func (m *ModifyPasswordResponse) String() string { return proto.CompactTextString(m) }
-func (*ModifyPasswordResponse) ProtoMessage()    {}
-func (*ModifyPasswordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{35}
+func (m *AddGroupMembersResponse) Reset()         { *m = AddGroupMembersResponse{} }
+func (m *AddGroupMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*AddGroupMembersResponse) ProtoMessage()    {}
+func (*AddGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{43}
 }
 
-func (m *ModifyPasswordResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ModifyPasswordResponse.Unmarshal(m, b)
+func (m *AddGroupMembersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddGroupMembersResponse.Unmarshal(m, b)
 }
-func (m *ModifyPasswordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ModifyPasswordResponse.Marshal(b, m, deterministic)
+func (m *AddGroupMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddGroupMembersResponse.Marshal(b, m, deterministic)
 }
-func (m *ModifyPasswordResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ModifyPasswordResponse.Merge(m, src)
+func (m *AddGroupMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddGroupMembersResponse.Merge(m, src)
 }
-func (m *ModifyPasswordResponse) XXX_Size() int {
-	return xxx_messageInfo_ModifyPasswordResponse.Size(m)
+func (m *AddGroupMembersResponse) XXX_Size() int {
+	return xxx_messageInfo_AddGroupMembersResponse.Size(m)
 }
-func (m *ModifyPasswordResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ModifyPasswordResponse.DiscardUnknown(m)
+func (m *AddGroupMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddGroupMembersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ModifyPasswordResponse proto.InternalMessageInfo
+var xxx_messageInfo_AddGroupMembersResponse proto.InternalMessageInfo
 
-func (m *ModifyPasswordResponse) GetUserId() string {
+func (m *AddGroupMembersResponse) GetGroupId() string {
 	if m != nil {
-		return m.UserId
+		return m.GroupId
 	}
 	return ""
 }
 
-type ComparePasswordRequest struct {
-	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
+func (m *AddGroupMembersResponse) GetMemberGroupId() []string {
+	if m != nil {
+		return m.MemberGroupId
+	}
+	return nil
+}
+
+type RemoveGroupMembersRequest struct {
+	GroupId              string   `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	MemberGroupId        []string `protobuf:"bytes,2,rep,name=member_group_id,json=memberGroupId,proto3" json:"member_group_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ComparePasswordRequest) Reset()         { *m = ComparePasswordRequest{} }
-func (m *ComparePasswordRequest) String() string { return proto.CompactTextString(m) }
-func (*ComparePasswordRequest) ProtoMessage()    {}
-func (*ComparePasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{36}
+func (m *RemoveGroupMembersRequest) Reset()         { *m = RemoveGroupMembersRequest{} }
+func (m *RemoveGroupMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveGroupMembersRequest) ProtoMessage()    {}
+func (*RemoveGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{44}
 }
 
-func (m *ComparePasswordRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ComparePasswordRequest.Unmarshal(m, b)
+func (m *RemoveGroupMembersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveGroupMembersRequest.Unmarshal(m, b)
 }
-func (m *ComparePasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ComparePasswordRequest.Marshal(b, m, deterministic)
+func (m *RemoveGroupMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveGroupMembersRequest.Marshal(b, m, deterministic)
 }
-func (m *ComparePasswordRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ComparePasswordRequest.Merge(m, src)
+func (m *RemoveGroupMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveGroupMembersRequest.Merge(m, src)
 }
-func (m *ComparePasswordRequest) XXX_Size() int {
-	return xxx_messageInfo_ComparePasswordRequest.Size(m)
+func (m *RemoveGroupMembersRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveGroupMembersRequest.Size(m)
 }
-func (m *ComparePasswordRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ComparePasswordRequest.DiscardUnknown(m)
+func (m *RemoveGroupMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveGroupMembersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ComparePasswordRequest proto.InternalMessageInfo
+var xxx_messageInfo_RemoveGroupMembersRequest proto.InternalMessageInfo
 
-func (m *ComparePasswordRequest) GetUserId() string {
+func (m *RemoveGroupMembersRequest) GetGroupId() string {
 	if m != nil {
-		return m.UserId
+		return m.GroupId
 	}
 	return ""
 }
 
-func (m *ComparePasswordRequest) GetPassword() string {
+func (m *RemoveGroupMembersRequest) GetMemberGroupId() []string {
 	if m != nil {
-		return m.Password
+		return m.MemberGroupId
 	}
-	return ""
+	return nil
 }
 
-type ComparePasswordResponse struct {
-	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+type RemoveGroupMembersResponse struct {
+	GroupId              string   `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	MemberGroupId        []string `protobuf:"bytes,2,rep,name=member_group_id,json=memberGroupId,proto3" json:"member_group_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ComparePasswordResponse) Reset()         { *m = ComparePasswordResponse{} }
-func (m *ComparePasswordResponse) String() string { return proto.CompactTextString(m) }
-func (*ComparePasswordResponse) ProtoMessage()    {}
-func (*ComparePasswordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36f2114a3e4ddb9e, []int{37}
+func (m *RemoveGroupMembersResponse) Reset()         { *m = RemoveGroupMembersResponse{} }
+func (m *RemoveGroupMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveGroupMembersResponse) ProtoMessage()    {}
+func (*RemoveGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{45}
 }
 
-func (m *ComparePasswordResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ComparePasswordResponse.Unmarshal(m, b)
+func (m *RemoveGroupMembersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveGroupMembersResponse.Unmarshal(m, b)
 }
-func (m *ComparePasswordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ComparePasswordResponse.Marshal(b, m, deterministic)
+func (m *RemoveGroupMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveGroupMembersResponse.Marshal(b, m, deterministic)
 }
-func (m *ComparePasswordResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ComparePasswordResponse.Merge(m, src)
+func (m *RemoveGroupMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveGroupMembersResponse.Merge(m, src)
 }
-func (m *ComparePasswordResponse) XXX_Size() int {
-	return xxx_messageInfo_ComparePasswordResponse.Size(m)
+func (m *RemoveGroupMembersResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveGroupMembersResponse.Size(m)
 }
-func (m *ComparePasswordResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ComparePasswordResponse.DiscardUnknown(m)
+func (m *RemoveGroupMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveGroupMembersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ComparePasswordResponse proto.InternalMessageInfo
+var xxx_messageInfo_RemoveGroupMembersResponse proto.InternalMessageInfo
 
-func (m *ComparePasswordResponse) GetOk() bool {
+func (m *RemoveGroupMembersResponse) GetGroupId() string {
 	if m != nil {
-		return m.Ok
+		return m.GroupId
 	}
-	return false
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*GetVersionRequest)(nil), "kubesphere.GetVersionRequest")
-	proto.RegisterType((*GetVersionResponse)(nil), "kubesphere.GetVersionResponse")
-	proto.RegisterType((*CreateGroupRequest)(nil), "kubesphere.CreateGroupRequest")
-	proto.RegisterMapType((map[string]string)(nil), "kubesphere.CreateGroupRequest.ExtraEntry")
-	proto.RegisterType((*CreateGroupResponse)(nil), "kubesphere.CreateGroupResponse")
-	proto.RegisterType((*DeleteGroupsRequest)(nil), "kubesphere.DeleteGroupsRequest")
-	proto.RegisterType((*DeleteGroupsResponse)(nil), "kubesphere.DeleteGroupsResponse")
-	proto.RegisterType((*ModifyGroupRequest)(nil), "kubesphere.ModifyGroupRequest")
-	proto.RegisterMapType((map[string]string)(nil), "kubesphere.ModifyGroupRequest.ExtraEntry")
-	proto.RegisterType((*ModifyGroupResponse)(nil), "kubesphere.ModifyGroupResponse")
-	proto.RegisterType((*Group)(nil), "kubesphere.Group")
-	proto.RegisterMapType((map[string]string)(nil), "kubesphere.Group.ExtraEntry")
-	proto.RegisterType((*GroupWithUser)(nil), "kubesphere.GroupWithUser")
-	proto.RegisterType((*GetGroupRequest)(nil), "kubesphere.GetGroupRequest")
-	proto.RegisterType((*GetGroupResponse)(nil), "kubesphere.GetGroupResponse")
-	proto.RegisterType((*GetGroupWithUserResponse)(nil), "kubesphere.GetGroupWithUserResponse")
-	proto.RegisterType((*ListGroupsRequest)(nil), "kubesphere.ListGroupsRequest")
-	proto.RegisterType((*ListGroupsResponse)(nil), "kubesphere.ListGroupsResponse")
-	proto.RegisterType((*ListGroupsWithUserResponse)(nil), "kubesphere.ListGroupsWithUserResponse")
-	proto.RegisterType((*CreateUserRequest)(nil), "kubesphere.CreateUserRequest")
-	proto.RegisterMapType((map[string]string)(nil), "kubesphere.CreateUserRequest.ExtraEntry")
-	proto.RegisterType((*CreateUserResponse)(nil), "kubesphere.CreateUserResponse")
-	proto.RegisterType((*DeleteUsersRequest)(nil), "kubesphere.DeleteUsersRequest")
-	proto.RegisterType((*DeleteUsersResponse)(nil), "kubesphere.DeleteUsersResponse")
-	proto.RegisterType((*ModifyUserRequest)(nil), "kubesphere.ModifyUserRequest")
-	proto.RegisterMapType((map[string]string)(nil), "kubesphere.ModifyUserRequest.ExtraEntry")
-	proto.RegisterType((*ModifyUserResponse)(nil), "kubesphere.ModifyUserResponse")
-	proto.RegisterType((*User)(nil), "kubesphere.User")
-	proto.RegisterMapType((map[string]string)(nil), "kubesphere.User.ExtraEntry")
-	proto.RegisterType((*UserWithGroup)(nil), "kubesphere.UserWithGroup")
-	proto.RegisterType((*GetUserRequest)(nil), "kubesphere.GetUserRequest")
-	proto.RegisterType((*GetUserResponse)(nil), "kubesphere.GetUserResponse")
-	proto.RegisterType((*GetUserWithGroupResponse)(nil), "kubesphere.GetUserWithGroupResponse")
-	proto.RegisterType((*ListUsersRequest)(nil), "kubesphere.ListUsersRequest")
-	proto.RegisterType((*ListUsersResponse)(nil), "kubesphere.ListUsersResponse")
-	proto.RegisterType((*ListUsersWithGroupResponse)(nil), "kubesphere.ListUsersWithGroupResponse")
-	proto.RegisterType((*JoinGroupRequest)(nil), "kubesphere.JoinGroupRequest")
-	proto.RegisterType((*JoinGroupResponse)(nil), "kubesphere.JoinGroupResponse")
-	proto.RegisterType((*LeaveGroupRequest)(nil), "kubesphere.LeaveGroupRequest")
-	proto.RegisterType((*LeaveGroupResponse)(nil), "kubesphere.LeaveGroupResponse")
-	proto.RegisterType((*ModifyPasswordRequest)(nil), "kubesphere.ModifyPasswordRequest")
-	proto.RegisterType((*ModifyPasswordResponse)(nil), "kubesphere.ModifyPasswordResponse")
-	proto.RegisterType((*ComparePasswordRequest)(nil), "kubesphere.ComparePasswordRequest")
-	proto.RegisterType((*ComparePasswordResponse)(nil), "kubesphere.ComparePasswordResponse")
+func (m *RemoveGroupMembersResponse) GetMemberGroupId() []string {
+	if m != nil {
+		return m.MemberGroupId
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("im.proto", fileDescriptor_36f2114a3e4ddb9e) }
+// PreviewRuleRequest lists the users matching a membership rule such as
+// email ends_with "@example.com" and (extra.department == "eng" or extra.level >= 3),
+// the operators are ==, !=, >, >=, <, <=, contains, starts_with, ends_with and in [...],
+// conditions are combined by and, or, not and parentheses, the fields are
+// username, email, phone_number, description, status, display_name, given_name,
+// family_name, locale, timezone and extra.<key>; the users other than deleted are matched,
+// pending and disabled ones included, a rule excludes them with status == "active"
+type PreviewRuleRequest struct {
+	MembershipRule       string   `protobuf:"bytes,1,opt,name=membership_rule,json=membershipRule,proto3" json:"membership_rule,omitempty"`
+	Offset               uint32   `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint32   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-var fileDescriptor_36f2114a3e4ddb9e = []byte{
-	// 1460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x5b, 0x6f, 0xdc, 0x44,
-	0x14, 0x56, 0xbc, 0xd7, 0x9c, 0xcd, 0x36, 0xd9, 0x69, 0x69, 0xb7, 0x6e, 0x9a, 0xa4, 0xa6, 0x2a,
-	0xa9, 0xa0, 0x1b, 0x1a, 0x10, 0x54, 0x54, 0x2a, 0x52, 0x4b, 0xd9, 0x86, 0xb4, 0x55, 0x59, 0x7a,
-	0x91, 0xc2, 0xc3, 0xca, 0xc9, 0x4e, 0xb2, 0x26, 0xbb, 0xb6, 0xb1, 0x67, 0x03, 0xf9, 0x15, 0x3c,
-	0xf0, 0x8c, 0xc4, 0x6f, 0x42, 0xbc, 0xf1, 0x03, 0xf8, 0x0b, 0x3c, 0xa2, 0xb9, 0xd8, 0x9e, 0xf1,
-	0xf8, 0xb2, 0x55, 0x82, 0x04, 0x12, 0x6f, 0x9e, 0x39, 0xe7, 0x7c, 0x73, 0x7c, 0xee, 0x33, 0xd0,
-	0x74, 0xa6, 0x3d, 0x3f, 0xf0, 0x88, 0x87, 0xe0, 0x78, 0xb6, 0x8f, 0x43, 0x7f, 0x8c, 0x03, 0x6c,
-	0xae, 0x1e, 0x79, 0xde, 0xd1, 0x04, 0x6f, 0xd9, 0xbe, 0xb3, 0x65, 0xbb, 0xae, 0x47, 0x6c, 0xe2,
-	0x78, 0x6e, 0xc8, 0x39, 0xcd, 0x75, 0x41, 0x65, 0xab, 0xfd, 0xd9, 0xe1, 0x16, 0x71, 0xa6, 0x38,
-	0x24, 0xf6, 0xd4, 0xe7, 0x0c, 0xd6, 0x45, 0xe8, 0xf4, 0x31, 0x79, 0x8d, 0x83, 0xd0, 0xf1, 0xdc,
-	0x01, 0xfe, 0x7e, 0x86, 0x43, 0x62, 0xf5, 0x00, 0xc9, 0x9b, 0xa1, 0xef, 0xb9, 0x21, 0x46, 0x5d,
-	0x68, 0x9c, 0xf0, 0xad, 0xee, 0xc2, 0xc6, 0xc2, 0xe6, 0xe2, 0x20, 0x5a, 0x5a, 0x7f, 0x2d, 0x00,
-	0x7a, 0x14, 0x60, 0x9b, 0xe0, 0x7e, 0xe0, 0xcd, 0x7c, 0x01, 0x83, 0x6e, 0xc1, 0xb2, 0x6f, 0x07,
-	0xd8, 0x25, 0xc3, 0x23, 0xba, 0x3d, 0x74, 0x46, 0x42, 0xb0, 0xcd, 0xb7, 0x19, 0xf3, 0xce, 0x08,
-	0x5d, 0x07, 0xe0, 0x0c, 0xae, 0x3d, 0xc5, 0x5d, 0x83, 0xb1, 0x2c, 0xb2, 0x9d, 0xe7, 0xf6, 0x14,
-	0xa3, 0x0d, 0x68, 0x8d, 0x70, 0x78, 0x10, 0x38, 0x3e, 0xfd, 0xb3, 0x6e, 0x85, 0xd1, 0xe5, 0x2d,
-	0xf4, 0x39, 0xd4, 0xf0, 0x8f, 0x24, 0xb0, 0xbb, 0xd5, 0x8d, 0xca, 0x66, 0x6b, 0xfb, 0x76, 0x2f,
-	0xb1, 0x4f, 0x4f, 0xd7, 0xab, 0xf7, 0x98, 0xf2, 0x3e, 0x76, 0x49, 0x70, 0x3a, 0xe0, 0x72, 0xe6,
-	0x3d, 0x80, 0x64, 0x13, 0xad, 0x40, 0xe5, 0x18, 0x9f, 0x0a, 0x5d, 0xe9, 0x27, 0xba, 0x04, 0xb5,
-	0x13, 0x7b, 0x32, 0x8b, 0x94, 0xe3, 0x8b, 0xcf, 0x8c, 0x7b, 0x0b, 0xd6, 0x87, 0x70, 0x51, 0x39,
-	0x41, 0xd8, 0xea, 0x2a, 0x34, 0x53, 0xff, 0xdc, 0x38, 0xe2, 0x7f, 0x4b, 0x25, 0xbe, 0xc0, 0x13,
-	0x2c, 0x24, 0xc2, 0xc8, 0x58, 0xaa, 0x44, 0x45, 0x96, 0xb8, 0x0b, 0x97, 0x54, 0x89, 0xcc, 0x43,
-	0x14, 0x91, 0x9f, 0x0d, 0x40, 0xcf, 0xbc, 0x91, 0x73, 0x78, 0xaa, 0x78, 0x24, 0x5f, 0xad, 0x2c,
-	0x67, 0x19, 0xe5, 0xce, 0xaa, 0x94, 0x38, 0xab, 0x5a, 0xe0, 0xac, 0x9a, 0xee, 0x2c, 0x5d, 0xe5,
-	0xf3, 0x76, 0x96, 0x72, 0x42, 0xb9, 0xb3, 0xfe, 0xac, 0x40, 0x8d, 0x31, 0xcf, 0x1d, 0xcc, 0x32,
-	0x98, 0xa1, 0x9a, 0x38, 0x36, 0x9d, 0x6f, 0x93, 0xb1, 0x62, 0xba, 0x17, 0x36, 0x19, 0xa7, 0x2c,
-	0x5b, 0x2d, 0xb1, 0x6c, 0x4d, 0xb7, 0xec, 0x65, 0xa8, 0x87, 0xc4, 0x26, 0xb3, 0xb0, 0x5b, 0x67,
-	0x44, 0xb1, 0x42, 0xdb, 0x91, 0xc5, 0x1b, 0xcc, 0xe2, 0xab, 0xb2, 0xc5, 0x99, 0xda, 0xba, 0x91,
-	0xd1, 0x7d, 0x68, 0x1d, 0xb0, 0xb8, 0x1e, 0xd2, 0x8a, 0xd1, 0x6d, 0x6e, 0x2c, 0x6c, 0xb6, 0xb6,
-	0xcd, 0x1e, 0x2f, 0x27, 0xbd, 0xa8, 0x9c, 0xf4, 0x5e, 0x46, 0xe5, 0x64, 0x00, 0x9c, 0x9d, 0x6e,
-	0x50, 0xe1, 0x99, 0x3f, 0x8a, 0x85, 0x17, 0xcb, 0x85, 0x39, 0x7b, 0x24, 0xcc, 0xf5, 0xe6, 0xc2,
-	0x50, 0x2e, 0xcc, 0xd9, 0xe9, 0xc6, 0x19, 0x62, 0x03, 0x43, 0x9b, 0xd9, 0xe2, 0x8d, 0x43, 0xc6,
-	0xaf, 0x42, 0x1c, 0xa0, 0xf7, 0xa0, 0xc6, 0x8c, 0xcf, 0xc4, 0x5b, 0xdb, 0x1d, 0xcd, 0x6a, 0x03,
-	0x4e, 0x47, 0xef, 0x43, 0x73, 0x16, 0xe2, 0x60, 0x18, 0x62, 0xd2, 0x35, 0x98, 0x85, 0x57, 0x64,
-	0x5e, 0x0a, 0x36, 0x68, 0x50, 0x8e, 0x6f, 0x30, 0xb1, 0x3e, 0x80, 0xe5, 0x3e, 0x26, 0x73, 0x26,
-	0xa5, 0x75, 0x1f, 0x56, 0x12, 0x6e, 0x11, 0xad, 0xf3, 0xea, 0x65, 0xed, 0x42, 0x37, 0x12, 0x8e,
-	0x7e, 0x2a, 0x06, 0xd9, 0x52, 0x41, 0xae, 0x6a, 0x20, 0xb1, 0x84, 0x00, 0xfb, 0xdd, 0x80, 0xce,
-	0x53, 0x27, 0x24, 0x6a, 0xd1, 0x5a, 0x87, 0x56, 0x88, 0xed, 0xe0, 0x60, 0x3c, 0xfc, 0xc1, 0x0b,
-	0xa2, 0x22, 0x04, 0x7c, 0xeb, 0x8d, 0x17, 0xb0, 0x6c, 0x08, 0xbd, 0x80, 0x0c, 0xa9, 0x1b, 0x44,
-	0x36, 0xd0, 0xf5, 0x2e, 0x3e, 0xa5, 0xed, 0x24, 0xc0, 0xb4, 0x83, 0xf0, 0x2a, 0xd2, 0x1c, 0x44,
-	0x4b, 0x1a, 0xc7, 0xde, 0xe1, 0x21, 0x35, 0x27, 0x4d, 0x82, 0xf6, 0x40, 0xac, 0xa8, 0xf3, 0x26,
-	0xce, 0xd4, 0x21, 0x2c, 0xf6, 0xdb, 0x03, 0xbe, 0x40, 0x16, 0xb4, 0x03, 0xcf, 0x93, 0xd2, 0xb2,
-	0xce, 0xb4, 0x68, 0xd1, 0xcd, 0x7e, 0x7e, 0x71, 0x6b, 0x30, 0xae, 0x82, 0xe4, 0x6d, 0x2a, 0x15,
-	0x35, 0x95, 0xbc, 0x8b, 0x8c, 0x98, 0x9b, 0xbc, 0x20, 0x91, 0x59, 0xf2, 0x26, 0xa9, 0xd9, 0x62,
-	0x24, 0xb1, 0xb2, 0xf6, 0x00, 0xc9, 0x56, 0x15, 0xde, 0xb9, 0x04, 0x35, 0xe2, 0x11, 0x7b, 0xc2,
-	0xbc, 0xd3, 0x1e, 0xf0, 0x05, 0xea, 0x01, 0x07, 0x94, 0x02, 0x2d, 0xc3, 0xf9, 0xfc, 0x07, 0x68,
-	0xa8, 0x7d, 0x07, 0x66, 0x82, 0xad, 0x45, 0x40, 0xf6, 0x19, 0x9f, 0xe8, 0x67, 0x14, 0xc4, 0x46,
-	0x72, 0xd6, 0xaf, 0x06, 0x74, 0x78, 0x1f, 0xe4, 0x87, 0xf0, 0xf0, 0x30, 0x79, 0x66, 0x30, 0x93,
-	0xf0, 0xc8, 0x8e, 0xd7, 0xf4, 0x7c, 0x3c, 0xb5, 0x9d, 0x49, 0x94, 0x89, 0x6c, 0x81, 0x6e, 0xc0,
-	0x92, 0x3f, 0xf6, 0x5c, 0x3c, 0x74, 0x67, 0xd3, 0x7d, 0x1c, 0x44, 0xcd, 0x9e, 0xed, 0x3d, 0x67,
-	0x5b, 0x73, 0x74, 0x18, 0x13, 0x9a, 0xbe, 0x1d, 0x86, 0x2c, 0x24, 0x79, 0x99, 0x8c, 0xd7, 0xe8,
-	0x41, 0x54, 0x0b, 0xeb, 0xec, 0xe7, 0x36, 0xf5, 0x51, 0x41, 0xfa, 0x81, 0x73, 0x6d, 0x3e, 0x77,
-	0xa2, 0x19, 0x49, 0x71, 0xc3, 0x15, 0x60, 0xa5, 0x21, 0xc9, 0xfd, 0x3a, 0x5d, 0xee, 0x8c, 0x28,
-	0x3b, 0x6f, 0xfa, 0x94, 0x3d, 0x4e, 0x38, 0x85, 0xbd, 0x22, 0xb1, 0xf7, 0xa2, 0xa9, 0x42, 0xb0,
-	0x67, 0xc1, 0xcb, 0xfc, 0xbf, 0x18, 0xd0, 0xe1, 0xbd, 0x50, 0x76, 0x58, 0x9e, 0x36, 0x8a, 0x27,
-	0x8d, 0x3c, 0x4f, 0x56, 0x8a, 0x3c, 0x59, 0x2d, 0xf5, 0x64, 0x46, 0x47, 0x7b, 0xa0, 0x76, 0xae,
-	0x4d, 0x7d, 0x56, 0xf8, 0x07, 0xbd, 0x25, 0x1f, 0x50, 0xe6, 0xad, 0xdf, 0x2a, 0x50, 0x65, 0x5d,
-	0xe3, 0xdf, 0x66, 0xc1, 0xbc, 0x99, 0xe0, 0xae, 0x6a, 0xd9, 0x6b, 0xe9, 0x8e, 0xf5, 0xff, 0x48,
-	0xc0, 0x46, 0x02, 0x6a, 0x0a, 0x5a, 0xee, 0xf8, 0x0c, 0x78, 0x13, 0xaa, 0xd4, 0x67, 0xa2, 0x69,
-	0xea, 0x5d, 0x9e, 0x51, 0xdf, 0xba, 0x4e, 0xdf, 0x86, 0x0b, 0x7d, 0x4c, 0xe6, 0x49, 0x43, 0xeb,
-	0x53, 0x36, 0x3d, 0x28, 0x21, 0x39, 0x97, 0x4e, 0xd6, 0x0e, 0x9b, 0x05, 0x94, 0xbf, 0x89, 0x11,
-	0xee, 0x28, 0x08, 0x57, 0xd3, 0x08, 0x89, 0x00, 0x87, 0xfa, 0xc3, 0x80, 0x15, 0xda, 0x57, 0x94,
-	0xba, 0xf4, 0x5f, 0x19, 0x04, 0xe4, 0x06, 0xdf, 0x50, 0x1b, 0xbc, 0x64, 0xf4, 0xa6, 0x5c, 0x2a,
-	0x95, 0xcc, 0xe5, 0x7d, 0x3f, 0x23, 0x73, 0x79, 0xc7, 0xcf, 0xc9, 0x5c, 0xde, 0xf3, 0x95, 0xcc,
-	0x4d, 0xf2, 0x72, 0x49, 0x19, 0x08, 0x5e, 0xf3, 0x31, 0x4b, 0xad, 0xe2, 0xd9, 0xbd, 0xfa, 0xad,
-	0xe6, 0xce, 0x31, 0x1f, 0x06, 0x18, 0xae, 0x1e, 0x02, 0xd9, 0x07, 0x7c, 0xac, 0x1d, 0x50, 0x10,
-	0x1c, 0xf1, 0x49, 0x5f, 0xc2, 0xca, 0x57, 0x9e, 0xe3, 0x16, 0x8c, 0xb8, 0x79, 0x66, 0x37, 0x94,
-	0x0e, 0xd5, 0x87, 0x8e, 0x84, 0x53, 0x7a, 0xe5, 0x2d, 0x04, 0x7a, 0x8a, 0xed, 0x13, 0x7c, 0x66,
-	0x8d, 0x9e, 0x00, 0x92, 0x81, 0xce, 0xa0, 0xd2, 0x53, 0x78, 0x87, 0x77, 0x97, 0x17, 0x62, 0x2e,
-	0x99, 0xa7, 0x01, 0xc7, 0x33, 0x8d, 0xa1, 0xce, 0x34, 0xd6, 0x5d, 0xb8, 0x9c, 0x46, 0x2b, 0xeb,
-	0x57, 0xcf, 0xe0, 0xf2, 0x23, 0x6f, 0x4a, 0x87, 0xdf, 0x73, 0xd1, 0xe0, 0x36, 0x5c, 0xd1, 0xe0,
-	0x84, 0x0a, 0x17, 0xc0, 0xf0, 0x8e, 0x19, 0x54, 0x73, 0x60, 0x78, 0xc7, 0xdb, 0x3f, 0x2d, 0xc1,
-	0xf2, 0xce, 0x08, 0xbb, 0xc4, 0x21, 0xa7, 0xcf, 0x6c, 0xd7, 0x3e, 0xc2, 0x01, 0xda, 0x05, 0x48,
-	0xde, 0x9b, 0xd0, 0x75, 0xa5, 0x58, 0xa6, 0x1f, 0xa7, 0xcc, 0xb5, 0x3c, 0xb2, 0x38, 0xf0, 0x39,
-	0xb4, 0xa4, 0x17, 0x19, 0xb4, 0x56, 0xfc, 0x18, 0x64, 0xae, 0xe7, 0xd2, 0x05, 0xde, 0xd7, 0xb0,
-	0x24, 0xbf, 0xbe, 0x20, 0x45, 0x20, 0xe3, 0x25, 0xc7, 0xdc, 0xc8, 0x67, 0x48, 0x54, 0x94, 0xde,
-	0x21, 0x54, 0x15, 0xf5, 0x27, 0x10, 0x55, 0xc5, 0xac, 0x07, 0x8c, 0xc7, 0xd0, 0x8c, 0x6e, 0x7a,
-	0xe8, 0x5a, 0xca, 0x3c, 0x0a, 0xd2, 0x6a, 0x36, 0x51, 0xc0, 0xbc, 0x4a, 0x6e, 0x9b, 0xf1, 0x2d,
-	0xb8, 0x10, 0xee, 0x66, 0x16, 0x51, 0xbb, 0x69, 0xec, 0x02, 0x24, 0xf7, 0x10, 0xd5, 0xbb, 0xda,
-	0x8d, 0x52, 0xf5, 0x6e, 0xc6, 0xd5, 0xe8, 0x5b, 0xf9, 0xc2, 0x14, 0x6b, 0x59, 0x02, 0x7a, 0x2b,
-	0x9b, 0x9c, 0xa5, 0x69, 0x32, 0xa2, 0xab, 0xa0, 0xda, 0xdd, 0xc0, 0x5c, 0xcb, 0x23, 0x27, 0x4e,
-	0x96, 0x26, 0x72, 0xd5, 0xc9, 0xfa, 0x64, 0x6f, 0xae, 0xe7, 0xd2, 0x13, 0xe5, 0x92, 0x89, 0x54,
-	0x55, 0x4e, 0x1b, 0x85, 0xcd, 0xb5, 0x3c, 0xb2, 0x00, 0x7b, 0x08, 0x0d, 0x31, 0x0f, 0x20, 0x33,
-	0xe5, 0x44, 0x19, 0xe6, 0x5a, 0x26, 0x4d, 0x60, 0xbc, 0x64, 0xe1, 0xa2, 0x4e, 0x48, 0x45, 0x60,
-	0x37, 0x33, 0x68, 0x7a, 0x2b, 0x7a, 0x02, 0x8b, 0x71, 0xa3, 0x42, 0xab, 0x69, 0xc7, 0x29, 0x26,
-	0xbb, 0x9e, 0x43, 0x15, 0x48, 0xe2, 0x6e, 0xad, 0xb6, 0xbc, 0x12, 0xc8, 0x5b, 0x99, 0xd4, 0x4c,
-	0x2d, 0xe3, 0xe6, 0xa4, 0x42, 0xa6, 0x7b, 0x9f, 0xaa, 0xa5, 0xde, 0xd1, 0x68, 0x76, 0xc4, 0x4d,
-	0x25, 0x15, 0xc8, 0xe9, 0xae, 0x95, 0xca, 0x0e, 0xbd, 0x17, 0xed, 0xc1, 0x72, 0xaa, 0x0e, 0x23,
-	0x4b, 0x09, 0xd3, 0xcc, 0x9a, 0x6f, 0xbe, 0x5b, 0xc8, 0x23, 0xb0, 0xdf, 0xc0, 0x05, 0xb5, 0xcb,
-	0xa0, 0x1b, 0x7a, 0x90, 0xa5, 0x91, 0xad, 0x22, 0x16, 0x0e, 0xfc, 0xb0, 0xba, 0x67, 0xf8, 0xfb,
-	0xfb, 0x75, 0x36, 0xc6, 0x7f, 0xf4, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x95, 0xb0, 0xc0, 0xf5,
-	0xe6, 0x18, 0x00, 0x00,
+func (m *PreviewRuleRequest) Reset()         { *m = PreviewRuleRequest{} }
+func (m *PreviewRuleRequest) String() string { return proto.CompactTextString(m) }
+func (*PreviewRuleRequest) ProtoMessage()    {}
+func (*PreviewRuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{46}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *PreviewRuleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreviewRuleRequest.Unmarshal(m, b)
+}
+func (m *PreviewRuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreviewRuleRequest.Marshal(b, m, deterministic)
+}
+func (m *PreviewRuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewRuleRequest.Merge(m, src)
+}
+func (m *PreviewRuleRequest) XXX_Size() int {
+	return xxx_messageInfo_PreviewRuleRequest.Size(m)
+}
+func (m *PreviewRuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewRuleRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_PreviewRuleRequest proto.InternalMessageInfo
 
-// IdentityManagerClient is the client API for IdentityManager service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type IdentityManagerClient interface {
-	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
-	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
-	DeleteGroups(ctx context.Context, in *DeleteGroupsRequest, opts ...grpc.CallOption) (*DeleteGroupsResponse, error)
-	ModifyGroup(ctx context.Context, in *ModifyGroupRequest, opts ...grpc.CallOption) (*ModifyGroupResponse, error)
-	GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupResponse, error)
-	GetGroupWithUser(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupWithUserResponse, error)
-	ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error)
-	ListGroupsWithUser(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsWithUserResponse, error)
-	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
-	DeleteUsers(ctx context.Context, in *DeleteUsersRequest, opts ...grpc.CallOption) (*DeleteUsersResponse, error)
-	ModifyUser(ctx context.Context, in *ModifyUserRequest, opts ...grpc.CallOption) (*ModifyUserResponse, error)
-	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
-	GetUserWithGroup(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserWithGroupResponse, error)
-	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
-	ListUsersWithGroup(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersWithGroupResponse, error)
-	JoinGroup(ctx context.Context, in *JoinGroupRequest, opts ...grpc.CallOption) (*JoinGroupResponse, error)
-	LeaveGroup(ctx context.Context, in *LeaveGroupRequest, opts ...grpc.CallOption) (*LeaveGroupResponse, error)
-	ComparePassword(ctx context.Context, in *ComparePasswordRequest, opts ...grpc.CallOption) (*ComparePasswordResponse, error)
-	ModifyPassword(ctx context.Context, in *ModifyPasswordRequest, opts ...grpc.CallOption) (*ModifyPasswordResponse, error)
+func (m *PreviewRuleRequest) GetMembershipRule() string {
+	if m != nil {
+		return m.MembershipRule
+	}
+	return ""
 }
 
-type identityManagerClient struct {
-	cc *grpc.ClientConn
+func (m *PreviewRuleRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
 }
 
-func NewIdentityManagerClient(cc *grpc.ClientConn) IdentityManagerClient {
-	return &identityManagerClient{cc}
+func (m *PreviewRuleRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
 
-func (c *identityManagerClient) GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error) {
-	out := new(GetVersionResponse)
-	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/GetVersion", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type PreviewRuleResponse struct {
+	Total                uint32   `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	UserSet              []*User  `protobuf:"bytes,2,rep,name=user_set,json=userSet,proto3" json:"user_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *identityManagerClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error) {
-	out := new(CreateGroupResponse)
-	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/CreateGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *PreviewRuleResponse) Reset()         { *m = PreviewRuleResponse{} }
+func (m *PreviewRuleResponse) String() string { return proto.CompactTextString(m) }
+func (*PreviewRuleResponse) ProtoMessage()    {}
+func (*PreviewRuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{47}
 }
 
-func (c *identityManagerClient) DeleteGroups(ctx context.Context, in *DeleteGroupsRequest, opts ...grpc.CallOption) (*DeleteGroupsResponse, error) {
-	out := new(DeleteGroupsResponse)
-	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/DeleteGroups", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *PreviewRuleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreviewRuleResponse.Unmarshal(m, b)
+}
+func (m *PreviewRuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreviewRuleResponse.Marshal(b, m, deterministic)
+}
+func (m *PreviewRuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewRuleResponse.Merge(m, src)
+}
+func (m *PreviewRuleResponse) XXX_Size() int {
+	return xxx_messageInfo_PreviewRuleResponse.Size(m)
+}
+func (m *PreviewRuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewRuleResponse.DiscardUnknown(m)
 }
 
-func (c *identityManagerClient) ModifyGroup(ctx context.Context, in *ModifyGroupRequest, opts ...grpc.CallOption) (*ModifyGroupResponse, error) {
-	out := new(ModifyGroupResponse)
-	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ModifyGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PreviewRuleResponse proto.InternalMessageInfo
+
+func (m *PreviewRuleResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
 	}
-	return out, nil
+	return 0
 }
 
-func (c *identityManagerClient) GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupResponse, error) {
-	out := new(GetGroupResponse)
-	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/GetGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PreviewRuleResponse) GetUserSet() []*User {
+	if m != nil {
+		return m.UserSet
 	}
-	return out, nil
+	return nil
 }
 
-func (c *identityManagerClient) GetGroupWithUser(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupWithUserResponse, error) {
-	out := new(GetGroupWithUserResponse)
-	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/GetGroupWithUser", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// ExtendMembershipRequest pushes out the expire time of the bindings of the users to the groups
+type ExtendMembershipRequest struct {
+	GroupId              []string             `protobuf:"bytes,1,rep,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId               []string             `protobuf:"bytes,2,rep,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpireTime           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (c *identityManagerClient) ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error) {
-	out := new(ListGroupsResponse)
-	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ListGroups", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ExtendMembershipRequest) Reset()         { *m = ExtendMembershipRequest{} }
+func (m *ExtendMembershipRequest) String() string { return proto.CompactTextString(m) }
+func (*ExtendMembershipRequest) ProtoMessage()    {}
+func (*ExtendMembershipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{48}
 }
 
-func (c *identityManagerClient) ListGroupsWithUser(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsWithUserResponse, error) {
-	out := new(ListGroupsWithUserResponse)
-	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ListGroupsWithUser", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ExtendMembershipRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExtendMembershipRequest.Unmarshal(m, b)
+}
+func (m *ExtendMembershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExtendMembershipRequest.Marshal(b, m, deterministic)
+}
+func (m *ExtendMembershipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendMembershipRequest.Merge(m, src)
+}
+func (m *ExtendMembershipRequest) XXX_Size() int {
+	return xxx_messageInfo_ExtendMembershipRequest.Size(m)
+}
+func (m *ExtendMembershipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendMembershipRequest.DiscardUnknown(m)
 }
 
-func (c *identityManagerClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
-	out := new(CreateUserResponse)
-	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/CreateUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ExtendMembershipRequest proto.InternalMessageInfo
+
+func (m *ExtendMembershipRequest) GetGroupId() []string {
+	if m != nil {
+		return m.GroupId
 	}
-	return out, nil
+	return nil
 }
 
-func (c *identityManagerClient) DeleteUsers(ctx context.Context, in *DeleteUsersRequest, opts ...grpc.CallOption) (*DeleteUsersResponse, error) {
-	out := new(DeleteUsersResponse)
-	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/DeleteUsers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ExtendMembershipRequest) GetUserId() []string {
+	if m != nil {
+		return m.UserId
 	}
-	return out, nil
+	return nil
 }
 
-func (c *identityManagerClient) ModifyUser(ctx context.Context, in *ModifyUserRequest, opts ...grpc.CallOption) (*ModifyUserResponse, error) {
-	out := new(ModifyUserResponse)
+func (m *ExtendMembershipRequest) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+type ExtendMembershipResponse struct {
+	BindingSet           []*UserGroupBinding `protobuf:"bytes,1,rep,name=binding_set,json=bindingSet,proto3" json:"binding_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ExtendMembershipResponse) Reset()         { *m = ExtendMembershipResponse{} }
+func (m *ExtendMembershipResponse) String() string { return proto.CompactTextString(m) }
+func (*ExtendMembershipResponse) ProtoMessage()    {}
+func (*ExtendMembershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{49}
+}
+
+func (m *ExtendMembershipResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExtendMembershipResponse.Unmarshal(m, b)
+}
+func (m *ExtendMembershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExtendMembershipResponse.Marshal(b, m, deterministic)
+}
+func (m *ExtendMembershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendMembershipResponse.Merge(m, src)
+}
+func (m *ExtendMembershipResponse) XXX_Size() int {
+	return xxx_messageInfo_ExtendMembershipResponse.Size(m)
+}
+func (m *ExtendMembershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendMembershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendMembershipResponse proto.InternalMessageInfo
+
+func (m *ExtendMembershipResponse) GetBindingSet() []*UserGroupBinding {
+	if m != nil {
+		return m.BindingSet
+	}
+	return nil
+}
+
+// ListExpiringMembershipsRequest lists the bindings with an expire time, the earliest first
+type ListExpiringMembershipsRequest struct {
+	GroupId              []string             `protobuf:"bytes,1,rep,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId               []string             `protobuf:"bytes,2,rep,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpireBefore         *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expire_before,json=expireBefore,proto3" json:"expire_before,omitempty"`
+	Offset               uint32               `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint32               `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListExpiringMembershipsRequest) Reset()         { *m = ListExpiringMembershipsRequest{} }
+func (m *ListExpiringMembershipsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListExpiringMembershipsRequest) ProtoMessage()    {}
+func (*ListExpiringMembershipsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{50}
+}
+
+func (m *ListExpiringMembershipsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListExpiringMembershipsRequest.Unmarshal(m, b)
+}
+func (m *ListExpiringMembershipsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListExpiringMembershipsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListExpiringMembershipsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListExpiringMembershipsRequest.Merge(m, src)
+}
+func (m *ListExpiringMembershipsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListExpiringMembershipsRequest.Size(m)
+}
+func (m *ListExpiringMembershipsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListExpiringMembershipsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListExpiringMembershipsRequest proto.InternalMessageInfo
+
+func (m *ListExpiringMembershipsRequest) GetGroupId() []string {
+	if m != nil {
+		return m.GroupId
+	}
+	return nil
+}
+
+func (m *ListExpiringMembershipsRequest) GetUserId() []string {
+	if m != nil {
+		return m.UserId
+	}
+	return nil
+}
+
+func (m *ListExpiringMembershipsRequest) GetExpireBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireBefore
+	}
+	return nil
+}
+
+func (m *ListExpiringMembershipsRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListExpiringMembershipsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListExpiringMembershipsResponse struct {
+	Total                uint32              `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	BindingSet           []*UserGroupBinding `protobuf:"bytes,2,rep,name=binding_set,json=bindingSet,proto3" json:"binding_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListExpiringMembershipsResponse) Reset()         { *m = ListExpiringMembershipsResponse{} }
+func (m *ListExpiringMembershipsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListExpiringMembershipsResponse) ProtoMessage()    {}
+func (*ListExpiringMembershipsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{51}
+}
+
+func (m *ListExpiringMembershipsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListExpiringMembershipsResponse.Unmarshal(m, b)
+}
+func (m *ListExpiringMembershipsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListExpiringMembershipsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListExpiringMembershipsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListExpiringMembershipsResponse.Merge(m, src)
+}
+func (m *ListExpiringMembershipsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListExpiringMembershipsResponse.Size(m)
+}
+func (m *ListExpiringMembershipsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListExpiringMembershipsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListExpiringMembershipsResponse proto.InternalMessageInfo
+
+func (m *ListExpiringMembershipsResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ListExpiringMembershipsResponse) GetBindingSet() []*UserGroupBinding {
+	if m != nil {
+		return m.BindingSet
+	}
+	return nil
+}
+
+// MembershipRemoval records a binding removed by the service rather than by LeaveGroup
+type MembershipRemoval struct {
+	BindingId            string               `protobuf:"bytes,1,opt,name=binding_id,json=bindingId,proto3" json:"binding_id,omitempty"`
+	GroupId              string               `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId               string               `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason               string               `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpireTime           *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *MembershipRemoval) Reset()         { *m = MembershipRemoval{} }
+func (m *MembershipRemoval) String() string { return proto.CompactTextString(m) }
+func (*MembershipRemoval) ProtoMessage()    {}
+func (*MembershipRemoval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{52}
+}
+
+func (m *MembershipRemoval) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MembershipRemoval.Unmarshal(m, b)
+}
+func (m *MembershipRemoval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MembershipRemoval.Marshal(b, m, deterministic)
+}
+func (m *MembershipRemoval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MembershipRemoval.Merge(m, src)
+}
+func (m *MembershipRemoval) XXX_Size() int {
+	return xxx_messageInfo_MembershipRemoval.Size(m)
+}
+func (m *MembershipRemoval) XXX_DiscardUnknown() {
+	xxx_messageInfo_MembershipRemoval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MembershipRemoval proto.InternalMessageInfo
+
+func (m *MembershipRemoval) GetBindingId() string {
+	if m != nil {
+		return m.BindingId
+	}
+	return ""
+}
+
+func (m *MembershipRemoval) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *MembershipRemoval) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *MembershipRemoval) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *MembershipRemoval) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *MembershipRemoval) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+type ListMembershipRemovalsRequest struct {
+	GroupId              []string `protobuf:"bytes,1,rep,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId               []string `protobuf:"bytes,2,rep,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Offset               uint32   `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint32   `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListMembershipRemovalsRequest) Reset()         { *m = ListMembershipRemovalsRequest{} }
+func (m *ListMembershipRemovalsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMembershipRemovalsRequest) ProtoMessage()    {}
+func (*ListMembershipRemovalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{53}
+}
+
+func (m *ListMembershipRemovalsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMembershipRemovalsRequest.Unmarshal(m, b)
+}
+func (m *ListMembershipRemovalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMembershipRemovalsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListMembershipRemovalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMembershipRemovalsRequest.Merge(m, src)
+}
+func (m *ListMembershipRemovalsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListMembershipRemovalsRequest.Size(m)
+}
+func (m *ListMembershipRemovalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMembershipRemovalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMembershipRemovalsRequest proto.InternalMessageInfo
+
+func (m *ListMembershipRemovalsRequest) GetGroupId() []string {
+	if m != nil {
+		return m.GroupId
+	}
+	return nil
+}
+
+func (m *ListMembershipRemovalsRequest) GetUserId() []string {
+	if m != nil {
+		return m.UserId
+	}
+	return nil
+}
+
+func (m *ListMembershipRemovalsRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListMembershipRemovalsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListMembershipRemovalsResponse struct {
+	Total                uint32               `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	RemovalSet           []*MembershipRemoval `protobuf:"bytes,2,rep,name=removal_set,json=removalSet,proto3" json:"removal_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListMembershipRemovalsResponse) Reset()         { *m = ListMembershipRemovalsResponse{} }
+func (m *ListMembershipRemovalsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMembershipRemovalsResponse) ProtoMessage()    {}
+func (*ListMembershipRemovalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{54}
+}
+
+func (m *ListMembershipRemovalsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMembershipRemovalsResponse.Unmarshal(m, b)
+}
+func (m *ListMembershipRemovalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMembershipRemovalsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListMembershipRemovalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMembershipRemovalsResponse.Merge(m, src)
+}
+func (m *ListMembershipRemovalsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListMembershipRemovalsResponse.Size(m)
+}
+func (m *ListMembershipRemovalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMembershipRemovalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMembershipRemovalsResponse proto.InternalMessageInfo
+
+func (m *ListMembershipRemovalsResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ListMembershipRemovalsResponse) GetRemovalSet() []*MembershipRemoval {
+	if m != nil {
+		return m.RemovalSet
+	}
+	return nil
+}
+
+type ListEffectiveGroupsRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListEffectiveGroupsRequest) Reset()         { *m = ListEffectiveGroupsRequest{} }
+func (m *ListEffectiveGroupsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListEffectiveGroupsRequest) ProtoMessage()    {}
+func (*ListEffectiveGroupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{55}
+}
+
+func (m *ListEffectiveGroupsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEffectiveGroupsRequest.Unmarshal(m, b)
+}
+func (m *ListEffectiveGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEffectiveGroupsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListEffectiveGroupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEffectiveGroupsRequest.Merge(m, src)
+}
+func (m *ListEffectiveGroupsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListEffectiveGroupsRequest.Size(m)
+}
+func (m *ListEffectiveGroupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEffectiveGroupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEffectiveGroupsRequest proto.InternalMessageInfo
+
+func (m *ListEffectiveGroupsRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+// EffectiveGroup is a group the user is bound to or inherits from a sub group
+type EffectiveGroup struct {
+	Group  *Group `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Direct bool   `protobuf:"varint,2,opt,name=direct,proto3" json:"direct,omitempty"`
+	// group ids from this group down to the group the user is bound to, through sub groups
+	// and nested member groups, the nearest one if several
+	Path                 []string `protobuf:"bytes,3,rep,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EffectiveGroup) Reset()         { *m = EffectiveGroup{} }
+func (m *EffectiveGroup) String() string { return proto.CompactTextString(m) }
+func (*EffectiveGroup) ProtoMessage()    {}
+func (*EffectiveGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{56}
+}
+
+func (m *EffectiveGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EffectiveGroup.Unmarshal(m, b)
+}
+func (m *EffectiveGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EffectiveGroup.Marshal(b, m, deterministic)
+}
+func (m *EffectiveGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveGroup.Merge(m, src)
+}
+func (m *EffectiveGroup) XXX_Size() int {
+	return xxx_messageInfo_EffectiveGroup.Size(m)
+}
+func (m *EffectiveGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EffectiveGroup proto.InternalMessageInfo
+
+func (m *EffectiveGroup) GetGroup() *Group {
+	if m != nil {
+		return m.Group
+	}
+	return nil
+}
+
+func (m *EffectiveGroup) GetDirect() bool {
+	if m != nil {
+		return m.Direct
+	}
+	return false
+}
+
+func (m *EffectiveGroup) GetPath() []string {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+type ListEffectiveGroupsResponse struct {
+	Total                uint32            `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	GroupSet             []*EffectiveGroup `protobuf:"bytes,2,rep,name=group_set,json=groupSet,proto3" json:"group_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListEffectiveGroupsResponse) Reset()         { *m = ListEffectiveGroupsResponse{} }
+func (m *ListEffectiveGroupsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEffectiveGroupsResponse) ProtoMessage()    {}
+func (*ListEffectiveGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{57}
+}
+
+func (m *ListEffectiveGroupsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEffectiveGroupsResponse.Unmarshal(m, b)
+}
+func (m *ListEffectiveGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEffectiveGroupsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEffectiveGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEffectiveGroupsResponse.Merge(m, src)
+}
+func (m *ListEffectiveGroupsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEffectiveGroupsResponse.Size(m)
+}
+func (m *ListEffectiveGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEffectiveGroupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEffectiveGroupsResponse proto.InternalMessageInfo
+
+func (m *ListEffectiveGroupsResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ListEffectiveGroupsResponse) GetGroupSet() []*EffectiveGroup {
+	if m != nil {
+		return m.GroupSet
+	}
+	return nil
+}
+
+type ListEffectiveMembersRequest struct {
+	GroupId              string   `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Offset               uint32   `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint32   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListEffectiveMembersRequest) Reset()         { *m = ListEffectiveMembersRequest{} }
+func (m *ListEffectiveMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListEffectiveMembersRequest) ProtoMessage()    {}
+func (*ListEffectiveMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{58}
+}
+
+func (m *ListEffectiveMembersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEffectiveMembersRequest.Unmarshal(m, b)
+}
+func (m *ListEffectiveMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEffectiveMembersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListEffectiveMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEffectiveMembersRequest.Merge(m, src)
+}
+func (m *ListEffectiveMembersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListEffectiveMembersRequest.Size(m)
+}
+func (m *ListEffectiveMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEffectiveMembersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEffectiveMembersRequest proto.InternalMessageInfo
+
+func (m *ListEffectiveMembersRequest) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *ListEffectiveMembersRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListEffectiveMembersRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// EffectiveMember is a user bound to the group or to one of its sub groups
+type EffectiveMember struct {
+	User   *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Direct bool  `protobuf:"varint,2,opt,name=direct,proto3" json:"direct,omitempty"`
+	// group ids from the group down to the group the user is bound to, through sub groups
+	// and nested member groups, the nearest one if several
+	Path                 []string `protobuf:"bytes,3,rep,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EffectiveMember) Reset()         { *m = EffectiveMember{} }
+func (m *EffectiveMember) String() string { return proto.CompactTextString(m) }
+func (*EffectiveMember) ProtoMessage()    {}
+func (*EffectiveMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{59}
+}
+
+func (m *EffectiveMember) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EffectiveMember.Unmarshal(m, b)
+}
+func (m *EffectiveMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EffectiveMember.Marshal(b, m, deterministic)
+}
+func (m *EffectiveMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveMember.Merge(m, src)
+}
+func (m *EffectiveMember) XXX_Size() int {
+	return xxx_messageInfo_EffectiveMember.Size(m)
+}
+func (m *EffectiveMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EffectiveMember proto.InternalMessageInfo
+
+func (m *EffectiveMember) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *EffectiveMember) GetDirect() bool {
+	if m != nil {
+		return m.Direct
+	}
+	return false
+}
+
+func (m *EffectiveMember) GetPath() []string {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+type ListEffectiveMembersResponse struct {
+	Total                uint32             `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	UserSet              []*EffectiveMember `protobuf:"bytes,2,rep,name=user_set,json=userSet,proto3" json:"user_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ListEffectiveMembersResponse) Reset()         { *m = ListEffectiveMembersResponse{} }
+func (m *ListEffectiveMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEffectiveMembersResponse) ProtoMessage()    {}
+func (*ListEffectiveMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{60}
+}
+
+func (m *ListEffectiveMembersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEffectiveMembersResponse.Unmarshal(m, b)
+}
+func (m *ListEffectiveMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEffectiveMembersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEffectiveMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEffectiveMembersResponse.Merge(m, src)
+}
+func (m *ListEffectiveMembersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEffectiveMembersResponse.Size(m)
+}
+func (m *ListEffectiveMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEffectiveMembersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEffectiveMembersResponse proto.InternalMessageInfo
+
+func (m *ListEffectiveMembersResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ListEffectiveMembersResponse) GetUserSet() []*EffectiveMember {
+	if m != nil {
+		return m.UserSet
+	}
+	return nil
+}
+
+type IsMemberRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GroupId              string   `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Transitive           bool     `protobuf:"varint,3,opt,name=transitive,proto3" json:"transitive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IsMemberRequest) Reset()         { *m = IsMemberRequest{} }
+func (m *IsMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*IsMemberRequest) ProtoMessage()    {}
+func (*IsMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{61}
+}
+
+func (m *IsMemberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IsMemberRequest.Unmarshal(m, b)
+}
+func (m *IsMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IsMemberRequest.Marshal(b, m, deterministic)
+}
+func (m *IsMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsMemberRequest.Merge(m, src)
+}
+func (m *IsMemberRequest) XXX_Size() int {
+	return xxx_messageInfo_IsMemberRequest.Size(m)
+}
+func (m *IsMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsMemberRequest proto.InternalMessageInfo
+
+func (m *IsMemberRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *IsMemberRequest) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *IsMemberRequest) GetTransitive() bool {
+	if m != nil {
+		return m.Transitive
+	}
+	return false
+}
+
+type IsMemberResponse struct {
+	IsMember             bool     `protobuf:"varint,1,opt,name=is_member,json=isMember,proto3" json:"is_member,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IsMemberResponse) Reset()         { *m = IsMemberResponse{} }
+func (m *IsMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*IsMemberResponse) ProtoMessage()    {}
+func (*IsMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{62}
+}
+
+func (m *IsMemberResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IsMemberResponse.Unmarshal(m, b)
+}
+func (m *IsMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IsMemberResponse.Marshal(b, m, deterministic)
+}
+func (m *IsMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsMemberResponse.Merge(m, src)
+}
+func (m *IsMemberResponse) XXX_Size() int {
+	return xxx_messageInfo_IsMemberResponse.Size(m)
+}
+func (m *IsMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsMemberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsMemberResponse proto.InternalMessageInfo
+
+func (m *IsMemberResponse) GetIsMember() bool {
+	if m != nil {
+		return m.IsMember
+	}
+	return false
+}
+
+type ModifyPasswordRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModifyPasswordRequest) Reset()         { *m = ModifyPasswordRequest{} }
+func (m *ModifyPasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifyPasswordRequest) ProtoMessage()    {}
+func (*ModifyPasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{63}
+}
+
+func (m *ModifyPasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyPasswordRequest.Unmarshal(m, b)
+}
+func (m *ModifyPasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyPasswordRequest.Marshal(b, m, deterministic)
+}
+func (m *ModifyPasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyPasswordRequest.Merge(m, src)
+}
+func (m *ModifyPasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ModifyPasswordRequest.Size(m)
+}
+func (m *ModifyPasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyPasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyPasswordRequest proto.InternalMessageInfo
+
+func (m *ModifyPasswordRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ModifyPasswordRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type ModifyPasswordResponse struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModifyPasswordResponse) Reset()         { *m = ModifyPasswordResponse{} }
+func (m *ModifyPasswordResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifyPasswordResponse) ProtoMessage()    {}
+func (*ModifyPasswordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{64}
+}
+
+func (m *ModifyPasswordResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyPasswordResponse.Unmarshal(m, b)
+}
+func (m *ModifyPasswordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyPasswordResponse.Marshal(b, m, deterministic)
+}
+func (m *ModifyPasswordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyPasswordResponse.Merge(m, src)
+}
+func (m *ModifyPasswordResponse) XXX_Size() int {
+	return xxx_messageInfo_ModifyPasswordResponse.Size(m)
+}
+func (m *ModifyPasswordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyPasswordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyPasswordResponse proto.InternalMessageInfo
+
+func (m *ModifyPasswordResponse) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type ComparePasswordRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ComparePasswordRequest) Reset()         { *m = ComparePasswordRequest{} }
+func (m *ComparePasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ComparePasswordRequest) ProtoMessage()    {}
+func (*ComparePasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{65}
+}
+
+func (m *ComparePasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ComparePasswordRequest.Unmarshal(m, b)
+}
+func (m *ComparePasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ComparePasswordRequest.Marshal(b, m, deterministic)
+}
+func (m *ComparePasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComparePasswordRequest.Merge(m, src)
+}
+func (m *ComparePasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ComparePasswordRequest.Size(m)
+}
+func (m *ComparePasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComparePasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComparePasswordRequest proto.InternalMessageInfo
+
+func (m *ComparePasswordRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ComparePasswordRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type ComparePasswordResponse struct {
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ComparePasswordResponse) Reset()         { *m = ComparePasswordResponse{} }
+func (m *ComparePasswordResponse) String() string { return proto.CompactTextString(m) }
+func (*ComparePasswordResponse) ProtoMessage()    {}
+func (*ComparePasswordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{66}
+}
+
+func (m *ComparePasswordResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ComparePasswordResponse.Unmarshal(m, b)
+}
+func (m *ComparePasswordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ComparePasswordResponse.Marshal(b, m, deterministic)
+}
+func (m *ComparePasswordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComparePasswordResponse.Merge(m, src)
+}
+func (m *ComparePasswordResponse) XXX_Size() int {
+	return xxx_messageInfo_ComparePasswordResponse.Size(m)
+}
+func (m *ComparePasswordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComparePasswordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComparePasswordResponse proto.InternalMessageInfo
+
+func (m *ComparePasswordResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+type SendEmailVerificationRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Locale               string   `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendEmailVerificationRequest) Reset()         { *m = SendEmailVerificationRequest{} }
+func (m *SendEmailVerificationRequest) String() string { return proto.CompactTextString(m) }
+func (*SendEmailVerificationRequest) ProtoMessage()    {}
+func (*SendEmailVerificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{67}
+}
+
+func (m *SendEmailVerificationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendEmailVerificationRequest.Unmarshal(m, b)
+}
+func (m *SendEmailVerificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendEmailVerificationRequest.Marshal(b, m, deterministic)
+}
+func (m *SendEmailVerificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendEmailVerificationRequest.Merge(m, src)
+}
+func (m *SendEmailVerificationRequest) XXX_Size() int {
+	return xxx_messageInfo_SendEmailVerificationRequest.Size(m)
+}
+func (m *SendEmailVerificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendEmailVerificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendEmailVerificationRequest proto.InternalMessageInfo
+
+func (m *SendEmailVerificationRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *SendEmailVerificationRequest) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+type SendEmailVerificationResponse struct {
+	UserId               string               `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email                string               `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	ExpireTime           *timestamp.Timestamp `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SendEmailVerificationResponse) Reset()         { *m = SendEmailVerificationResponse{} }
+func (m *SendEmailVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*SendEmailVerificationResponse) ProtoMessage()    {}
+func (*SendEmailVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{68}
+}
+
+func (m *SendEmailVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendEmailVerificationResponse.Unmarshal(m, b)
+}
+func (m *SendEmailVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendEmailVerificationResponse.Marshal(b, m, deterministic)
+}
+func (m *SendEmailVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendEmailVerificationResponse.Merge(m, src)
+}
+func (m *SendEmailVerificationResponse) XXX_Size() int {
+	return xxx_messageInfo_SendEmailVerificationResponse.Size(m)
+}
+func (m *SendEmailVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendEmailVerificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendEmailVerificationResponse proto.InternalMessageInfo
+
+func (m *SendEmailVerificationResponse) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *SendEmailVerificationResponse) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *SendEmailVerificationResponse) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+type ConfirmEmailVerificationRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfirmEmailVerificationRequest) Reset()         { *m = ConfirmEmailVerificationRequest{} }
+func (m *ConfirmEmailVerificationRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfirmEmailVerificationRequest) ProtoMessage()    {}
+func (*ConfirmEmailVerificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{69}
+}
+
+func (m *ConfirmEmailVerificationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmEmailVerificationRequest.Unmarshal(m, b)
+}
+func (m *ConfirmEmailVerificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmEmailVerificationRequest.Marshal(b, m, deterministic)
+}
+func (m *ConfirmEmailVerificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmEmailVerificationRequest.Merge(m, src)
+}
+func (m *ConfirmEmailVerificationRequest) XXX_Size() int {
+	return xxx_messageInfo_ConfirmEmailVerificationRequest.Size(m)
+}
+func (m *ConfirmEmailVerificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmEmailVerificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmEmailVerificationRequest proto.InternalMessageInfo
+
+func (m *ConfirmEmailVerificationRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type ConfirmEmailVerificationResponse struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfirmEmailVerificationResponse) Reset()         { *m = ConfirmEmailVerificationResponse{} }
+func (m *ConfirmEmailVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*ConfirmEmailVerificationResponse) ProtoMessage()    {}
+func (*ConfirmEmailVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{70}
+}
+
+func (m *ConfirmEmailVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmEmailVerificationResponse.Unmarshal(m, b)
+}
+func (m *ConfirmEmailVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmEmailVerificationResponse.Marshal(b, m, deterministic)
+}
+func (m *ConfirmEmailVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmEmailVerificationResponse.Merge(m, src)
+}
+func (m *ConfirmEmailVerificationResponse) XXX_Size() int {
+	return xxx_messageInfo_ConfirmEmailVerificationResponse.Size(m)
+}
+func (m *ConfirmEmailVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmEmailVerificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmEmailVerificationResponse proto.InternalMessageInfo
+
+func (m *ConfirmEmailVerificationResponse) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ConfirmEmailVerificationResponse) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type SendPhoneCodeRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendPhoneCodeRequest) Reset()         { *m = SendPhoneCodeRequest{} }
+func (m *SendPhoneCodeRequest) String() string { return proto.CompactTextString(m) }
+func (*SendPhoneCodeRequest) ProtoMessage()    {}
+func (*SendPhoneCodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{71}
+}
+
+func (m *SendPhoneCodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendPhoneCodeRequest.Unmarshal(m, b)
+}
+func (m *SendPhoneCodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendPhoneCodeRequest.Marshal(b, m, deterministic)
+}
+func (m *SendPhoneCodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendPhoneCodeRequest.Merge(m, src)
+}
+func (m *SendPhoneCodeRequest) XXX_Size() int {
+	return xxx_messageInfo_SendPhoneCodeRequest.Size(m)
+}
+func (m *SendPhoneCodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendPhoneCodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendPhoneCodeRequest proto.InternalMessageInfo
+
+func (m *SendPhoneCodeRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type SendPhoneCodeResponse struct {
+	UserId               string               `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PhoneNumber          string               `protobuf:"bytes,2,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	ExpireTime           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SendPhoneCodeResponse) Reset()         { *m = SendPhoneCodeResponse{} }
+func (m *SendPhoneCodeResponse) String() string { return proto.CompactTextString(m) }
+func (*SendPhoneCodeResponse) ProtoMessage()    {}
+func (*SendPhoneCodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{72}
+}
+
+func (m *SendPhoneCodeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendPhoneCodeResponse.Unmarshal(m, b)
+}
+func (m *SendPhoneCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendPhoneCodeResponse.Marshal(b, m, deterministic)
+}
+func (m *SendPhoneCodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendPhoneCodeResponse.Merge(m, src)
+}
+func (m *SendPhoneCodeResponse) XXX_Size() int {
+	return xxx_messageInfo_SendPhoneCodeResponse.Size(m)
+}
+func (m *SendPhoneCodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendPhoneCodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendPhoneCodeResponse proto.InternalMessageInfo
+
+func (m *SendPhoneCodeResponse) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *SendPhoneCodeResponse) GetPhoneNumber() string {
+	if m != nil {
+		return m.PhoneNumber
+	}
+	return ""
+}
+
+func (m *SendPhoneCodeResponse) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+type VerifyPhoneCodeRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyPhoneCodeRequest) Reset()         { *m = VerifyPhoneCodeRequest{} }
+func (m *VerifyPhoneCodeRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyPhoneCodeRequest) ProtoMessage()    {}
+func (*VerifyPhoneCodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{73}
+}
+
+func (m *VerifyPhoneCodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyPhoneCodeRequest.Unmarshal(m, b)
+}
+func (m *VerifyPhoneCodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyPhoneCodeRequest.Marshal(b, m, deterministic)
+}
+func (m *VerifyPhoneCodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyPhoneCodeRequest.Merge(m, src)
+}
+func (m *VerifyPhoneCodeRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyPhoneCodeRequest.Size(m)
+}
+func (m *VerifyPhoneCodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyPhoneCodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyPhoneCodeRequest proto.InternalMessageInfo
+
+func (m *VerifyPhoneCodeRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *VerifyPhoneCodeRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type VerifyPhoneCodeResponse struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PhoneNumber          string   `protobuf:"bytes,2,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyPhoneCodeResponse) Reset()         { *m = VerifyPhoneCodeResponse{} }
+func (m *VerifyPhoneCodeResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyPhoneCodeResponse) ProtoMessage()    {}
+func (*VerifyPhoneCodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{74}
+}
+
+func (m *VerifyPhoneCodeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyPhoneCodeResponse.Unmarshal(m, b)
+}
+func (m *VerifyPhoneCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyPhoneCodeResponse.Marshal(b, m, deterministic)
+}
+func (m *VerifyPhoneCodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyPhoneCodeResponse.Merge(m, src)
+}
+func (m *VerifyPhoneCodeResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyPhoneCodeResponse.Size(m)
+}
+func (m *VerifyPhoneCodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyPhoneCodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyPhoneCodeResponse proto.InternalMessageInfo
+
+func (m *VerifyPhoneCodeResponse) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *VerifyPhoneCodeResponse) GetPhoneNumber() string {
+	if m != nil {
+		return m.PhoneNumber
+	}
+	return ""
+}
+
+type Invite struct {
+	InviteId             string               `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	UserId               string               `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email                string               `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	GroupId              []string             `protobuf:"bytes,4,rep,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Status               string               `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	ExpireTime           *timestamp.Timestamp `protobuf:"bytes,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	StatusTime           *timestamp.Timestamp `protobuf:"bytes,8,opt,name=status_time,json=statusTime,proto3" json:"status_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Invite) Reset()         { *m = Invite{} }
+func (m *Invite) String() string { return proto.CompactTextString(m) }
+func (*Invite) ProtoMessage()    {}
+func (*Invite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{75}
+}
+
+func (m *Invite) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Invite.Unmarshal(m, b)
+}
+func (m *Invite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Invite.Marshal(b, m, deterministic)
+}
+func (m *Invite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Invite.Merge(m, src)
+}
+func (m *Invite) XXX_Size() int {
+	return xxx_messageInfo_Invite.Size(m)
+}
+func (m *Invite) XXX_DiscardUnknown() {
+	xxx_messageInfo_Invite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Invite proto.InternalMessageInfo
+
+func (m *Invite) GetInviteId() string {
+	if m != nil {
+		return m.InviteId
+	}
+	return ""
+}
+
+func (m *Invite) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *Invite) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *Invite) GetGroupId() []string {
+	if m != nil {
+		return m.GroupId
+	}
+	return nil
+}
+
+func (m *Invite) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Invite) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *Invite) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *Invite) GetStatusTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StatusTime
+	}
+	return nil
+}
+
+type InviteUserRequest struct {
+	Email                string             `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	GroupId              []string           `protobuf:"bytes,2,rep,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Expiry               *duration.Duration `protobuf:"bytes,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Description          string             `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Locale               string             `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
+	Extra                map[string]string  `protobuf:"bytes,6,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *InviteUserRequest) Reset()         { *m = InviteUserRequest{} }
+func (m *InviteUserRequest) String() string { return proto.CompactTextString(m) }
+func (*InviteUserRequest) ProtoMessage()    {}
+func (*InviteUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{76}
+}
+
+func (m *InviteUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InviteUserRequest.Unmarshal(m, b)
+}
+func (m *InviteUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InviteUserRequest.Marshal(b, m, deterministic)
+}
+func (m *InviteUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InviteUserRequest.Merge(m, src)
+}
+func (m *InviteUserRequest) XXX_Size() int {
+	return xxx_messageInfo_InviteUserRequest.Size(m)
+}
+func (m *InviteUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InviteUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InviteUserRequest proto.InternalMessageInfo
+
+func (m *InviteUserRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *InviteUserRequest) GetGroupId() []string {
+	if m != nil {
+		return m.GroupId
+	}
+	return nil
+}
+
+func (m *InviteUserRequest) GetExpiry() *duration.Duration {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+func (m *InviteUserRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *InviteUserRequest) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+func (m *InviteUserRequest) GetExtra() map[string]string {
+	if m != nil {
+		return m.Extra
+	}
+	return nil
+}
+
+type InviteUserResponse struct {
+	InviteId             string               `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	UserId               string               `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpireTime           *timestamp.Timestamp `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *InviteUserResponse) Reset()         { *m = InviteUserResponse{} }
+func (m *InviteUserResponse) String() string { return proto.CompactTextString(m) }
+func (*InviteUserResponse) ProtoMessage()    {}
+func (*InviteUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{77}
+}
+
+func (m *InviteUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InviteUserResponse.Unmarshal(m, b)
+}
+func (m *InviteUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InviteUserResponse.Marshal(b, m, deterministic)
+}
+func (m *InviteUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InviteUserResponse.Merge(m, src)
+}
+func (m *InviteUserResponse) XXX_Size() int {
+	return xxx_messageInfo_InviteUserResponse.Size(m)
+}
+func (m *InviteUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InviteUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InviteUserResponse proto.InternalMessageInfo
+
+func (m *InviteUserResponse) GetInviteId() string {
+	if m != nil {
+		return m.InviteId
+	}
+	return ""
+}
+
+func (m *InviteUserResponse) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *InviteUserResponse) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+type AcceptInviteRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password             string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcceptInviteRequest) Reset()         { *m = AcceptInviteRequest{} }
+func (m *AcceptInviteRequest) String() string { return proto.CompactTextString(m) }
+func (*AcceptInviteRequest) ProtoMessage()    {}
+func (*AcceptInviteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{78}
+}
+
+func (m *AcceptInviteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptInviteRequest.Unmarshal(m, b)
+}
+func (m *AcceptInviteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptInviteRequest.Marshal(b, m, deterministic)
+}
+func (m *AcceptInviteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptInviteRequest.Merge(m, src)
+}
+func (m *AcceptInviteRequest) XXX_Size() int {
+	return xxx_messageInfo_AcceptInviteRequest.Size(m)
+}
+func (m *AcceptInviteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptInviteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptInviteRequest proto.InternalMessageInfo
+
+func (m *AcceptInviteRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *AcceptInviteRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *AcceptInviteRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type AcceptInviteResponse struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcceptInviteResponse) Reset()         { *m = AcceptInviteResponse{} }
+func (m *AcceptInviteResponse) String() string { return proto.CompactTextString(m) }
+func (*AcceptInviteResponse) ProtoMessage()    {}
+func (*AcceptInviteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{79}
+}
+
+func (m *AcceptInviteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptInviteResponse.Unmarshal(m, b)
+}
+func (m *AcceptInviteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptInviteResponse.Marshal(b, m, deterministic)
+}
+func (m *AcceptInviteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptInviteResponse.Merge(m, src)
+}
+func (m *AcceptInviteResponse) XXX_Size() int {
+	return xxx_messageInfo_AcceptInviteResponse.Size(m)
+}
+func (m *AcceptInviteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptInviteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptInviteResponse proto.InternalMessageInfo
+
+func (m *AcceptInviteResponse) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type ListInvitesRequest struct {
+	SortKey              string   `protobuf:"bytes,1,opt,name=sort_key,json=sortKey,proto3" json:"sort_key,omitempty"`
+	Reverse              bool     `protobuf:"varint,2,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Offset               uint32   `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint32   `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	InviteId             []string `protobuf:"bytes,5,rep,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	UserId               []string `protobuf:"bytes,6,rep,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email                []string `protobuf:"bytes,7,rep,name=email,proto3" json:"email,omitempty"`
+	Status               []string `protobuf:"bytes,8,rep,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListInvitesRequest) Reset()         { *m = ListInvitesRequest{} }
+func (m *ListInvitesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListInvitesRequest) ProtoMessage()    {}
+func (*ListInvitesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{80}
+}
+
+func (m *ListInvitesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInvitesRequest.Unmarshal(m, b)
+}
+func (m *ListInvitesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInvitesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListInvitesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInvitesRequest.Merge(m, src)
+}
+func (m *ListInvitesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListInvitesRequest.Size(m)
+}
+func (m *ListInvitesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInvitesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListInvitesRequest proto.InternalMessageInfo
+
+func (m *ListInvitesRequest) GetSortKey() string {
+	if m != nil {
+		return m.SortKey
+	}
+	return ""
+}
+
+func (m *ListInvitesRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+func (m *ListInvitesRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListInvitesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListInvitesRequest) GetInviteId() []string {
+	if m != nil {
+		return m.InviteId
+	}
+	return nil
+}
+
+func (m *ListInvitesRequest) GetUserId() []string {
+	if m != nil {
+		return m.UserId
+	}
+	return nil
+}
+
+func (m *ListInvitesRequest) GetEmail() []string {
+	if m != nil {
+		return m.Email
+	}
+	return nil
+}
+
+func (m *ListInvitesRequest) GetStatus() []string {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type ListInvitesResponse struct {
+	Total                uint32    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	InviteSet            []*Invite `protobuf:"bytes,2,rep,name=invite_set,json=inviteSet,proto3" json:"invite_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ListInvitesResponse) Reset()         { *m = ListInvitesResponse{} }
+func (m *ListInvitesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListInvitesResponse) ProtoMessage()    {}
+func (*ListInvitesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{81}
+}
+
+func (m *ListInvitesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInvitesResponse.Unmarshal(m, b)
+}
+func (m *ListInvitesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInvitesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListInvitesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInvitesResponse.Merge(m, src)
+}
+func (m *ListInvitesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListInvitesResponse.Size(m)
+}
+func (m *ListInvitesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInvitesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListInvitesResponse proto.InternalMessageInfo
+
+func (m *ListInvitesResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ListInvitesResponse) GetInviteSet() []*Invite {
+	if m != nil {
+		return m.InviteSet
+	}
+	return nil
+}
+
+type RevokeInviteRequest struct {
+	InviteId             string   `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeInviteRequest) Reset()         { *m = RevokeInviteRequest{} }
+func (m *RevokeInviteRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeInviteRequest) ProtoMessage()    {}
+func (*RevokeInviteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{82}
+}
+
+func (m *RevokeInviteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeInviteRequest.Unmarshal(m, b)
+}
+func (m *RevokeInviteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeInviteRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeInviteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeInviteRequest.Merge(m, src)
+}
+func (m *RevokeInviteRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeInviteRequest.Size(m)
+}
+func (m *RevokeInviteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeInviteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeInviteRequest proto.InternalMessageInfo
+
+func (m *RevokeInviteRequest) GetInviteId() string {
+	if m != nil {
+		return m.InviteId
+	}
+	return ""
+}
+
+type RevokeInviteResponse struct {
+	InviteId             string   `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeInviteResponse) Reset()         { *m = RevokeInviteResponse{} }
+func (m *RevokeInviteResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeInviteResponse) ProtoMessage()    {}
+func (*RevokeInviteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{83}
+}
+
+func (m *RevokeInviteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeInviteResponse.Unmarshal(m, b)
+}
+func (m *RevokeInviteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeInviteResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeInviteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeInviteResponse.Merge(m, src)
+}
+func (m *RevokeInviteResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeInviteResponse.Size(m)
+}
+func (m *RevokeInviteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeInviteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeInviteResponse proto.InternalMessageInfo
+
+func (m *RevokeInviteResponse) GetInviteId() string {
+	if m != nil {
+		return m.InviteId
+	}
+	return ""
+}
+
+type UploadAvatarRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content              []byte   `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UploadAvatarRequest) Reset()         { *m = UploadAvatarRequest{} }
+func (m *UploadAvatarRequest) String() string { return proto.CompactTextString(m) }
+func (*UploadAvatarRequest) ProtoMessage()    {}
+func (*UploadAvatarRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{84}
+}
+
+func (m *UploadAvatarRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadAvatarRequest.Unmarshal(m, b)
+}
+func (m *UploadAvatarRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadAvatarRequest.Marshal(b, m, deterministic)
+}
+func (m *UploadAvatarRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadAvatarRequest.Merge(m, src)
+}
+func (m *UploadAvatarRequest) XXX_Size() int {
+	return xxx_messageInfo_UploadAvatarRequest.Size(m)
+}
+func (m *UploadAvatarRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadAvatarRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadAvatarRequest proto.InternalMessageInfo
+
+func (m *UploadAvatarRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *UploadAvatarRequest) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+type UploadAvatarResponse struct {
+	UserId               string               `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContentType          string               `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	UpdateTime           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UploadAvatarResponse) Reset()         { *m = UploadAvatarResponse{} }
+func (m *UploadAvatarResponse) String() string { return proto.CompactTextString(m) }
+func (*UploadAvatarResponse) ProtoMessage()    {}
+func (*UploadAvatarResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{85}
+}
+
+func (m *UploadAvatarResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadAvatarResponse.Unmarshal(m, b)
+}
+func (m *UploadAvatarResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadAvatarResponse.Marshal(b, m, deterministic)
+}
+func (m *UploadAvatarResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadAvatarResponse.Merge(m, src)
+}
+func (m *UploadAvatarResponse) XXX_Size() int {
+	return xxx_messageInfo_UploadAvatarResponse.Size(m)
+}
+func (m *UploadAvatarResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadAvatarResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadAvatarResponse proto.InternalMessageInfo
+
+func (m *UploadAvatarResponse) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *UploadAvatarResponse) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *UploadAvatarResponse) GetUpdateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return nil
+}
+
+type GetAvatarRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAvatarRequest) Reset()         { *m = GetAvatarRequest{} }
+func (m *GetAvatarRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAvatarRequest) ProtoMessage()    {}
+func (*GetAvatarRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{86}
+}
+
+func (m *GetAvatarRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAvatarRequest.Unmarshal(m, b)
+}
+func (m *GetAvatarRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAvatarRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAvatarRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAvatarRequest.Merge(m, src)
+}
+func (m *GetAvatarRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAvatarRequest.Size(m)
+}
+func (m *GetAvatarRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAvatarRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAvatarRequest proto.InternalMessageInfo
+
+func (m *GetAvatarRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type GetAvatarResponse struct {
+	UserId               string               `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content              []byte               `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType          string               `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	UpdateTime           *timestamp.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetAvatarResponse) Reset()         { *m = GetAvatarResponse{} }
+func (m *GetAvatarResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAvatarResponse) ProtoMessage()    {}
+func (*GetAvatarResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{87}
+}
+
+func (m *GetAvatarResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAvatarResponse.Unmarshal(m, b)
+}
+func (m *GetAvatarResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAvatarResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAvatarResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAvatarResponse.Merge(m, src)
+}
+func (m *GetAvatarResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAvatarResponse.Size(m)
+}
+func (m *GetAvatarResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAvatarResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAvatarResponse proto.InternalMessageInfo
+
+func (m *GetAvatarResponse) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *GetAvatarResponse) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *GetAvatarResponse) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *GetAvatarResponse) GetUpdateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return nil
+}
+
+type Attribute struct {
+	AttributeId          string               `protobuf:"bytes,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	Target               string               `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Name                 string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 string               `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Required             bool                 `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	Unique               bool                 `protobuf:"varint,6,opt,name=unique,proto3" json:"unique,omitempty"`
+	EnumValue            []string             `protobuf:"bytes,7,rep,name=enum_value,json=enumValue,proto3" json:"enum_value,omitempty"`
+	Description          string               `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Attribute) Reset()         { *m = Attribute{} }
+func (m *Attribute) String() string { return proto.CompactTextString(m) }
+func (*Attribute) ProtoMessage()    {}
+func (*Attribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{88}
+}
+
+func (m *Attribute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Attribute.Unmarshal(m, b)
+}
+func (m *Attribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Attribute.Marshal(b, m, deterministic)
+}
+func (m *Attribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attribute.Merge(m, src)
+}
+func (m *Attribute) XXX_Size() int {
+	return xxx_messageInfo_Attribute.Size(m)
+}
+func (m *Attribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attribute proto.InternalMessageInfo
+
+func (m *Attribute) GetAttributeId() string {
+	if m != nil {
+		return m.AttributeId
+	}
+	return ""
+}
+
+func (m *Attribute) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *Attribute) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Attribute) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Attribute) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
+func (m *Attribute) GetUnique() bool {
+	if m != nil {
+		return m.Unique
+	}
+	return false
+}
+
+func (m *Attribute) GetEnumValue() []string {
+	if m != nil {
+		return m.EnumValue
+	}
+	return nil
+}
+
+func (m *Attribute) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Attribute) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+type AttributeFilter struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Op                   string   `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttributeFilter) Reset()         { *m = AttributeFilter{} }
+func (m *AttributeFilter) String() string { return proto.CompactTextString(m) }
+func (*AttributeFilter) ProtoMessage()    {}
+func (*AttributeFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{89}
+}
+
+func (m *AttributeFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttributeFilter.Unmarshal(m, b)
+}
+func (m *AttributeFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttributeFilter.Marshal(b, m, deterministic)
+}
+func (m *AttributeFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeFilter.Merge(m, src)
+}
+func (m *AttributeFilter) XXX_Size() int {
+	return xxx_messageInfo_AttributeFilter.Size(m)
+}
+func (m *AttributeFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeFilter proto.InternalMessageInfo
+
+func (m *AttributeFilter) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AttributeFilter) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *AttributeFilter) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type CreateAttributeRequest struct {
+	Target               string   `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Required             bool     `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	Unique               bool     `protobuf:"varint,5,opt,name=unique,proto3" json:"unique,omitempty"`
+	EnumValue            []string `protobuf:"bytes,6,rep,name=enum_value,json=enumValue,proto3" json:"enum_value,omitempty"`
+	Description          string   `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAttributeRequest) Reset()         { *m = CreateAttributeRequest{} }
+func (m *CreateAttributeRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAttributeRequest) ProtoMessage()    {}
+func (*CreateAttributeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{90}
+}
+
+func (m *CreateAttributeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAttributeRequest.Unmarshal(m, b)
+}
+func (m *CreateAttributeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAttributeRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateAttributeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAttributeRequest.Merge(m, src)
+}
+func (m *CreateAttributeRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAttributeRequest.Size(m)
+}
+func (m *CreateAttributeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAttributeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAttributeRequest proto.InternalMessageInfo
+
+func (m *CreateAttributeRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *CreateAttributeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateAttributeRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *CreateAttributeRequest) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
+func (m *CreateAttributeRequest) GetUnique() bool {
+	if m != nil {
+		return m.Unique
+	}
+	return false
+}
+
+func (m *CreateAttributeRequest) GetEnumValue() []string {
+	if m != nil {
+		return m.EnumValue
+	}
+	return nil
+}
+
+func (m *CreateAttributeRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type CreateAttributeResponse struct {
+	AttributeId          string   `protobuf:"bytes,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAttributeResponse) Reset()         { *m = CreateAttributeResponse{} }
+func (m *CreateAttributeResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAttributeResponse) ProtoMessage()    {}
+func (*CreateAttributeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{91}
+}
+
+func (m *CreateAttributeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAttributeResponse.Unmarshal(m, b)
+}
+func (m *CreateAttributeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAttributeResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateAttributeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAttributeResponse.Merge(m, src)
+}
+func (m *CreateAttributeResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateAttributeResponse.Size(m)
+}
+func (m *CreateAttributeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAttributeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAttributeResponse proto.InternalMessageInfo
+
+func (m *CreateAttributeResponse) GetAttributeId() string {
+	if m != nil {
+		return m.AttributeId
+	}
+	return ""
+}
+
+type ListAttributesRequest struct {
+	SortKey              string   `protobuf:"bytes,1,opt,name=sort_key,json=sortKey,proto3" json:"sort_key,omitempty"`
+	Reverse              bool     `protobuf:"varint,2,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Offset               uint32   `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint32   `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	AttributeId          []string `protobuf:"bytes,5,rep,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	Target               []string `protobuf:"bytes,6,rep,name=target,proto3" json:"target,omitempty"`
+	Name                 []string `protobuf:"bytes,7,rep,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAttributesRequest) Reset()         { *m = ListAttributesRequest{} }
+func (m *ListAttributesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAttributesRequest) ProtoMessage()    {}
+func (*ListAttributesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{92}
+}
+
+func (m *ListAttributesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAttributesRequest.Unmarshal(m, b)
+}
+func (m *ListAttributesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAttributesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAttributesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAttributesRequest.Merge(m, src)
+}
+func (m *ListAttributesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAttributesRequest.Size(m)
+}
+func (m *ListAttributesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAttributesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAttributesRequest proto.InternalMessageInfo
+
+func (m *ListAttributesRequest) GetSortKey() string {
+	if m != nil {
+		return m.SortKey
+	}
+	return ""
+}
+
+func (m *ListAttributesRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+func (m *ListAttributesRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListAttributesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListAttributesRequest) GetAttributeId() []string {
+	if m != nil {
+		return m.AttributeId
+	}
+	return nil
+}
+
+func (m *ListAttributesRequest) GetTarget() []string {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *ListAttributesRequest) GetName() []string {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+type ListAttributesResponse struct {
+	Total                uint32       `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	AttributeSet         []*Attribute `protobuf:"bytes,2,rep,name=attribute_set,json=attributeSet,proto3" json:"attribute_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListAttributesResponse) Reset()         { *m = ListAttributesResponse{} }
+func (m *ListAttributesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAttributesResponse) ProtoMessage()    {}
+func (*ListAttributesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{93}
+}
+
+func (m *ListAttributesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAttributesResponse.Unmarshal(m, b)
+}
+func (m *ListAttributesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAttributesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAttributesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAttributesResponse.Merge(m, src)
+}
+func (m *ListAttributesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAttributesResponse.Size(m)
+}
+func (m *ListAttributesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAttributesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAttributesResponse proto.InternalMessageInfo
+
+func (m *ListAttributesResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ListAttributesResponse) GetAttributeSet() []*Attribute {
+	if m != nil {
+		return m.AttributeSet
+	}
+	return nil
+}
+
+type DeleteAttributesRequest struct {
+	AttributeId          []string `protobuf:"bytes,1,rep,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAttributesRequest) Reset()         { *m = DeleteAttributesRequest{} }
+func (m *DeleteAttributesRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAttributesRequest) ProtoMessage()    {}
+func (*DeleteAttributesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{94}
+}
+
+func (m *DeleteAttributesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAttributesRequest.Unmarshal(m, b)
+}
+func (m *DeleteAttributesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAttributesRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteAttributesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAttributesRequest.Merge(m, src)
+}
+func (m *DeleteAttributesRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAttributesRequest.Size(m)
+}
+func (m *DeleteAttributesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAttributesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAttributesRequest proto.InternalMessageInfo
+
+func (m *DeleteAttributesRequest) GetAttributeId() []string {
+	if m != nil {
+		return m.AttributeId
+	}
+	return nil
+}
+
+type DeleteAttributesResponse struct {
+	AttributeId          []string `protobuf:"bytes,1,rep,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAttributesResponse) Reset()         { *m = DeleteAttributesResponse{} }
+func (m *DeleteAttributesResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteAttributesResponse) ProtoMessage()    {}
+func (*DeleteAttributesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{95}
+}
+
+func (m *DeleteAttributesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAttributesResponse.Unmarshal(m, b)
+}
+func (m *DeleteAttributesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAttributesResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteAttributesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAttributesResponse.Merge(m, src)
+}
+func (m *DeleteAttributesResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteAttributesResponse.Size(m)
+}
+func (m *DeleteAttributesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAttributesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAttributesResponse proto.InternalMessageInfo
+
+func (m *DeleteAttributesResponse) GetAttributeId() []string {
+	if m != nil {
+		return m.AttributeId
+	}
+	return nil
+}
+
+type BatchError struct {
+	Code                 string            `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Reason               string            `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message              string            `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BatchError) Reset()         { *m = BatchError{} }
+func (m *BatchError) String() string { return proto.CompactTextString(m) }
+func (*BatchError) ProtoMessage()    {}
+func (*BatchError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{96}
+}
+
+func (m *BatchError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchError.Unmarshal(m, b)
+}
+func (m *BatchError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchError.Marshal(b, m, deterministic)
+}
+func (m *BatchError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchError.Merge(m, src)
+}
+func (m *BatchError) XXX_Size() int {
+	return xxx_messageInfo_BatchError.Size(m)
+}
+func (m *BatchError) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchError proto.InternalMessageInfo
+
+func (m *BatchError) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *BatchError) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *BatchError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *BatchError) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type BatchResult struct {
+	Index                uint32      `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Id                   string      `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Error                *BatchError `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *BatchResult) Reset()         { *m = BatchResult{} }
+func (m *BatchResult) String() string { return proto.CompactTextString(m) }
+func (*BatchResult) ProtoMessage()    {}
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{97}
+}
+
+func (m *BatchResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchResult.Unmarshal(m, b)
+}
+func (m *BatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchResult.Marshal(b, m, deterministic)
+}
+func (m *BatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchResult.Merge(m, src)
+}
+func (m *BatchResult) XXX_Size() int {
+	return xxx_messageInfo_BatchResult.Size(m)
+}
+func (m *BatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchResult proto.InternalMessageInfo
+
+func (m *BatchResult) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *BatchResult) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *BatchResult) GetError() *BatchError {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+// items are checked as a whole by the field rules before the batch starts,
+// atomic batches write all items or none, otherwise the valid items are written
+type BatchCreateUsersRequest struct {
+	User                 []*CreateUserRequest `protobuf:"bytes,1,rep,name=user,proto3" json:"user,omitempty"`
+	Atomic               bool                 `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BatchCreateUsersRequest) Reset()         { *m = BatchCreateUsersRequest{} }
+func (m *BatchCreateUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchCreateUsersRequest) ProtoMessage()    {}
+func (*BatchCreateUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{98}
+}
+
+func (m *BatchCreateUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchCreateUsersRequest.Unmarshal(m, b)
+}
+func (m *BatchCreateUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchCreateUsersRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchCreateUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCreateUsersRequest.Merge(m, src)
+}
+func (m *BatchCreateUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchCreateUsersRequest.Size(m)
+}
+func (m *BatchCreateUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCreateUsersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchCreateUsersRequest proto.InternalMessageInfo
+
+func (m *BatchCreateUsersRequest) GetUser() []*CreateUserRequest {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *BatchCreateUsersRequest) GetAtomic() bool {
+	if m != nil {
+		return m.Atomic
+	}
+	return false
+}
+
+type BatchCreateUsersResponse struct {
+	ResultSet            []*BatchResult `protobuf:"bytes,1,rep,name=result_set,json=resultSet,proto3" json:"result_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BatchCreateUsersResponse) Reset()         { *m = BatchCreateUsersResponse{} }
+func (m *BatchCreateUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchCreateUsersResponse) ProtoMessage()    {}
+func (*BatchCreateUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{99}
+}
+
+func (m *BatchCreateUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchCreateUsersResponse.Unmarshal(m, b)
+}
+func (m *BatchCreateUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchCreateUsersResponse.Marshal(b, m, deterministic)
+}
+func (m *BatchCreateUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCreateUsersResponse.Merge(m, src)
+}
+func (m *BatchCreateUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchCreateUsersResponse.Size(m)
+}
+func (m *BatchCreateUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCreateUsersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchCreateUsersResponse proto.InternalMessageInfo
+
+func (m *BatchCreateUsersResponse) GetResultSet() []*BatchResult {
+	if m != nil {
+		return m.ResultSet
+	}
+	return nil
+}
+
+type BatchModifyUsersRequest struct {
+	User                 []*ModifyUserRequest `protobuf:"bytes,1,rep,name=user,proto3" json:"user,omitempty"`
+	Atomic               bool                 `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BatchModifyUsersRequest) Reset()         { *m = BatchModifyUsersRequest{} }
+func (m *BatchModifyUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchModifyUsersRequest) ProtoMessage()    {}
+func (*BatchModifyUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{100}
+}
+
+func (m *BatchModifyUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchModifyUsersRequest.Unmarshal(m, b)
+}
+func (m *BatchModifyUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchModifyUsersRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchModifyUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchModifyUsersRequest.Merge(m, src)
+}
+func (m *BatchModifyUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchModifyUsersRequest.Size(m)
+}
+func (m *BatchModifyUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchModifyUsersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchModifyUsersRequest proto.InternalMessageInfo
+
+func (m *BatchModifyUsersRequest) GetUser() []*ModifyUserRequest {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *BatchModifyUsersRequest) GetAtomic() bool {
+	if m != nil {
+		return m.Atomic
+	}
+	return false
+}
+
+type BatchModifyUsersResponse struct {
+	ResultSet            []*BatchResult `protobuf:"bytes,1,rep,name=result_set,json=resultSet,proto3" json:"result_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BatchModifyUsersResponse) Reset()         { *m = BatchModifyUsersResponse{} }
+func (m *BatchModifyUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchModifyUsersResponse) ProtoMessage()    {}
+func (*BatchModifyUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{101}
+}
+
+func (m *BatchModifyUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchModifyUsersResponse.Unmarshal(m, b)
+}
+func (m *BatchModifyUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchModifyUsersResponse.Marshal(b, m, deterministic)
+}
+func (m *BatchModifyUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchModifyUsersResponse.Merge(m, src)
+}
+func (m *BatchModifyUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchModifyUsersResponse.Size(m)
+}
+func (m *BatchModifyUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchModifyUsersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchModifyUsersResponse proto.InternalMessageInfo
+
+func (m *BatchModifyUsersResponse) GetResultSet() []*BatchResult {
+	if m != nil {
+		return m.ResultSet
+	}
+	return nil
+}
+
+type BatchCreateGroupsRequest struct {
+	Group                []*CreateGroupRequest `protobuf:"bytes,1,rep,name=group,proto3" json:"group,omitempty"`
+	Atomic               bool                  `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *BatchCreateGroupsRequest) Reset()         { *m = BatchCreateGroupsRequest{} }
+func (m *BatchCreateGroupsRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchCreateGroupsRequest) ProtoMessage()    {}
+func (*BatchCreateGroupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{102}
+}
+
+func (m *BatchCreateGroupsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchCreateGroupsRequest.Unmarshal(m, b)
+}
+func (m *BatchCreateGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchCreateGroupsRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchCreateGroupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCreateGroupsRequest.Merge(m, src)
+}
+func (m *BatchCreateGroupsRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchCreateGroupsRequest.Size(m)
+}
+func (m *BatchCreateGroupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCreateGroupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchCreateGroupsRequest proto.InternalMessageInfo
+
+func (m *BatchCreateGroupsRequest) GetGroup() []*CreateGroupRequest {
+	if m != nil {
+		return m.Group
+	}
+	return nil
+}
+
+func (m *BatchCreateGroupsRequest) GetAtomic() bool {
+	if m != nil {
+		return m.Atomic
+	}
+	return false
+}
+
+type BatchCreateGroupsResponse struct {
+	ResultSet            []*BatchResult `protobuf:"bytes,1,rep,name=result_set,json=resultSet,proto3" json:"result_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BatchCreateGroupsResponse) Reset()         { *m = BatchCreateGroupsResponse{} }
+func (m *BatchCreateGroupsResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchCreateGroupsResponse) ProtoMessage()    {}
+func (*BatchCreateGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{103}
+}
+
+func (m *BatchCreateGroupsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchCreateGroupsResponse.Unmarshal(m, b)
+}
+func (m *BatchCreateGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchCreateGroupsResponse.Marshal(b, m, deterministic)
+}
+func (m *BatchCreateGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCreateGroupsResponse.Merge(m, src)
+}
+func (m *BatchCreateGroupsResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchCreateGroupsResponse.Size(m)
+}
+func (m *BatchCreateGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCreateGroupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchCreateGroupsResponse proto.InternalMessageInfo
+
+func (m *BatchCreateGroupsResponse) GetResultSet() []*BatchResult {
+	if m != nil {
+		return m.ResultSet
+	}
+	return nil
+}
+
+type MergeUsersRequest struct {
+	SourceUserId string `protobuf:"bytes,1,opt,name=source_user_id,json=sourceUserId,proto3" json:"source_user_id,omitempty"`
+	TargetUserId string `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	// how to merge an extra key set on both users: keep_target (default), keep_source or fail
+	ConflictStrategy     string   `protobuf:"bytes,3,opt,name=conflict_strategy,json=conflictStrategy,proto3" json:"conflict_strategy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MergeUsersRequest) Reset()         { *m = MergeUsersRequest{} }
+func (m *MergeUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*MergeUsersRequest) ProtoMessage()    {}
+func (*MergeUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{104}
+}
+
+func (m *MergeUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MergeUsersRequest.Unmarshal(m, b)
+}
+func (m *MergeUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MergeUsersRequest.Marshal(b, m, deterministic)
+}
+func (m *MergeUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeUsersRequest.Merge(m, src)
+}
+func (m *MergeUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_MergeUsersRequest.Size(m)
+}
+func (m *MergeUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeUsersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeUsersRequest proto.InternalMessageInfo
+
+func (m *MergeUsersRequest) GetSourceUserId() string {
+	if m != nil {
+		return m.SourceUserId
+	}
+	return ""
+}
+
+func (m *MergeUsersRequest) GetTargetUserId() string {
+	if m != nil {
+		return m.TargetUserId
+	}
+	return ""
+}
+
+func (m *MergeUsersRequest) GetConflictStrategy() string {
+	if m != nil {
+		return m.ConflictStrategy
+	}
+	return ""
+}
+
+type MergeUsersResponse struct {
+	SourceUserId         string   `protobuf:"bytes,1,opt,name=source_user_id,json=sourceUserId,proto3" json:"source_user_id,omitempty"`
+	TargetUserId         string   `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	GroupId              []string `protobuf:"bytes,3,rep,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MergeUsersResponse) Reset()         { *m = MergeUsersResponse{} }
+func (m *MergeUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*MergeUsersResponse) ProtoMessage()    {}
+func (*MergeUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{105}
+}
+
+func (m *MergeUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MergeUsersResponse.Unmarshal(m, b)
+}
+func (m *MergeUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MergeUsersResponse.Marshal(b, m, deterministic)
+}
+func (m *MergeUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeUsersResponse.Merge(m, src)
+}
+func (m *MergeUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_MergeUsersResponse.Size(m)
+}
+func (m *MergeUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeUsersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeUsersResponse proto.InternalMessageInfo
+
+func (m *MergeUsersResponse) GetSourceUserId() string {
+	if m != nil {
+		return m.SourceUserId
+	}
+	return ""
+}
+
+func (m *MergeUsersResponse) GetTargetUserId() string {
+	if m != nil {
+		return m.TargetUserId
+	}
+	return ""
+}
+
+func (m *MergeUsersResponse) GetGroupId() []string {
+	if m != nil {
+		return m.GroupId
+	}
+	return nil
+}
+
+type GroupMembership struct {
+	GroupId              string               `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupName            string               `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	GroupPath            string               `protobuf:"bytes,3,opt,name=group_path,json=groupPath,proto3" json:"group_path,omitempty"`
+	JoinTime             *timestamp.Timestamp `protobuf:"bytes,4,opt,name=join_time,json=joinTime,proto3" json:"join_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GroupMembership) Reset()         { *m = GroupMembership{} }
+func (m *GroupMembership) String() string { return proto.CompactTextString(m) }
+func (*GroupMembership) ProtoMessage()    {}
+func (*GroupMembership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{106}
+}
+
+func (m *GroupMembership) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupMembership.Unmarshal(m, b)
+}
+func (m *GroupMembership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupMembership.Marshal(b, m, deterministic)
+}
+func (m *GroupMembership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupMembership.Merge(m, src)
+}
+func (m *GroupMembership) XXX_Size() int {
+	return xxx_messageInfo_GroupMembership.Size(m)
+}
+func (m *GroupMembership) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupMembership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupMembership proto.InternalMessageInfo
+
+func (m *GroupMembership) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *GroupMembership) GetGroupName() string {
+	if m != nil {
+		return m.GroupName
+	}
+	return ""
+}
+
+func (m *GroupMembership) GetGroupPath() string {
+	if m != nil {
+		return m.GroupPath
+	}
+	return ""
+}
+
+func (m *GroupMembership) GetJoinTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.JoinTime
+	}
+	return nil
+}
+
+// Token is the metadata of a verification token, the token itself is never exported
+type Token struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind                 string               `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Target               string               `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Attempts             uint32               `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	ExpireTime           *timestamp.Timestamp `protobuf:"bytes,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	ConsumeTime          *timestamp.Timestamp `protobuf:"bytes,7,opt,name=consume_time,json=consumeTime,proto3" json:"consume_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Token) Reset()         { *m = Token{} }
+func (m *Token) String() string { return proto.CompactTextString(m) }
+func (*Token) ProtoMessage()    {}
+func (*Token) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{107}
+}
+
+func (m *Token) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Token.Unmarshal(m, b)
+}
+func (m *Token) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Token.Marshal(b, m, deterministic)
+}
+func (m *Token) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Token.Merge(m, src)
+}
+func (m *Token) XXX_Size() int {
+	return xxx_messageInfo_Token.Size(m)
+}
+func (m *Token) XXX_DiscardUnknown() {
+	xxx_messageInfo_Token.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Token proto.InternalMessageInfo
+
+func (m *Token) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Token) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *Token) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *Token) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *Token) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *Token) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *Token) GetConsumeTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ConsumeTime
+	}
+	return nil
+}
+
+type Notification struct {
+	NotificationId       string               `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	Kind                 string               `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Recipient            string               `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Subject              string               `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Status               string               `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	SendTime             *timestamp.Timestamp `protobuf:"bytes,7,opt,name=send_time,json=sendTime,proto3" json:"send_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Notification) Reset()         { *m = Notification{} }
+func (m *Notification) String() string { return proto.CompactTextString(m) }
+func (*Notification) ProtoMessage()    {}
+func (*Notification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{108}
+}
+
+func (m *Notification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Notification.Unmarshal(m, b)
+}
+func (m *Notification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Notification.Marshal(b, m, deterministic)
+}
+func (m *Notification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notification.Merge(m, src)
+}
+func (m *Notification) XXX_Size() int {
+	return xxx_messageInfo_Notification.Size(m)
+}
+func (m *Notification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notification proto.InternalMessageInfo
+
+func (m *Notification) GetNotificationId() string {
+	if m != nil {
+		return m.NotificationId
+	}
+	return ""
+}
+
+func (m *Notification) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *Notification) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Notification) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *Notification) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Notification) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *Notification) GetSendTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.SendTime
+	}
+	return nil
+}
+
+// UserData is everything held about a user, exported as json
+type UserData struct {
+	ExportTime           *timestamp.Timestamp `protobuf:"bytes,1,opt,name=export_time,json=exportTime,proto3" json:"export_time,omitempty"`
+	User                 *User                `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	GroupMembershipSet   []*GroupMembership   `protobuf:"bytes,3,rep,name=group_membership_set,json=groupMembershipSet,proto3" json:"group_membership_set,omitempty"`
+	LoginHistorySet      []*LoginHistory      `protobuf:"bytes,4,rep,name=login_history_set,json=loginHistorySet,proto3" json:"login_history_set,omitempty"`
+	TokenSet             []*Token             `protobuf:"bytes,5,rep,name=token_set,json=tokenSet,proto3" json:"token_set,omitempty"`
+	InviteSet            []*Invite            `protobuf:"bytes,6,rep,name=invite_set,json=inviteSet,proto3" json:"invite_set,omitempty"`
+	NotificationSet      []*Notification      `protobuf:"bytes,7,rep,name=notification_set,json=notificationSet,proto3" json:"notification_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UserData) Reset()         { *m = UserData{} }
+func (m *UserData) String() string { return proto.CompactTextString(m) }
+func (*UserData) ProtoMessage()    {}
+func (*UserData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{109}
+}
+
+func (m *UserData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserData.Unmarshal(m, b)
+}
+func (m *UserData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserData.Marshal(b, m, deterministic)
+}
+func (m *UserData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserData.Merge(m, src)
+}
+func (m *UserData) XXX_Size() int {
+	return xxx_messageInfo_UserData.Size(m)
+}
+func (m *UserData) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserData proto.InternalMessageInfo
+
+func (m *UserData) GetExportTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExportTime
+	}
+	return nil
+}
+
+func (m *UserData) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *UserData) GetGroupMembershipSet() []*GroupMembership {
+	if m != nil {
+		return m.GroupMembershipSet
+	}
+	return nil
+}
+
+func (m *UserData) GetLoginHistorySet() []*LoginHistory {
+	if m != nil {
+		return m.LoginHistorySet
+	}
+	return nil
+}
+
+func (m *UserData) GetTokenSet() []*Token {
+	if m != nil {
+		return m.TokenSet
+	}
+	return nil
+}
+
+func (m *UserData) GetInviteSet() []*Invite {
+	if m != nil {
+		return m.InviteSet
+	}
+	return nil
+}
+
+func (m *UserData) GetNotificationSet() []*Notification {
+	if m != nil {
+		return m.NotificationSet
+	}
+	return nil
+}
+
+type ExportUserDataRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportUserDataRequest) Reset()         { *m = ExportUserDataRequest{} }
+func (m *ExportUserDataRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportUserDataRequest) ProtoMessage()    {}
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{110}
+}
+
+func (m *ExportUserDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportUserDataRequest.Unmarshal(m, b)
+}
+func (m *ExportUserDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportUserDataRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportUserDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportUserDataRequest.Merge(m, src)
+}
+func (m *ExportUserDataRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportUserDataRequest.Size(m)
+}
+func (m *ExportUserDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportUserDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportUserDataRequest proto.InternalMessageInfo
+
+func (m *ExportUserDataRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type ExportUserDataResponse struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportUserDataResponse) Reset()         { *m = ExportUserDataResponse{} }
+func (m *ExportUserDataResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportUserDataResponse) ProtoMessage()    {}
+func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{111}
+}
+
+func (m *ExportUserDataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportUserDataResponse.Unmarshal(m, b)
+}
+func (m *ExportUserDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportUserDataResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportUserDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportUserDataResponse.Merge(m, src)
+}
+func (m *ExportUserDataResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportUserDataResponse.Size(m)
+}
+func (m *ExportUserDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportUserDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportUserDataResponse proto.InternalMessageInfo
+
+func (m *ExportUserDataResponse) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ExportUserDataResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type AnonymizeUserRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AnonymizeUserRequest) Reset()         { *m = AnonymizeUserRequest{} }
+func (m *AnonymizeUserRequest) String() string { return proto.CompactTextString(m) }
+func (*AnonymizeUserRequest) ProtoMessage()    {}
+func (*AnonymizeUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{112}
+}
+
+func (m *AnonymizeUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnonymizeUserRequest.Unmarshal(m, b)
+}
+func (m *AnonymizeUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnonymizeUserRequest.Marshal(b, m, deterministic)
+}
+func (m *AnonymizeUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnonymizeUserRequest.Merge(m, src)
+}
+func (m *AnonymizeUserRequest) XXX_Size() int {
+	return xxx_messageInfo_AnonymizeUserRequest.Size(m)
+}
+func (m *AnonymizeUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnonymizeUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnonymizeUserRequest proto.InternalMessageInfo
+
+func (m *AnonymizeUserRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type AnonymizeUserResponse struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AnonymizeUserResponse) Reset()         { *m = AnonymizeUserResponse{} }
+func (m *AnonymizeUserResponse) String() string { return proto.CompactTextString(m) }
+func (*AnonymizeUserResponse) ProtoMessage()    {}
+func (*AnonymizeUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{113}
+}
+
+func (m *AnonymizeUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnonymizeUserResponse.Unmarshal(m, b)
+}
+func (m *AnonymizeUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnonymizeUserResponse.Marshal(b, m, deterministic)
+}
+func (m *AnonymizeUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnonymizeUserResponse.Merge(m, src)
+}
+func (m *AnonymizeUserResponse) XXX_Size() int {
+	return xxx_messageInfo_AnonymizeUserResponse.Size(m)
+}
+func (m *AnonymizeUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnonymizeUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnonymizeUserResponse proto.InternalMessageInfo
+
+func (m *AnonymizeUserResponse) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type LoginHistory struct {
+	LoginId              string               `protobuf:"bytes,1,opt,name=login_id,json=loginId,proto3" json:"login_id,omitempty"`
+	UserId               string               `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Success              bool                 `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	ClientIp             string               `protobuf:"bytes,4,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	UserAgent            string               `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *LoginHistory) Reset()         { *m = LoginHistory{} }
+func (m *LoginHistory) String() string { return proto.CompactTextString(m) }
+func (*LoginHistory) ProtoMessage()    {}
+func (*LoginHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{114}
+}
+
+func (m *LoginHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoginHistory.Unmarshal(m, b)
+}
+func (m *LoginHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoginHistory.Marshal(b, m, deterministic)
+}
+func (m *LoginHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoginHistory.Merge(m, src)
+}
+func (m *LoginHistory) XXX_Size() int {
+	return xxx_messageInfo_LoginHistory.Size(m)
+}
+func (m *LoginHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoginHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoginHistory proto.InternalMessageInfo
+
+func (m *LoginHistory) GetLoginId() string {
+	if m != nil {
+		return m.LoginId
+	}
+	return ""
+}
+
+func (m *LoginHistory) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *LoginHistory) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *LoginHistory) GetClientIp() string {
+	if m != nil {
+		return m.ClientIp
+	}
+	return ""
+}
+
+func (m *LoginHistory) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
+}
+
+func (m *LoginHistory) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+type ListLoginHistoryRequest struct {
+	SortKey              string               `protobuf:"bytes,1,opt,name=sort_key,json=sortKey,proto3" json:"sort_key,omitempty"`
+	Reverse              bool                 `protobuf:"varint,2,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Offset               uint32               `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint32               `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	UserId               string               `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              *timestamp.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListLoginHistoryRequest) Reset()         { *m = ListLoginHistoryRequest{} }
+func (m *ListLoginHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ListLoginHistoryRequest) ProtoMessage()    {}
+func (*ListLoginHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{115}
+}
+
+func (m *ListLoginHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLoginHistoryRequest.Unmarshal(m, b)
+}
+func (m *ListLoginHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLoginHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *ListLoginHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLoginHistoryRequest.Merge(m, src)
+}
+func (m *ListLoginHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_ListLoginHistoryRequest.Size(m)
+}
+func (m *ListLoginHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLoginHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLoginHistoryRequest proto.InternalMessageInfo
+
+func (m *ListLoginHistoryRequest) GetSortKey() string {
+	if m != nil {
+		return m.SortKey
+	}
+	return ""
+}
+
+func (m *ListLoginHistoryRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+func (m *ListLoginHistoryRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListLoginHistoryRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListLoginHistoryRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ListLoginHistoryRequest) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *ListLoginHistoryRequest) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+type ListLoginHistoryResponse struct {
+	Total                uint32          `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	LoginHistorySet      []*LoginHistory `protobuf:"bytes,2,rep,name=login_history_set,json=loginHistorySet,proto3" json:"login_history_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListLoginHistoryResponse) Reset()         { *m = ListLoginHistoryResponse{} }
+func (m *ListLoginHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ListLoginHistoryResponse) ProtoMessage()    {}
+func (*ListLoginHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f2114a3e4ddb9e, []int{116}
+}
+
+func (m *ListLoginHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLoginHistoryResponse.Unmarshal(m, b)
+}
+func (m *ListLoginHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLoginHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *ListLoginHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLoginHistoryResponse.Merge(m, src)
+}
+func (m *ListLoginHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_ListLoginHistoryResponse.Size(m)
+}
+func (m *ListLoginHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLoginHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLoginHistoryResponse proto.InternalMessageInfo
+
+func (m *ListLoginHistoryResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ListLoginHistoryResponse) GetLoginHistorySet() []*LoginHistory {
+	if m != nil {
+		return m.LoginHistorySet
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetVersionRequest)(nil), "kubesphere.GetVersionRequest")
+	proto.RegisterType((*GetVersionResponse)(nil), "kubesphere.GetVersionResponse")
+	proto.RegisterType((*CreateGroupRequest)(nil), "kubesphere.CreateGroupRequest")
+	proto.RegisterMapType((map[string]string)(nil), "kubesphere.CreateGroupRequest.ExtraEntry")
+	proto.RegisterType((*CreateGroupResponse)(nil), "kubesphere.CreateGroupResponse")
+	proto.RegisterType((*DeleteGroupsRequest)(nil), "kubesphere.DeleteGroupsRequest")
+	proto.RegisterType((*DeleteGroupsResponse)(nil), "kubesphere.DeleteGroupsResponse")
+	proto.RegisterType((*GroupMember)(nil), "kubesphere.GroupMember")
+	proto.RegisterType((*UserGroupBinding)(nil), "kubesphere.UserGroupBinding")
+	proto.RegisterType((*ModifyGroupRequest)(nil), "kubesphere.ModifyGroupRequest")
+	proto.RegisterMapType((map[string]string)(nil), "kubesphere.ModifyGroupRequest.ExtraEntry")
+	proto.RegisterType((*ModifyGroupResponse)(nil), "kubesphere.ModifyGroupResponse")
+	proto.RegisterType((*MoveGroupRequest)(nil), "kubesphere.MoveGroupRequest")
+	proto.RegisterType((*MoveGroupResponse)(nil), "kubesphere.MoveGroupResponse")
+	proto.RegisterType((*Group)(nil), "kubesphere.Group")
+	proto.RegisterMapType((map[string]string)(nil), "kubesphere.Group.ExtraEntry")
+	proto.RegisterType((*GroupWithUser)(nil), "kubesphere.GroupWithUser")
+	proto.RegisterType((*GetGroupRequest)(nil), "kubesphere.GetGroupRequest")
+	proto.RegisterType((*GetGroupResponse)(nil), "kubesphere.GetGroupResponse")
+	proto.RegisterType((*GetGroupByNamePathRequest)(nil), "kubesphere.GetGroupByNamePathRequest")
+	proto.RegisterType((*GetGroupWithUserResponse)(nil), "kubesphere.GetGroupWithUserResponse")
+	proto.RegisterType((*GetGroupTreeRequest)(nil), "kubesphere.GetGroupTreeRequest")
+	proto.RegisterType((*GroupTreeNode)(nil), "kubesphere.GroupTreeNode")
+	proto.RegisterType((*GetGroupTreeResponse)(nil), "kubesphere.GetGroupTreeResponse")
+	proto.RegisterType((*ListGroupsRequest)(nil), "kubesphere.ListGroupsRequest")
+	proto.RegisterType((*ListGroupsResponse)(nil), "kubesphere.ListGroupsResponse")
+	proto.RegisterType((*ListGroupsWithUserResponse)(nil), "kubesphere.ListGroupsWithUserResponse")
+	proto.RegisterType((*CreateUserRequest)(nil), "kubesphere.CreateUserRequest")
+	proto.RegisterMapType((map[string]string)(nil), "kubesphere.CreateUserRequest.ExtraEntry")
+	proto.RegisterType((*CreateUserResponse)(nil), "kubesphere.CreateUserResponse")
+	proto.RegisterType((*DeleteUsersRequest)(nil), "kubesphere.DeleteUsersRequest")
+	proto.RegisterType((*DeleteUsersResponse)(nil), "kubesphere.DeleteUsersResponse")
+	proto.RegisterType((*ModifyUserRequest)(nil), "kubesphere.ModifyUserRequest")
+	proto.RegisterMapType((map[string]string)(nil), "kubesphere.ModifyUserRequest.ExtraEntry")
+	proto.RegisterType((*ModifyUserResponse)(nil), "kubesphere.ModifyUserResponse")
+	proto.RegisterType((*User)(nil), "kubesphere.User")
+	proto.RegisterMapType((map[string]string)(nil), "kubesphere.User.ExtraEntry")
+	proto.RegisterType((*UserWithGroup)(nil), "kubesphere.UserWithGroup")
+	proto.RegisterType((*GetUserRequest)(nil), "kubesphere.GetUserRequest")
+	proto.RegisterType((*GetUserResponse)(nil), "kubesphere.GetUserResponse")
+	proto.RegisterType((*GetUserWithGroupResponse)(nil), "kubesphere.GetUserWithGroupResponse")
+	proto.RegisterType((*ListUsersRequest)(nil), "kubesphere.ListUsersRequest")
+	proto.RegisterType((*ListUsersResponse)(nil), "kubesphere.ListUsersResponse")
+	proto.RegisterType((*ListUsersWithGroupResponse)(nil), "kubesphere.ListUsersWithGroupResponse")
+	proto.RegisterType((*JoinGroupRequest)(nil), "kubesphere.JoinGroupRequest")
+	proto.RegisterType((*JoinGroupResponse)(nil), "kubesphere.JoinGroupResponse")
+	proto.RegisterType((*LeaveGroupRequest)(nil), "kubesphere.LeaveGroupRequest")
+	proto.RegisterType((*LeaveGroupResponse)(nil), "kubesphere.LeaveGroupResponse")
+	proto.RegisterType((*AddGroupMembersRequest)(nil), "kubesphere.AddGroupMembersRequest")
+	proto.RegisterType((*AddGroupMembersResponse)(nil), "kubesphere.AddGroupMembersResponse")
+	proto.RegisterType((*RemoveGroupMembersRequest)(nil), "kubesphere.RemoveGroupMembersRequest")
+	proto.RegisterType((*RemoveGroupMembersResponse)(nil), "kubesphere.RemoveGroupMembersResponse")
+	proto.RegisterType((*PreviewRuleRequest)(nil), "kubesphere.PreviewRuleRequest")
+	proto.RegisterType((*PreviewRuleResponse)(nil), "kubesphere.PreviewRuleResponse")
+	proto.RegisterType((*ExtendMembershipRequest)(nil), "kubesphere.ExtendMembershipRequest")
+	proto.RegisterType((*ExtendMembershipResponse)(nil), "kubesphere.ExtendMembershipResponse")
+	proto.RegisterType((*ListExpiringMembershipsRequest)(nil), "kubesphere.ListExpiringMembershipsRequest")
+	proto.RegisterType((*ListExpiringMembershipsResponse)(nil), "kubesphere.ListExpiringMembershipsResponse")
+	proto.RegisterType((*MembershipRemoval)(nil), "kubesphere.MembershipRemoval")
+	proto.RegisterType((*ListMembershipRemovalsRequest)(nil), "kubesphere.ListMembershipRemovalsRequest")
+	proto.RegisterType((*ListMembershipRemovalsResponse)(nil), "kubesphere.ListMembershipRemovalsResponse")
+	proto.RegisterType((*ListEffectiveGroupsRequest)(nil), "kubesphere.ListEffectiveGroupsRequest")
+	proto.RegisterType((*EffectiveGroup)(nil), "kubesphere.EffectiveGroup")
+	proto.RegisterType((*ListEffectiveGroupsResponse)(nil), "kubesphere.ListEffectiveGroupsResponse")
+	proto.RegisterType((*ListEffectiveMembersRequest)(nil), "kubesphere.ListEffectiveMembersRequest")
+	proto.RegisterType((*EffectiveMember)(nil), "kubesphere.EffectiveMember")
+	proto.RegisterType((*ListEffectiveMembersResponse)(nil), "kubesphere.ListEffectiveMembersResponse")
+	proto.RegisterType((*IsMemberRequest)(nil), "kubesphere.IsMemberRequest")
+	proto.RegisterType((*IsMemberResponse)(nil), "kubesphere.IsMemberResponse")
+	proto.RegisterType((*ModifyPasswordRequest)(nil), "kubesphere.ModifyPasswordRequest")
+	proto.RegisterType((*ModifyPasswordResponse)(nil), "kubesphere.ModifyPasswordResponse")
+	proto.RegisterType((*ComparePasswordRequest)(nil), "kubesphere.ComparePasswordRequest")
+	proto.RegisterType((*ComparePasswordResponse)(nil), "kubesphere.ComparePasswordResponse")
+	proto.RegisterType((*SendEmailVerificationRequest)(nil), "kubesphere.SendEmailVerificationRequest")
+	proto.RegisterType((*SendEmailVerificationResponse)(nil), "kubesphere.SendEmailVerificationResponse")
+	proto.RegisterType((*ConfirmEmailVerificationRequest)(nil), "kubesphere.ConfirmEmailVerificationRequest")
+	proto.RegisterType((*ConfirmEmailVerificationResponse)(nil), "kubesphere.ConfirmEmailVerificationResponse")
+	proto.RegisterType((*SendPhoneCodeRequest)(nil), "kubesphere.SendPhoneCodeRequest")
+	proto.RegisterType((*SendPhoneCodeResponse)(nil), "kubesphere.SendPhoneCodeResponse")
+	proto.RegisterType((*VerifyPhoneCodeRequest)(nil), "kubesphere.VerifyPhoneCodeRequest")
+	proto.RegisterType((*VerifyPhoneCodeResponse)(nil), "kubesphere.VerifyPhoneCodeResponse")
+	proto.RegisterType((*Invite)(nil), "kubesphere.Invite")
+	proto.RegisterType((*InviteUserRequest)(nil), "kubesphere.InviteUserRequest")
+	proto.RegisterMapType((map[string]string)(nil), "kubesphere.InviteUserRequest.ExtraEntry")
+	proto.RegisterType((*InviteUserResponse)(nil), "kubesphere.InviteUserResponse")
+	proto.RegisterType((*AcceptInviteRequest)(nil), "kubesphere.AcceptInviteRequest")
+	proto.RegisterType((*AcceptInviteResponse)(nil), "kubesphere.AcceptInviteResponse")
+	proto.RegisterType((*ListInvitesRequest)(nil), "kubesphere.ListInvitesRequest")
+	proto.RegisterType((*ListInvitesResponse)(nil), "kubesphere.ListInvitesResponse")
+	proto.RegisterType((*RevokeInviteRequest)(nil), "kubesphere.RevokeInviteRequest")
+	proto.RegisterType((*RevokeInviteResponse)(nil), "kubesphere.RevokeInviteResponse")
+	proto.RegisterType((*UploadAvatarRequest)(nil), "kubesphere.UploadAvatarRequest")
+	proto.RegisterType((*UploadAvatarResponse)(nil), "kubesphere.UploadAvatarResponse")
+	proto.RegisterType((*GetAvatarRequest)(nil), "kubesphere.GetAvatarRequest")
+	proto.RegisterType((*GetAvatarResponse)(nil), "kubesphere.GetAvatarResponse")
+	proto.RegisterType((*Attribute)(nil), "kubesphere.Attribute")
+	proto.RegisterType((*AttributeFilter)(nil), "kubesphere.AttributeFilter")
+	proto.RegisterType((*CreateAttributeRequest)(nil), "kubesphere.CreateAttributeRequest")
+	proto.RegisterType((*CreateAttributeResponse)(nil), "kubesphere.CreateAttributeResponse")
+	proto.RegisterType((*ListAttributesRequest)(nil), "kubesphere.ListAttributesRequest")
+	proto.RegisterType((*ListAttributesResponse)(nil), "kubesphere.ListAttributesResponse")
+	proto.RegisterType((*DeleteAttributesRequest)(nil), "kubesphere.DeleteAttributesRequest")
+	proto.RegisterType((*DeleteAttributesResponse)(nil), "kubesphere.DeleteAttributesResponse")
+	proto.RegisterType((*BatchError)(nil), "kubesphere.BatchError")
+	proto.RegisterMapType((map[string]string)(nil), "kubesphere.BatchError.MetadataEntry")
+	proto.RegisterType((*BatchResult)(nil), "kubesphere.BatchResult")
+	proto.RegisterType((*BatchCreateUsersRequest)(nil), "kubesphere.BatchCreateUsersRequest")
+	proto.RegisterType((*BatchCreateUsersResponse)(nil), "kubesphere.BatchCreateUsersResponse")
+	proto.RegisterType((*BatchModifyUsersRequest)(nil), "kubesphere.BatchModifyUsersRequest")
+	proto.RegisterType((*BatchModifyUsersResponse)(nil), "kubesphere.BatchModifyUsersResponse")
+	proto.RegisterType((*BatchCreateGroupsRequest)(nil), "kubesphere.BatchCreateGroupsRequest")
+	proto.RegisterType((*BatchCreateGroupsResponse)(nil), "kubesphere.BatchCreateGroupsResponse")
+	proto.RegisterType((*MergeUsersRequest)(nil), "kubesphere.MergeUsersRequest")
+	proto.RegisterType((*MergeUsersResponse)(nil), "kubesphere.MergeUsersResponse")
+	proto.RegisterType((*GroupMembership)(nil), "kubesphere.GroupMembership")
+	proto.RegisterType((*Token)(nil), "kubesphere.Token")
+	proto.RegisterType((*Notification)(nil), "kubesphere.Notification")
+	proto.RegisterType((*UserData)(nil), "kubesphere.UserData")
+	proto.RegisterType((*ExportUserDataRequest)(nil), "kubesphere.ExportUserDataRequest")
+	proto.RegisterType((*ExportUserDataResponse)(nil), "kubesphere.ExportUserDataResponse")
+	proto.RegisterType((*AnonymizeUserRequest)(nil), "kubesphere.AnonymizeUserRequest")
+	proto.RegisterType((*AnonymizeUserResponse)(nil), "kubesphere.AnonymizeUserResponse")
+	proto.RegisterType((*LoginHistory)(nil), "kubesphere.LoginHistory")
+	proto.RegisterType((*ListLoginHistoryRequest)(nil), "kubesphere.ListLoginHistoryRequest")
+	proto.RegisterType((*ListLoginHistoryResponse)(nil), "kubesphere.ListLoginHistoryResponse")
+}
+
+func init() { proto.RegisterFile("im.proto", fileDescriptor_36f2114a3e4ddb9e) }
+
+var fileDescriptor_36f2114a3e4ddb9e = []byte{
+	// 5553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3c, 0x4d, 0x6f, 0x1c, 0x47,
+	0x76, 0xe8, 0xf9, 0x20, 0x67, 0xde, 0x70, 0xc8, 0x61, 0x93, 0x22, 0x47, 0x2d, 0x91, 0x43, 0xb5,
+	0x68, 0x9b, 0xb2, 0x24, 0x52, 0x92, 0x65, 0x49, 0xeb, 0x6f, 0x52, 0xa6, 0x25, 0xad, 0x44, 0xc5,
+	0x6e, 0x4b, 0xb6, 0xd6, 0x5e, 0x69, 0xdc, 0x9c, 0x29, 0x92, 0x6d, 0xce, 0x74, 0x8f, 0xbb, 0x7b,
+	0x28, 0xd2, 0xa6, 0x0f, 0x8b, 0x24, 0xc8, 0x21, 0xc8, 0x27, 0x10, 0xe4, 0x1b, 0x09, 0x36, 0x8b,
+	0x1c, 0xf6, 0x1f, 0x24, 0x40, 0x02, 0x04, 0x49, 0x80, 0x24, 0x48, 0x0e, 0x39, 0xe4, 0x4c, 0x80,
+	0x87, 0x60, 0x6f, 0xb9, 0xe6, 0x90, 0x43, 0x50, 0x1f, 0xdd, 0x5d, 0xd5, 0x5f, 0x33, 0xc3, 0xd1,
+	0x6e, 0x36, 0x7b, 0xeb, 0xaa, 0x7a, 0xf5, 0xea, 0xd5, 0xab, 0xf7, 0x5e, 0xbd, 0x7a, 0xf5, 0xaa,
+	0xa1, 0x60, 0xb4, 0x97, 0x3b, 0xb6, 0xe5, 0x5a, 0x32, 0xec, 0x76, 0x37, 0x91, 0xd3, 0xd9, 0x41,
+	0x36, 0x52, 0xce, 0x6e, 0x5b, 0xd6, 0x76, 0x0b, 0xad, 0xe8, 0x1d, 0x63, 0x45, 0x37, 0x4d, 0xcb,
+	0xd5, 0x5d, 0xc3, 0x32, 0x1d, 0x0a, 0xa9, 0xcc, 0xb3, 0x56, 0x52, 0xda, 0xec, 0x6e, 0xad, 0x34,
+	0xbb, 0x36, 0x01, 0x60, 0xed, 0x0b, 0xe1, 0xf6, 0x2d, 0x03, 0xb5, 0x9a, 0xf5, 0xb6, 0xee, 0xec,
+	0x32, 0x88, 0x5a, 0x18, 0xc2, 0x35, 0xda, 0xc8, 0x71, 0xf5, 0x76, 0x27, 0x69, 0x88, 0xe7, 0xb6,
+	0xde, 0xe9, 0x20, 0xdb, 0x23, 0xe1, 0xc6, 0xb6, 0xe1, 0xee, 0x74, 0x37, 0x97, 0x1b, 0x56, 0x7b,
+	0xa5, 0xfd, 0xdc, 0x70, 0x77, 0xad, 0xe7, 0x2b, 0xdb, 0xd6, 0x65, 0xd2, 0x78, 0x79, 0x4f, 0x6f,
+	0x19, 0x4d, 0xdd, 0xb5, 0x6c, 0x67, 0xc5, 0xff, 0xa4, 0xfd, 0xd4, 0x29, 0x98, 0xbc, 0x83, 0xdc,
+	0x4f, 0x90, 0xed, 0x18, 0x96, 0xa9, 0xa1, 0xaf, 0xba, 0xc8, 0x71, 0xd5, 0x65, 0x90, 0xf9, 0x4a,
+	0xa7, 0x63, 0x99, 0x0e, 0x92, 0xab, 0x30, 0xba, 0x47, 0xab, 0xaa, 0xd2, 0x82, 0xb4, 0x54, 0xd4,
+	0xbc, 0xa2, 0xfa, 0xc7, 0x39, 0x90, 0x6f, 0xdb, 0x48, 0x77, 0xd1, 0x1d, 0xdb, 0xea, 0x76, 0x18,
+	0x1a, 0xf9, 0x03, 0x98, 0xe8, 0xe8, 0x36, 0x32, 0xdd, 0xfa, 0x36, 0xae, 0xae, 0x1b, 0x4d, 0xda,
+	0x71, 0x6d, 0xfe, 0xf8, 0xa8, 0xa6, 0x40, 0xf5, 0xd9, 0xd2, 0xe7, 0xfa, 0xe5, 0xaf, 0x57, 0x2f,
+	0x7f, 0x76, 0xe5, 0xf2, 0x77, 0xea, 0x97, 0x9f, 0x7e, 0x73, 0xed, 0xd2, 0xeb, 0x57, 0xbe, 0xbd,
+	0xf0, 0xee, 0xa2, 0x56, 0xa6, 0xdd, 0x08, 0xb2, 0x7b, 0x4d, 0xf9, 0x3a, 0x00, 0x45, 0x60, 0xea,
+	0x6d, 0x54, 0xcd, 0x10, 0x14, 0xa7, 0x8e, 0x8f, 0x6a, 0x93, 0x50, 0x5e, 0x7a, 0xd7, 0xb9, 0xf0,
+	0x6c, 0xf9, 0x9b, 0x2b, 0xb8, 0xe7, 0xe2, 0x13, 0x49, 0x2b, 0x12, 0xc0, 0x87, 0x7a, 0x1b, 0xc9,
+	0x37, 0xa1, 0xd4, 0x44, 0x4e, 0xc3, 0x36, 0x3a, 0x78, 0x25, 0xaa, 0x59, 0xae, 0xdb, 0x84, 0xdf,
+	0xed, 0xea, 0x95, 0x2b, 0x57, 0xbe, 0x5d, 0xd4, 0x78, 0x48, 0xf9, 0x5d, 0xc8, 0xa3, 0x7d, 0xd7,
+	0xd6, 0xab, 0xb9, 0x85, 0xec, 0x52, 0xe9, 0xda, 0x85, 0xe5, 0x40, 0x0e, 0x96, 0xa3, 0xb3, 0x5c,
+	0x5e, 0xc7, 0xb0, 0xeb, 0xa6, 0x6b, 0x1f, 0x68, 0xb4, 0x9f, 0x7c, 0x11, 0x26, 0x0d, 0x53, 0x6f,
+	0xb8, 0xc6, 0x9e, 0xe1, 0x1e, 0xd4, 0xd1, 0x3e, 0x6a, 0x77, 0xdc, 0x6a, 0x7e, 0x41, 0x5a, 0x2a,
+	0x68, 0x95, 0xa0, 0x61, 0x9d, 0xd4, 0xcb, 0xef, 0xc0, 0x44, 0x1b, 0xb5, 0x37, 0x91, 0xed, 0xec,
+	0x18, 0x9d, 0xba, 0xdd, 0x6d, 0xa1, 0xea, 0x48, 0x1a, 0xa9, 0xe3, 0x01, 0xb4, 0xd6, 0x6d, 0x21,
+	0xb9, 0x06, 0xa5, 0xb6, 0xbe, 0x5f, 0x67, 0xb5, 0xd5, 0xd1, 0x05, 0x69, 0xa9, 0xac, 0x41, 0x5b,
+	0xdf, 0xdf, 0xa0, 0x35, 0xf2, 0x12, 0x54, 0x30, 0x40, 0x63, 0xc7, 0x68, 0x35, 0xe9, 0x42, 0x38,
+	0xd5, 0x02, 0x81, 0x1a, 0x6f, 0xeb, 0xfb, 0xb7, 0x71, 0x35, 0x99, 0x8f, 0x23, 0x9f, 0x81, 0x22,
+	0x86, 0x6c, 0xa2, 0x8e, 0xbb, 0x53, 0x2d, 0x12, 0x90, 0x42, 0x5b, 0xdf, 0x7f, 0x1f, 0x97, 0x95,
+	0x5b, 0x00, 0xc1, 0x4c, 0xe5, 0x0a, 0x64, 0x77, 0xd1, 0x01, 0x93, 0x03, 0xfc, 0x29, 0x4f, 0x43,
+	0x7e, 0x4f, 0x6f, 0x75, 0xd9, 0xfa, 0x68, 0xb4, 0xf0, 0x46, 0xe6, 0x96, 0xa4, 0x5e, 0x81, 0x29,
+	0x81, 0x6d, 0x4c, 0x9c, 0x4e, 0x43, 0x41, 0x14, 0x0b, 0x6d, 0x74, 0x9b, 0x2e, 0xb8, 0xfa, 0x2f,
+	0x12, 0x4c, 0xbd, 0x8f, 0x5a, 0x88, 0x75, 0x71, 0x3c, 0x81, 0xba, 0x25, 0x74, 0xc9, 0x2e, 0x15,
+	0xd7, 0xe6, 0x8e, 0x8f, 0x6a, 0xa7, 0xe1, 0xd4, 0xb3, 0x18, 0x41, 0x5a, 0xfc, 0x42, 0xf2, 0x31,
+	0x62, 0xd9, 0x6d, 0xe8, 0x4e, 0x43, 0x6f, 0x52, 0xfa, 0x0a, 0x9a, 0x57, 0xc4, 0x42, 0xda, 0xb6,
+	0xf6, 0x90, 0xc7, 0xc0, 0xba, 0x6b, 0x55, 0xb3, 0xfd, 0x09, 0x29, 0xee, 0xc6, 0x98, 0xfc, 0xc8,
+	0x92, 0x67, 0x61, 0xb4, 0x69, 0x1f, 0xd4, 0xed, 0xae, 0x59, 0xcd, 0x91, 0x11, 0x46, 0x9a, 0xf6,
+	0x81, 0xd6, 0x35, 0xd5, 0x7f, 0x95, 0x60, 0x5a, 0x9c, 0x4c, 0x2c, 0x03, 0xb2, 0x1c, 0x03, 0xe4,
+	0xb7, 0xa1, 0xb4, 0x69, 0x98, 0x4d, 0xc3, 0xdc, 0xae, 0x3b, 0xc8, 0xad, 0x66, 0x88, 0x20, 0x9e,
+	0xe5, 0x05, 0xf1, 0xb1, 0x83, 0x6c, 0x82, 0x6f, 0x8d, 0xc2, 0x69, 0xc0, 0x3a, 0x7c, 0x8c, 0x5c,
+	0x9e, 0x96, 0x2c, 0x4f, 0x8b, 0xbc, 0x0a, 0x15, 0x3a, 0x24, 0x9d, 0x2d, 0x41, 0x4e, 0xa5, 0x7c,
+	0x96, 0x47, 0x4e, 0x10, 0xd3, 0xa9, 0x69, 0xe3, 0xdb, 0x41, 0xe1, 0x63, 0xe4, 0xaa, 0x7f, 0x2a,
+	0x41, 0x89, 0x6b, 0x97, 0xc7, 0x21, 0xe3, 0x2f, 0x60, 0xc6, 0x68, 0x0a, 0xb3, 0xca, 0x08, 0xcb,
+	0x2a, 0xbf, 0xec, 0x89, 0x7a, 0x60, 0x0f, 0x08, 0xab, 0xb5, 0x32, 0xad, 0xf6, 0xf4, 0xfd, 0x4d,
+	0x28, 0x35, 0x88, 0xc0, 0xd4, 0xb1, 0x15, 0x24, 0xec, 0x2c, 0x5d, 0x53, 0x96, 0xa9, 0x05, 0x5c,
+	0xf6, 0x2c, 0xe0, 0xf2, 0x23, 0xcf, 0x44, 0x6a, 0x40, 0xc1, 0x71, 0x85, 0x7a, 0x24, 0x41, 0x25,
+	0xcc, 0x1c, 0x79, 0x0e, 0x3c, 0xf6, 0x04, 0xd2, 0x56, 0x64, 0x35, 0xf7, 0x52, 0x69, 0x9e, 0x85,
+	0xd1, 0xae, 0x83, 0xec, 0x80, 0xd6, 0x11, 0x5c, 0x1c, 0x92, 0x48, 0xdc, 0x19, 0xed, 0x77, 0x0c,
+	0x9b, 0x75, 0xce, 0xf7, 0xee, 0x4c, 0xc1, 0xc9, 0x0c, 0x7f, 0x33, 0x0f, 0xf2, 0x86, 0xd5, 0x34,
+	0xb6, 0x0e, 0x04, 0x6b, 0x7b, 0x23, 0xac, 0x4f, 0x6b, 0x67, 0x8e, 0x8f, 0x6a, 0xb3, 0x09, 0xca,
+	0x11, 0xcc, 0x30, 0xc6, 0x4a, 0x67, 0x4e, 0x62, 0xa5, 0xaf, 0x09, 0x56, 0x9a, 0xea, 0xd0, 0xd4,
+	0xf1, 0x51, 0x6d, 0x22, 0x64, 0xa5, 0x53, 0x6c, 0x74, 0x6e, 0x70, 0x1b, 0x9d, 0x8f, 0xda, 0xe8,
+	0x28, 0x6f, 0x62, 0x6c, 0xf4, 0x9b, 0x50, 0xea, 0x76, 0x9a, 0x78, 0xf9, 0xf0, 0x2e, 0x5c, 0x1d,
+	0x49, 0x58, 0x81, 0x0f, 0xf0, 0x46, 0xbd, 0xa1, 0x3b, 0xbb, 0x1a, 0x50, 0x70, 0xfc, 0x1d, 0x6f,
+	0xe0, 0x47, 0xfb, 0x37, 0xf0, 0x85, 0x21, 0x0c, 0x7c, 0xb1, 0x2f, 0x03, 0x0f, 0xbd, 0x0d, 0x7c,
+	0xe9, 0x45, 0x1a, 0x78, 0x81, 0xe7, 0xbd, 0x0d, 0xfc, 0xef, 0x4a, 0x50, 0xd9, 0xb0, 0xf6, 0xd0,
+	0xcf, 0x93, 0x00, 0xab, 0x7f, 0x22, 0xc1, 0x24, 0x47, 0x54, 0xcf, 0x59, 0x60, 0xab, 0x42, 0x9b,
+	0x3a, 0xba, 0xbb, 0xc3, 0xd8, 0x42, 0x85, 0xfb, 0x43, 0xdd, 0xdd, 0x91, 0x5f, 0x82, 0x71, 0x7d,
+	0x6b, 0x0b, 0x35, 0x5c, 0xd4, 0xac, 0x37, 0xac, 0xae, 0xe9, 0x12, 0xa5, 0x28, 0x6b, 0x65, 0xaf,
+	0xf6, 0x36, 0xae, 0xc4, 0x56, 0x31, 0xd0, 0x1b, 0x8a, 0x2a, 0x47, 0xad, 0xa2, 0xaf, 0x27, 0x18,
+	0x9d, 0xfa, 0x6f, 0x79, 0xc8, 0x13, 0xd2, 0x70, 0x8f, 0x58, 0xbf, 0x2a, 0xac, 0x91, 0x29, 0x66,
+	0x4d, 0x24, 0x3d, 0x1b, 0x26, 0x7d, 0x4e, 0xd0, 0xe5, 0x1c, 0xd7, 0x4c, 0xd4, 0x76, 0x41, 0x54,
+	0xdb, 0x3c, 0x69, 0x17, 0xf4, 0x73, 0x06, 0x46, 0x1c, 0x57, 0x77, 0xbb, 0x0e, 0x75, 0x66, 0x34,
+	0x56, 0x92, 0xaf, 0x79, 0x7a, 0x3b, 0x1a, 0xdd, 0xd2, 0x08, 0xd9, 0xf1, 0xaa, 0xca, 0x5b, 0xda,
+	0xc2, 0xa0, 0x96, 0x96, 0xe9, 0x39, 0xe9, 0x5c, 0xec, 0xdd, 0x99, 0x82, 0x7b, 0x9d, 0x29, 0xdd,
+	0xb4, 0x33, 0xf4, 0xee, 0x4c, 0xc1, 0x49, 0xe7, 0x58, 0x23, 0x51, 0x4a, 0x30, 0x12, 0xaf, 0x44,
+	0x8d, 0xc4, 0x18, 0x61, 0x5c, 0x0f, 0x6b, 0x50, 0xee, 0xcb, 0x1a, 0x8c, 0xf7, 0xb6, 0x06, 0x13,
+	0xa2, 0x35, 0x88, 0x93, 0xca, 0x4a, 0x8c, 0x54, 0x0e, 0x61, 0x35, 0x7e, 0x28, 0x41, 0x99, 0x50,
+	0xf2, 0xa9, 0xe1, 0xee, 0xe0, 0x1d, 0x5b, 0x7e, 0x05, 0xf2, 0x04, 0x39, 0xe9, 0x5f, 0xba, 0x36,
+	0x19, 0x11, 0x0e, 0x8d, 0xb6, 0xcb, 0x17, 0xa1, 0xd0, 0x75, 0x98, 0xfb, 0x42, 0x7d, 0xa3, 0x4a,
+	0xd8, 0x37, 0xd2, 0xc8, 0xb6, 0x8d, 0x9d, 0xa1, 0x37, 0xa1, 0x22, 0x78, 0x1d, 0xb8, 0x53, 0x76,
+	0x21, 0x1b, 0x3f, 0xc0, 0x38, 0xe7, 0x89, 0x60, 0x6f, 0xc7, 0x84, 0x89, 0x3b, 0xc8, 0x7d, 0x21,
+	0x66, 0xea, 0x3c, 0x94, 0xd1, 0x7e, 0x47, 0x37, 0x9b, 0x75, 0x13, 0x39, 0x2e, 0x6a, 0x32, 0x47,
+	0x74, 0x8c, 0x56, 0x3e, 0x24, 0x75, 0xea, 0x9b, 0x50, 0x09, 0xc6, 0x63, 0x16, 0xa8, 0x5f, 0xb6,
+	0xa8, 0xf7, 0xe1, 0xb4, 0xd7, 0x79, 0xed, 0xc0, 0x5b, 0x21, 0x8f, 0xec, 0xe5, 0xe8, 0x82, 0x52,
+	0xea, 0x47, 0x8e, 0x8f, 0x6a, 0x99, 0x27, 0x52, 0xd8, 0xdc, 0xdc, 0x87, 0xaa, 0x87, 0xcc, 0x5b,
+	0x20, 0x9f, 0xa2, 0x15, 0x91, 0xa2, 0xd3, 0x11, 0x8a, 0xfc, 0x1e, 0x8c, 0xb2, 0x1f, 0x4a, 0x30,
+	0xe5, 0x61, 0x7b, 0x64, 0x23, 0xe4, 0x11, 0xb5, 0x06, 0x65, 0xdb, 0xb2, 0x06, 0x3e, 0x1f, 0x96,
+	0x70, 0x27, 0xcf, 0xca, 0x09, 0x62, 0x9c, 0x09, 0x89, 0xf1, 0xab, 0x30, 0xf9, 0xdc, 0x70, 0x77,
+	0x3c, 0x7f, 0x37, 0x30, 0xc3, 0x05, 0x6d, 0x02, 0x37, 0x50, 0xad, 0x21, 0x86, 0x58, 0xfd, 0x71,
+	0x06, 0xca, 0x3e, 0x85, 0x0f, 0xad, 0x66, 0xff, 0x9c, 0x97, 0x5f, 0x87, 0x02, 0x51, 0x38, 0x1b,
+	0x99, 0x4c, 0x20, 0xa3, 0x3c, 0xf1, 0xb0, 0x6a, 0x3e, 0x28, 0x56, 0x66, 0xf2, 0x2d, 0x6c, 0x0f,
+	0x40, 0xaa, 0xe8, 0xde, 0xf0, 0x00, 0xa6, 0x9a, 0x86, 0x8d, 0x1a, 0xae, 0x38, 0x01, 0xea, 0x6c,
+	0x9e, 0x8d, 0x18, 0xa2, 0xc7, 0xf7, 0x4c, 0xf7, 0xb5, 0x6b, 0x9f, 0x60, 0x15, 0xd3, 0x26, 0x69,
+	0x47, 0x6e, 0x82, 0xf2, 0x77, 0x41, 0x76, 0x2d, 0x57, 0x6f, 0x89, 0xc8, 0xf2, 0x7d, 0x20, 0xab,
+	0x90, 0x7e, 0x3c, 0xb3, 0x1e, 0xc0, 0xb4, 0xb8, 0xa0, 0x4c, 0x34, 0xae, 0x43, 0xc1, 0xb4, 0x9a,
+	0x88, 0x68, 0x99, 0xd4, 0x8b, 0x13, 0xa3, 0x18, 0x14, 0xab, 0xd9, 0x5f, 0xe7, 0x60, 0xf2, 0x81,
+	0xe1, 0xb8, 0xe2, 0x71, 0xaf, 0x06, 0x25, 0x07, 0xe9, 0x76, 0x63, 0xa7, 0xfe, 0xdc, 0xb2, 0xbd,
+	0x33, 0x12, 0xd0, 0xaa, 0x4f, 0x2d, 0x9b, 0x6c, 0x70, 0x8e, 0x65, 0xbb, 0x75, 0x6c, 0x73, 0xd8,
+	0x06, 0x87, 0xcb, 0xf7, 0xd1, 0x01, 0x3e, 0xf0, 0xd9, 0x68, 0x0f, 0xd9, 0x0e, 0x62, 0xcb, 0xed,
+	0x15, 0xf1, 0xd6, 0x64, 0x6d, 0x6d, 0xd1, 0x93, 0x0f, 0xe6, 0x37, 0x2b, 0x61, 0x4b, 0xd5, 0x32,
+	0xda, 0x06, 0x65, 0x48, 0x59, 0xa3, 0x05, 0xf9, 0xdd, 0xb0, 0x84, 0x8e, 0x2c, 0x64, 0x7b, 0xa9,
+	0xbc, 0x20, 0x9e, 0xb7, 0xa3, 0x9b, 0xf5, 0x68, 0x6f, 0x14, 0xa1, 0x9d, 0x9c, 0xb7, 0x39, 0x85,
+	0x85, 0x6c, 0xdf, 0x36, 0xe7, 0x2d, 0x61, 0x9b, 0x2f, 0x72, 0x47, 0xe6, 0x59, 0xbe, 0xe7, 0x32,
+	0xe9, 0x7a, 0xed, 0xf5, 0xd7, 0x7d, 0xef, 0x3c, 0xc6, 0x0b, 0x00, 0xc2, 0x7e, 0xce, 0x0b, 0x08,
+	0xf6, 0xf8, 0x12, 0x69, 0x62, 0x25, 0xf9, 0x03, 0xa8, 0xe8, 0xae, 0x6b, 0x1b, 0x9b, 0x5d, 0x17,
+	0xd5, 0xb7, 0x8c, 0x96, 0x8b, 0xec, 0xea, 0x18, 0x11, 0x85, 0x33, 0xbc, 0x28, 0xac, 0x7a, 0x30,
+	0x1f, 0x10, 0x10, 0x6d, 0x42, 0x17, 0x2b, 0xe2, 0xb6, 0xa0, 0x32, 0x19, 0x28, 0x64, 0xa9, 0x3e,
+	0x03, 0x99, 0x97, 0x1d, 0x26, 0x88, 0xd3, 0x90, 0x27, 0x42, 0x4b, 0x74, 0xb7, 0xac, 0xd1, 0x82,
+	0xbc, 0x0c, 0xc5, 0x60, 0x17, 0xc8, 0x24, 0xed, 0x02, 0x94, 0xd9, 0x58, 0x30, 0xbf, 0x04, 0x25,
+	0xc0, 0x1d, 0xb1, 0x83, 0xf1, 0x63, 0xdc, 0x88, 0x8e, 0x91, 0x62, 0x21, 0x83, 0xb1, 0xfe, 0x21,
+	0x0f, 0x93, 0x34, 0x50, 0x42, 0x07, 0xa1, 0x4a, 0x70, 0x95, 0xee, 0x75, 0x64, 0x09, 0xa4, 0xb4,
+	0xd0, 0x97, 0x0f, 0x26, 0x7f, 0x08, 0x79, 0xd4, 0xd6, 0x8d, 0x16, 0x73, 0x83, 0xdf, 0x38, 0x3e,
+	0xaa, 0xdd, 0x80, 0x6b, 0xbc, 0x35, 0x5d, 0xae, 0x5f, 0xbc, 0xfc, 0xf4, 0xe2, 0x7b, 0x5c, 0xc5,
+	0xe5, 0xa7, 0x17, 0xbf, 0xbf, 0xcc, 0xca, 0x58, 0x18, 0xb0, 0x9d, 0xdd, 0x7f, 0x4d, 0xa3, 0x88,
+	0xe4, 0x1b, 0x30, 0xd6, 0xd9, 0xb1, 0x4c, 0x54, 0x37, 0xbb, 0xd8, 0x04, 0xa4, 0x9d, 0xee, 0x4a,
+	0x04, 0xf0, 0x21, 0x81, 0x3b, 0xf9, 0xf9, 0x4e, 0x85, 0x42, 0x47, 0x77, 0x1c, 0xa2, 0xf7, 0xf9,
+	0x60, 0x9b, 0xda, 0xbf, 0xa7, 0xf9, 0xf5, 0xf2, 0x3b, 0x9e, 0x2f, 0x39, 0x42, 0x78, 0xbc, 0x14,
+	0x8d, 0xd3, 0x71, 0x7c, 0x8c, 0xf1, 0x2b, 0x6f, 0xc2, 0x58, 0xd3, 0x70, 0x3a, 0x2d, 0xfd, 0x80,
+	0x0a, 0xf8, 0x28, 0x19, 0x67, 0xfa, 0xf8, 0xa8, 0x56, 0x81, 0x71, 0x9e, 0x3a, 0x42, 0x1c, 0x85,
+	0x24, 0x82, 0x8f, 0x4f, 0xba, 0xc6, 0x1e, 0x32, 0x69, 0xb7, 0x42, 0xda, 0x49, 0x17, 0x83, 0x91,
+	0x3e, 0xd7, 0xa1, 0xb4, 0xa5, 0xb7, 0x8d, 0x16, 0x1b, 0xab, 0x98, 0xdc, 0x09, 0x28, 0x1c, 0xe9,
+	0x75, 0x07, 0x46, 0x5a, 0x56, 0x43, 0x6f, 0x51, 0xdf, 0xb3, 0xb8, 0xb6, 0x72, 0x7c, 0x54, 0xbb,
+	0x08, 0x17, 0x9e, 0x2d, 0x71, 0x2b, 0x75, 0xeb, 0xdb, 0xa5, 0xcf, 0xeb, 0x97, 0x9f, 0x06, 0x6b,
+	0xf9, 0xf4, 0x9b, 0xab, 0x97, 0x6e, 0x7d, 0x7b, 0xe1, 0x55, 0xbc, 0x53, 0xb2, 0xee, 0xf2, 0x0a,
+	0x14, 0xb0, 0x0b, 0xfb, 0xb5, 0x65, 0xa2, 0x6a, 0x29, 0x79, 0x6c, 0x1f, 0x68, 0x08, 0xbf, 0xee,
+	0x32, 0xc8, 0x3c, 0xf7, 0x99, 0xaa, 0x70, 0x71, 0x14, 0x89, 0x8f, 0xa3, 0xa8, 0x0f, 0x40, 0xa6,
+	0xd1, 0x31, 0x0c, 0xee, 0x04, 0x4e, 0x16, 0x07, 0xde, 0x47, 0xa0, 0xcf, 0xc3, 0xb6, 0x0c, 0x53,
+	0x02, 0xb6, 0xb8, 0xd1, 0xb3, 0xdc, 0xe8, 0xff, 0x38, 0x02, 0x93, 0xf4, 0xec, 0xca, 0xeb, 0xdc,
+	0xf5, 0x10, 0xb1, 0xe9, 0xd6, 0x96, 0xe1, 0xc2, 0x3c, 0xf6, 0x35, 0x35, 0x93, 0xc2, 0xe3, 0xa8,
+	0x9e, 0x66, 0x7f, 0x5a, 0x7a, 0x9a, 0x3b, 0x99, 0x9e, 0xe6, 0xfb, 0xd6, 0xd3, 0x77, 0xc4, 0xf3,
+	0xdc, 0x52, 0x34, 0x0e, 0x93, 0xae, 0x83, 0xa1, 0x30, 0x4c, 0x61, 0xa0, 0x30, 0x4c, 0x58, 0x81,
+	0x8b, 0x27, 0x53, 0x60, 0x38, 0x89, 0x02, 0x97, 0x06, 0x55, 0xe0, 0xb1, 0x17, 0xa7, 0xc0, 0xe5,
+	0x3e, 0x14, 0x58, 0xbe, 0xe6, 0xef, 0xce, 0xe3, 0x04, 0x5c, 0x39, 0x3e, 0xaa, 0xcd, 0xc0, 0xf4,
+	0xb3, 0x25, 0x72, 0xf2, 0x44, 0x87, 0x4d, 0xc3, 0xd1, 0x37, 0x5b, 0xa8, 0x49, 0x06, 0xa1, 0x90,
+	0xc3, 0x29, 0x3d, 0xbf, 0xdc, 0xbd, 0x94, 0xfe, 0xaf, 0x0a, 0x90, 0xc3, 0x90, 0x89, 0x10, 0xb2,
+	0x12, 0x56, 0x26, 0x4e, 0x6f, 0xa6, 0x05, 0xbd, 0xf1, 0x64, 0xff, 0x5c, 0x9c, 0xec, 0x8b, 0x62,
+	0x7e, 0xf2, 0xb8, 0xc5, 0x55, 0x51, 0xce, 0xcf, 0x84, 0x8f, 0x9b, 0xbf, 0x38, 0x61, 0x8b, 0x97,
+	0x60, 0x9c, 0xf0, 0xb3, 0xbe, 0x87, 0x6c, 0x63, 0xcb, 0x40, 0x4d, 0x16, 0xb3, 0x28, 0x93, 0xda,
+	0x4f, 0x58, 0xa5, 0xfc, 0x01, 0x4c, 0x72, 0x60, 0x07, 0x74, 0xa4, 0xb1, 0x9e, 0x23, 0x4d, 0x04,
+	0x58, 0x0e, 0xbc, 0xe1, 0xe8, 0xaa, 0xf9, 0xc3, 0x95, 0xe9, 0x70, 0xa4, 0x96, 0x1f, 0x8e, 0x03,
+	0x63, 0xc3, 0x8d, 0xf7, 0x1e, 0x2e, 0xc0, 0x42, 0x87, 0x3b, 0x17, 0x32, 0x19, 0x13, 0x4c, 0x04,
+	0x38, 0xe3, 0x30, 0x27, 0x18, 0x87, 0x0a, 0x8b, 0x7d, 0xf9, 0x76, 0xa0, 0x26, 0xda, 0x81, 0x49,
+	0xd2, 0xce, 0xab, 0xfc, 0x8c, 0xaf, 0xf2, 0x32, 0x15, 0x21, 0x5a, 0xc2, 0x12, 0xed, 0x6b, 0xf0,
+	0x14, 0x95, 0x68, 0x5f, 0x59, 0xef, 0x82, 0xac, 0xef, 0xe9, 0xae, 0x6e, 0xd7, 0xf9, 0x55, 0x9f,
+	0xee, 0x39, 0xbf, 0x0a, 0xed, 0xf5, 0x38, 0x58, 0xfb, 0x35, 0x98, 0x68, 0xe9, 0x8e, 0x5b, 0x6f,
+	0x59, 0xdb, 0x86, 0x49, 0xd1, 0x9c, 0xea, 0x89, 0xa6, 0x8c, 0xbb, 0x3c, 0xc0, 0x3d, 0x08, 0x0e,
+	0x1c, 0x63, 0x42, 0xf6, 0x36, 0x6a, 0xd6, 0x0d, 0xd3, 0xb5, 0xaa, 0x33, 0x74, 0x8a, 0xb4, 0xea,
+	0x9e, 0xe9, 0x5a, 0xf2, 0x2a, 0x8c, 0xeb, 0xa6, 0x65, 0x1e, 0xb4, 0x8d, 0xaf, 0x19, 0xa9, 0xb3,
+	0xbd, 0xc7, 0xf0, 0x7b, 0xe0, 0xba, 0x21, 0x4c, 0xcd, 0x8f, 0x24, 0x28, 0x63, 0x95, 0xc3, 0x1e,
+	0x34, 0x8d, 0x87, 0x2e, 0x42, 0xae, 0xeb, 0x20, 0x9b, 0x9d, 0xd2, 0xa3, 0xa1, 0x20, 0xd2, 0x3a,
+	0xa8, 0xeb, 0x1f, 0xbe, 0x83, 0xcb, 0x0e, 0x76, 0x07, 0xa7, 0xee, 0xc2, 0xf8, 0x1d, 0xe4, 0x0e,
+	0xef, 0x55, 0x9c, 0x87, 0xf2, 0x96, 0xd5, 0x6a, 0x59, 0xcf, 0xeb, 0x74, 0x01, 0xbc, 0xb0, 0x11,
+	0xad, 0xdc, 0x20, 0x75, 0xea, 0x4d, 0x98, 0xf0, 0x07, 0x63, 0xb6, 0xb7, 0x2f, 0xa6, 0xa8, 0xf7,
+	0x48, 0x94, 0x47, 0x60, 0xa7, 0x8f, 0xe1, 0xb2, 0x80, 0xe1, 0x74, 0x18, 0x43, 0xd0, 0x81, 0xa2,
+	0xfa, 0x9b, 0x1c, 0x54, 0xf0, 0x59, 0x49, 0xf0, 0xe3, 0x7e, 0x21, 0x8e, 0xf0, 0xfc, 0xe9, 0x7b,
+	0x74, 0x80, 0xd3, 0x37, 0xb7, 0xe0, 0x7d, 0x1c, 0xda, 0xe3, 0x76, 0x3e, 0x72, 0x62, 0x8f, 0xdb,
+	0xf9, 0xe8, 0x61, 0x3c, 0x61, 0xe7, 0xa3, 0xc7, 0x71, 0x61, 0xe7, 0x0b, 0xf6, 0xb5, 0x31, 0xe1,
+	0xac, 0xbe, 0x1a, 0xb1, 0xf6, 0xe5, 0x04, 0x4d, 0x5e, 0xb3, 0xac, 0x16, 0x0d, 0x06, 0x45, 0x76,
+	0x82, 0xe8, 0x71, 0x7f, 0x7c, 0xf0, 0xe3, 0xbe, 0xfa, 0x09, 0x4c, 0x72, 0xe2, 0x93, 0x7a, 0xc2,
+	0x1e, 0x24, 0xfe, 0xab, 0xee, 0xd0, 0x23, 0x3c, 0xc1, 0x1b, 0x15, 0xf2, 0xf8, 0x01, 0xae, 0x47,
+	0x06, 0x48, 0x11, 0x7f, 0x7f, 0xa4, 0xbf, 0x93, 0xa0, 0xf2, 0x5d, 0xcb, 0x30, 0x85, 0x70, 0xf1,
+	0xc9, 0x73, 0x16, 0xb8, 0x33, 0x50, 0x66, 0x80, 0x33, 0x50, 0xf8, 0x72, 0x39, 0x3b, 0xd0, 0xe5,
+	0xf2, 0x1d, 0x98, 0xe4, 0xa6, 0xd0, 0x3b, 0x53, 0x61, 0x36, 0x44, 0xa4, 0xef, 0xe2, 0xfd, 0xaa,
+	0x04, 0x93, 0x0f, 0x90, 0xbe, 0x87, 0xfe, 0x6f, 0xb9, 0xa1, 0xde, 0x05, 0x99, 0x27, 0x63, 0x88,
+	0x19, 0xfd, 0xbe, 0x04, 0x33, 0xab, 0xcd, 0x26, 0x97, 0xfc, 0xe0, 0x0c, 0x7b, 0x27, 0xb0, 0x1e,
+	0xcd, 0x88, 0xe8, 0x6b, 0x72, 0x62, 0xc2, 0x84, 0xfa, 0x7d, 0x98, 0x8d, 0x10, 0xd6, 0xfb, 0xfa,
+	0xf2, 0xe5, 0x84, 0xc1, 0xc3, 0xd8, 0xff, 0x48, 0x82, 0xd3, 0x1a, 0x6a, 0x7b, 0x37, 0xa3, 0x3f,
+	0x5f, 0x53, 0xaf, 0x83, 0x12, 0x47, 0xdb, 0x8b, 0x9b, 0xfd, 0xaf, 0x48, 0x20, 0x7f, 0x68, 0xa3,
+	0x3d, 0x03, 0x3d, 0xc7, 0x17, 0x70, 0xde, 0xb4, 0xdf, 0x8b, 0x5e, 0xd8, 0xd1, 0xd9, 0xcf, 0x1e,
+	0x1f, 0xd5, 0xa6, 0x22, 0xa7, 0xe6, 0x27, 0x52, 0xe4, 0x26, 0x2f, 0xd8, 0xc4, 0x32, 0xf1, 0x9b,
+	0x58, 0x96, 0xdb, 0xc4, 0xd4, 0x27, 0x30, 0x25, 0x50, 0xf1, 0xe2, 0xec, 0xe3, 0x3f, 0x49, 0x30,
+	0xbb, 0xbe, 0xef, 0x22, 0xb3, 0xb9, 0x11, 0x10, 0xf8, 0xff, 0xd3, 0x78, 0x7d, 0x0f, 0xaa, 0xd1,
+	0x99, 0x30, 0x4e, 0x85, 0xdc, 0x39, 0x69, 0x40, 0x77, 0xee, 0xef, 0x25, 0x98, 0xc7, 0xdb, 0xc8,
+	0x3a, 0x1e, 0xcd, 0x30, 0xb7, 0x83, 0x11, 0x7c, 0x4d, 0x38, 0x81, 0x4d, 0xc1, 0xbe, 0x09, 0x9b,
+	0xee, 0x26, 0xda, 0xb2, 0xec, 0x7e, 0x26, 0x3c, 0x46, 0x3b, 0xac, 0x11, 0xf8, 0xc1, 0x5c, 0x21,
+	0x75, 0x0f, 0x6a, 0x89, 0x93, 0x48, 0x95, 0xa8, 0xe1, 0x12, 0xd2, 0xd4, 0xff, 0xc6, 0xa9, 0x15,
+	0xdc, 0x9a, 0xb4, 0xad, 0x3d, 0xbd, 0xf5, 0xd3, 0xc8, 0xca, 0x9a, 0x81, 0x11, 0x1b, 0xe9, 0x8e,
+	0x17, 0x6b, 0xd6, 0x58, 0x69, 0xa8, 0x84, 0xab, 0xf0, 0x49, 0x7e, 0x64, 0xa0, 0x7c, 0xb4, 0x1f,
+	0x48, 0x30, 0x87, 0x79, 0x1e, 0x99, 0xfe, 0x50, 0x72, 0x13, 0x2c, 0x7b, 0x36, 0x7e, 0xd9, 0x73,
+	0xe2, 0xb2, 0xcf, 0x27, 0x91, 0x90, 0xba, 0xea, 0xef, 0x40, 0xc9, 0xa6, 0x90, 0xdc, 0xaa, 0xcf,
+	0x09, 0x31, 0xbe, 0x30, 0x4a, 0x0d, 0x58, 0x0f, 0xbc, 0xec, 0x1a, 0x75, 0xbd, 0xd6, 0x49, 0xbe,
+	0x8b, 0xb1, 0x17, 0xca, 0xe6, 0x3c, 0xd1, 0x79, 0x48, 0x45, 0x30, 0x2e, 0xe2, 0xeb, 0xff, 0x96,
+	0x76, 0x06, 0x46, 0xe8, 0xa5, 0x28, 0x3b, 0x43, 0xb1, 0x92, 0x2c, 0x43, 0x8e, 0xa5, 0xc1, 0x60,
+	0x16, 0x93, 0x6f, 0xb5, 0x05, 0x67, 0x62, 0x49, 0x4f, 0xe5, 0xd7, 0xcd, 0xe8, 0x11, 0x53, 0xe1,
+	0xa9, 0x11, 0xb1, 0x71, 0x57, 0x3f, 0xbf, 0x2c, 0x85, 0x86, 0x7b, 0x41, 0x9b, 0xec, 0x60, 0x7b,
+	0x4c, 0x03, 0x26, 0x42, 0x04, 0xf4, 0x79, 0xb4, 0x1e, 0x8c, 0xb1, 0x67, 0xe3, 0x67, 0xda, 0xe3,
+	0x4e, 0x2d, 0xbc, 0xa3, 0x9d, 0x89, 0x65, 0x2c, 0xc5, 0x16, 0x6c, 0x6e, 0x7f, 0x26, 0xc1, 0xc4,
+	0x3d, 0x87, 0xd5, 0x0e, 0x75, 0x0e, 0xbf, 0x11, 0xb6, 0x46, 0x7d, 0x2e, 0xc1, 0x3c, 0x80, 0x6b,
+	0xeb, 0xa6, 0x63, 0x60, 0xf2, 0xd8, 0x41, 0x96, 0xab, 0x51, 0x57, 0xa0, 0x12, 0x10, 0xc8, 0x78,
+	0x70, 0x06, 0x8a, 0x86, 0xc3, 0x6e, 0xe9, 0x09, 0x8d, 0x05, 0xad, 0x60, 0x30, 0x20, 0xd5, 0x81,
+	0x53, 0x34, 0xd4, 0xfa, 0x21, 0xbb, 0x08, 0x1b, 0x6e, 0x5e, 0x8b, 0xdc, 0x4d, 0x1b, 0x9d, 0x57,
+	0xe1, 0xf8, 0xa8, 0x96, 0x7b, 0x22, 0xf1, 0x77, 0x6d, 0xea, 0x55, 0x98, 0x09, 0x0f, 0xda, 0x2b,
+	0xc6, 0x6b, 0xc3, 0xcc, 0x6d, 0xab, 0xdd, 0xd1, 0x6d, 0xf4, 0x62, 0x08, 0x55, 0x23, 0x84, 0x46,
+	0xae, 0x04, 0xd5, 0x0b, 0x30, 0x1b, 0x19, 0x93, 0xd1, 0x39, 0x0e, 0x19, 0x6b, 0x97, 0x31, 0x33,
+	0x63, 0xed, 0xe2, 0x3c, 0xe6, 0xb3, 0x1f, 0x23, 0xb3, 0xb9, 0x1e, 0x1c, 0x66, 0x1b, 0xe4, 0xcd,
+	0xc6, 0x70, 0x54, 0x06, 0x01, 0xff, 0xcc, 0x50, 0x01, 0x7f, 0xf5, 0xd7, 0x25, 0x98, 0x4b, 0xa0,
+	0xaf, 0x07, 0xe7, 0x83, 0x28, 0x41, 0x86, 0x8f, 0x8f, 0x87, 0xb6, 0xc0, 0xdc, 0x40, 0x9e, 0xd5,
+	0x1a, 0xd4, 0x6e, 0x5b, 0xe6, 0x96, 0x61, 0xb7, 0x13, 0xf9, 0x55, 0xc3, 0x8a, 0xbb, 0x8b, 0xd8,
+	0xe3, 0x90, 0xb5, 0xe2, 0xf1, 0x51, 0x2d, 0xff, 0x44, 0xda, 0xff, 0x81, 0xa4, 0xd1, 0x7a, 0xf5,
+	0x23, 0x58, 0x48, 0xc6, 0x71, 0xa2, 0x39, 0xe1, 0x2c, 0x14, 0xcc, 0xa3, 0x0f, 0x71, 0xa4, 0xe3,
+	0xb6, 0xd5, 0x44, 0xc3, 0x6d, 0x2d, 0xbf, 0x2d, 0xc1, 0xa9, 0x10, 0xba, 0x5e, 0x64, 0x85, 0x43,
+	0x2f, 0x99, 0xe8, 0xa5, 0xc3, 0x50, 0x1e, 0x6d, 0x0b, 0x66, 0x68, 0xf4, 0xfa, 0xc5, 0x4c, 0x51,
+	0x3e, 0x0b, 0xb9, 0x86, 0xd5, 0x44, 0x21, 0x4d, 0x9f, 0xd4, 0x48, 0xad, 0xfa, 0x18, 0x66, 0x23,
+	0xa3, 0x0d, 0xcf, 0x01, 0xf5, 0x6f, 0x33, 0x30, 0x72, 0xcf, 0xdc, 0x33, 0x5c, 0x6a, 0xd9, 0xc8,
+	0x57, 0x80, 0xa8, 0x40, 0x2b, 0xc2, 0xde, 0x4e, 0xec, 0xe2, 0x0b, 0x17, 0x3e, 0xbc, 0xdf, 0x94,
+	0x13, 0xfd, 0xa6, 0x20, 0xdc, 0x95, 0x17, 0xae, 0x71, 0x42, 0x6b, 0x31, 0x32, 0x8c, 0x1b, 0x38,
+	0x3a, 0xe8, 0x85, 0x0e, 0x7f, 0x27, 0x53, 0x18, 0xe4, 0x4e, 0x46, 0xfd, 0xe7, 0x2c, 0x4c, 0x52,
+	0x06, 0xf2, 0xf1, 0xe4, 0x0d, 0x8f, 0x2b, 0x74, 0xfd, 0x6f, 0x1e, 0x1f, 0xd5, 0x5e, 0x83, 0x95,
+	0x67, 0x03, 0xdd, 0x1e, 0x0b, 0x77, 0xc7, 0x85, 0xd0, 0x49, 0xbc, 0xbf, 0x0d, 0xee, 0x2a, 0x8c,
+	0x10, 0x26, 0x1d, 0x30, 0xd1, 0x3e, 0x1d, 0x99, 0xd4, 0xfb, 0xec, 0x35, 0x9c, 0xc6, 0x00, 0x4f,
+	0x9e, 0x16, 0x12, 0x58, 0xd7, 0xfc, 0x70, 0xd7, 0xa9, 0x69, 0xb9, 0x23, 0x11, 0x4e, 0x47, 0x2f,
+	0xf7, 0x86, 0xb8, 0xbe, 0xf8, 0x35, 0x09, 0x64, 0x7e, 0x04, 0x6e, 0xcb, 0x1f, 0x5c, 0x31, 0x86,
+	0xb2, 0xe9, 0xbf, 0x21, 0xc1, 0xd4, 0x6a, 0xa3, 0x81, 0x3a, 0x2e, 0xa5, 0xa7, 0x5f, 0x43, 0x3e,
+	0x78, 0xa2, 0x03, 0xef, 0x63, 0x64, 0x13, 0x7d, 0x8c, 0x15, 0x98, 0x16, 0xc9, 0xe9, 0xe5, 0x61,
+	0xfc, 0x5e, 0x86, 0x66, 0x7e, 0x51, 0x78, 0xfe, 0x3c, 0xe5, 0x5f, 0x29, 0x48, 0x89, 0x57, 0x0a,
+	0x99, 0xa4, 0x2b, 0x85, 0x3e, 0x0e, 0x54, 0xf2, 0x2d, 0x7e, 0xb5, 0xf2, 0xbd, 0x95, 0x25, 0x58,
+	0x4a, 0xce, 0x6c, 0x8f, 0xf4, 0x7f, 0x27, 0xe0, 0x1b, 0xc0, 0x51, 0x3e, 0xee, 0x1f, 0x58, 0xb9,
+	0x02, 0x1f, 0xd4, 0x57, 0x9f, 0xc1, 0x94, 0xc0, 0x96, 0x54, 0xcf, 0xfa, 0x2a, 0x00, 0x9b, 0x4a,
+	0xe0, 0x5b, 0xcb, 0x51, 0x75, 0xd0, 0xd8, 0x84, 0xb1, 0x53, 0xfd, 0x4b, 0x30, 0xa5, 0xa1, 0x3d,
+	0x6b, 0x17, 0x89, 0x72, 0x73, 0x2b, 0x22, 0xc2, 0x7d, 0x32, 0x45, 0x7d, 0x0d, 0xa6, 0x45, 0x84,
+	0x7d, 0x28, 0x85, 0x8a, 0x60, 0xea, 0x71, 0xa7, 0x65, 0xe9, 0xcd, 0x55, 0x72, 0x07, 0x3a, 0xdc,
+	0xbe, 0x88, 0xdf, 0x07, 0x5a, 0xa6, 0x8b, 0x4c, 0x7a, 0x84, 0x19, 0xd3, 0xbc, 0xa2, 0xfa, 0x5b,
+	0x12, 0x4c, 0x8b, 0xe3, 0xf4, 0xb1, 0x23, 0xb2, 0xce, 0x75, 0xf7, 0xa0, 0xe3, 0x99, 0x80, 0x12,
+	0xab, 0x7b, 0x74, 0xd0, 0x89, 0x5c, 0xef, 0x67, 0x07, 0xb9, 0xde, 0x57, 0xef, 0x92, 0x1c, 0xf1,
+	0x17, 0x30, 0x6b, 0xf5, 0x2f, 0x25, 0x98, 0xe4, 0x50, 0xf5, 0x9a, 0x58, 0x22, 0x93, 0x22, 0x53,
+	0xce, 0xf6, 0x9c, 0x72, 0x6e, 0xa0, 0x29, 0xff, 0x79, 0x06, 0x8a, 0xfe, 0x05, 0x12, 0x1e, 0x2d,
+	0xb8, 0x71, 0xf2, 0xa9, 0x2c, 0xf9, 0x75, 0xd4, 0x01, 0x70, 0x75, 0x7b, 0x9b, 0x1d, 0x7c, 0x8b,
+	0x1a, 0x2b, 0xe1, 0x13, 0x69, 0xf0, 0x3c, 0x4d, 0x23, 0xdf, 0xb8, 0x8e, 0x10, 0x4d, 0x23, 0x46,
+	0xe4, 0x1b, 0x5f, 0xc2, 0xd9, 0xe8, 0xab, 0xae, 0x61, 0xa3, 0x26, 0x7b, 0xb9, 0xeb, 0x97, 0x31,
+	0xee, 0xae, 0x69, 0x7c, 0xd5, 0xa5, 0xfe, 0x43, 0x41, 0x63, 0x25, 0x1c, 0xce, 0x42, 0x66, 0xb7,
+	0x5d, 0xa7, 0x86, 0x9f, 0x6a, 0x6a, 0x11, 0xd7, 0x90, 0x6b, 0xb3, 0x70, 0xf2, 0x49, 0x21, 0x9a,
+	0x7c, 0x12, 0x72, 0x30, 0x8a, 0x03, 0xc5, 0x99, 0xfe, 0x50, 0x82, 0x89, 0xd0, 0x1d, 0x9b, 0x7c,
+	0x93, 0xcd, 0x96, 0x8a, 0xc4, 0xf9, 0xe3, 0xa3, 0x5a, 0x0d, 0xe6, 0x3c, 0x91, 0xa8, 0x73, 0x5b,
+	0x64, 0xfd, 0xe9, 0x37, 0x57, 0x2e, 0x5d, 0xff, 0xce, 0xb7, 0x8b, 0x8c, 0x25, 0x37, 0x21, 0x63,
+	0x75, 0x98, 0x6d, 0x7f, 0xe5, 0xf8, 0xa8, 0x76, 0x1e, 0xce, 0x3d, 0x5b, 0x42, 0x5f, 0x1d, 0x9a,
+	0xe8, 0x70, 0xdb, 0x3d, 0xdc, 0x76, 0xd1, 0x61, 0xcb, 0x3d, 0x6c, 0xb9, 0xe8, 0x10, 0xaf, 0xb0,
+	0x6e, 0x98, 0x0e, 0xde, 0x5b, 0x33, 0x56, 0x27, 0xd8, 0xf7, 0xb2, 0xdc, 0xbe, 0xa7, 0xfe, 0x24,
+	0x03, 0x33, 0x34, 0x27, 0xd0, 0xa7, 0xd0, 0x13, 0xdc, 0x4b, 0xfe, 0x42, 0x49, 0x7c, 0xf6, 0xd6,
+	0xb3, 0x25, 0x2c, 0x73, 0x87, 0xc4, 0xc7, 0xb8, 0xb0, 0xe8, 0x2f, 0x9f, 0x37, 0xa1, 0xcc, 0xa0,
+	0x13, 0xba, 0xcd, 0xd6, 0x38, 0x2b, 0xba, 0x0d, 0x8e, 0x8b, 0xc3, 0xa0, 0x87, 0x86, 0xe9, 0x1e,
+	0x6e, 0x5a, 0x56, 0xeb, 0x10, 0xaf, 0xd6, 0x21, 0x96, 0xba, 0x43, 0xda, 0x52, 0x6f, 0x19, 0x8e,
+	0x7b, 0x61, 0x31, 0x46, 0x28, 0x72, 0x89, 0x42, 0x91, 0x17, 0x84, 0xe2, 0x75, 0x41, 0x28, 0xa8,
+	0xc9, 0x9f, 0x39, 0x3e, 0xaa, 0xc9, 0x5c, 0x86, 0x1a, 0x49, 0xbc, 0xc6, 0x8f, 0xd7, 0x03, 0x61,
+	0x09, 0x79, 0x48, 0xa3, 0xfd, 0x7a, 0x48, 0xea, 0x5b, 0x30, 0x1b, 0xe1, 0x34, 0xd3, 0xeb, 0xde,
+	0x6a, 0xa3, 0xfe, 0xa7, 0x04, 0xa7, 0xf0, 0xd6, 0xe1, 0x77, 0xfe, 0x59, 0x6e, 0xaa, 0xef, 0x84,
+	0xe8, 0xeb, 0x63, 0x5f, 0x4d, 0xd0, 0xf9, 0x11, 0xba, 0x1d, 0x86, 0x74, 0x9e, 0x6a, 0x24, 0xf9,
+	0x56, 0xbf, 0x84, 0x99, 0xf0, 0x3c, 0x53, 0x77, 0xc9, 0x37, 0xa0, 0x1c, 0xd0, 0x16, 0x6c, 0x94,
+	0xa7, 0x62, 0x6f, 0xb8, 0xb5, 0x60, 0x1e, 0x78, 0xbb, 0xfc, 0x1c, 0x66, 0x69, 0x4e, 0x6a, 0x94,
+	0xab, 0xef, 0x45, 0x96, 0xa4, 0x8f, 0xab, 0x12, 0x61, 0xc5, 0xde, 0x86, 0x6a, 0x14, 0x79, 0xe2,
+	0x82, 0x67, 0xc3, 0x0b, 0xfe, 0xef, 0x12, 0xc0, 0x9a, 0xee, 0x36, 0x76, 0xd6, 0x6d, 0xdb, 0xb2,
+	0x31, 0xab, 0xc8, 0xf1, 0x90, 0xae, 0x30, 0xf9, 0xe6, 0x42, 0xea, 0x19, 0x21, 0xa4, 0x5e, 0x85,
+	0xd1, 0x36, 0x72, 0x1c, 0x7d, 0xdb, 0x53, 0x76, 0xaf, 0x28, 0xbf, 0x07, 0x85, 0x36, 0x72, 0xf5,
+	0xa6, 0xee, 0x7a, 0xff, 0x50, 0x58, 0xe4, 0xf9, 0x14, 0x8c, 0xb7, 0xbc, 0xc1, 0xc0, 0xa8, 0x6f,
+	0xed, 0xf7, 0x52, 0xde, 0x84, 0xb2, 0xd0, 0x34, 0x90, 0x87, 0xad, 0x43, 0x89, 0x0c, 0xa1, 0x21,
+	0xa7, 0xdb, 0x22, 0xc2, 0x66, 0x98, 0x4d, 0xb4, 0xef, 0x2d, 0x28, 0x29, 0xb0, 0x67, 0xeb, 0x19,
+	0xff, 0xd9, 0xfa, 0x25, 0xc8, 0x23, 0x4c, 0x12, 0xdb, 0x8b, 0x67, 0xe2, 0x09, 0xd6, 0x28, 0x90,
+	0x6a, 0xc1, 0x2c, 0xa9, 0x0c, 0x12, 0x9d, 0xfd, 0x25, 0x7d, 0xcb, 0x8f, 0x98, 0x46, 0x82, 0xe5,
+	0x91, 0xa4, 0x74, 0xea, 0x5b, 0x7f, 0x21, 0xed, 0xfc, 0x64, 0x34, 0x88, 0xa4, 0xea, 0xae, 0xd5,
+	0x36, 0x1a, 0x5e, 0x24, 0x95, 0x96, 0x54, 0x0d, 0xaa, 0xd1, 0x01, 0xd9, 0x32, 0xdf, 0x00, 0xb0,
+	0xc9, 0x54, 0xb9, 0x8b, 0xad, 0xd9, 0x08, 0xfd, 0x94, 0x1b, 0x5a, 0x91, 0x82, 0x62, 0xb9, 0xf4,
+	0x26, 0x11, 0x24, 0x6e, 0xf6, 0x33, 0x89, 0x48, 0x56, 0xef, 0xe0, 0x93, 0x10, 0x06, 0x1c, 0x72,
+	0x12, 0x8e, 0xc0, 0x18, 0xf1, 0x82, 0xe1, 0xdd, 0xe0, 0x62, 0x00, 0xa3, 0x9b, 0x4f, 0xff, 0x91,
+	0x07, 0x3f, 0x8f, 0xe0, 0xc2, 0x20, 0x76, 0x22, 0x1f, 0xc3, 0xe9, 0x98, 0x41, 0x87, 0x9c, 0xc9,
+	0x7f, 0x91, 0x3b, 0x32, 0x7b, 0x5b, 0x14, 0xa7, 0x55, 0x18, 0x77, 0xac, 0xae, 0xdd, 0x40, 0xf5,
+	0x01, 0xfc, 0xbb, 0x31, 0xda, 0xe5, 0x31, 0x75, 0xdb, 0x56, 0x61, 0x9c, 0x5a, 0xc2, 0xba, 0x70,
+	0x88, 0xec, 0x81, 0x82, 0x76, 0x61, 0x28, 0x1e, 0xc1, 0x64, 0xc3, 0x32, 0xb7, 0x5a, 0x46, 0xc3,
+	0xad, 0x3b, 0xae, 0xad, 0xbb, 0x68, 0xfb, 0xa0, 0x9a, 0x15, 0xdd, 0x83, 0x5d, 0x84, 0x3a, 0x75,
+	0xda, 0xeb, 0x90, 0x7c, 0x53, 0x22, 0x0e, 0xb7, 0x74, 0xa3, 0x85, 0xdd, 0x83, 0x8a, 0x87, 0xe1,
+	0x63, 0x86, 0x40, 0xfd, 0x06, 0x64, 0x7e, 0xc2, 0x7e, 0xe2, 0x5a, 0xec, 0x8c, 0x43, 0x93, 0x5a,
+	0x8c, 0x9f, 0x54, 0x88, 0x6e, 0x3e, 0x44, 0x94, 0x15, 0x42, 0x44, 0xea, 0x5f, 0x48, 0x30, 0xc1,
+	0xe7, 0x0c, 0xec, 0x18, 0x9d, 0xbe, 0xde, 0x7a, 0x73, 0x19, 0xc9, 0xdc, 0x5b, 0xa8, 0x1e, 0xef,
+	0xa9, 0x6f, 0x42, 0xf1, 0x4b, 0xcb, 0xcb, 0xc7, 0xec, 0xed, 0xfa, 0x16, 0x30, 0x30, 0x2e, 0xaa,
+	0x7f, 0x90, 0x81, 0xfc, 0x23, 0x72, 0xe8, 0x0e, 0xff, 0x67, 0x43, 0x86, 0xdc, 0xae, 0x61, 0x7a,
+	0xd3, 0x26, 0xdf, 0xdc, 0x0e, 0x98, 0x15, 0xbc, 0x5e, 0x05, 0x0a, 0xba, 0xeb, 0xa2, 0x76, 0xc7,
+	0x75, 0xd8, 0x96, 0xeb, 0x97, 0xc3, 0x4e, 0x67, 0x7e, 0x98, 0xff, 0x58, 0x0c, 0x16, 0x4f, 0x7b,
+	0x9b, 0x1c, 0x1a, 0x9c, 0x6e, 0xbb, 0xef, 0x80, 0x5a, 0x89, 0xc1, 0x13, 0xd6, 0xfc, 0x4e, 0x06,
+	0xc6, 0x1e, 0x5a, 0xae, 0x1f, 0x43, 0xc6, 0x6f, 0xa8, 0x4d, 0xae, 0x1c, 0x2c, 0xe2, 0x38, 0x5f,
+	0x7d, 0x2f, 0x9e, 0x75, 0x67, 0xa1, 0x68, 0xa3, 0x86, 0xd1, 0x31, 0x90, 0xe9, 0x71, 0x2f, 0xa8,
+	0xc0, 0x7b, 0x9d, 0xd3, 0xdd, 0xfc, 0x12, 0xdf, 0x70, 0xe5, 0x98, 0xf3, 0x43, 0x8b, 0x69, 0x91,
+	0xc6, 0x13, 0xdf, 0x19, 0x63, 0x71, 0x71, 0x90, 0xd9, 0xec, 0x97, 0x2d, 0x05, 0x0c, 0x4c, 0x78,
+	0xf2, 0xe3, 0x2c, 0x14, 0xb0, 0xe8, 0xbf, 0xaf, 0xbb, 0x3a, 0x5b, 0x1c, 0xec, 0xb4, 0x11, 0x3c,
+	0x52, 0x5f, 0x8b, 0x63, 0xd9, 0x2e, 0x21, 0xc1, 0xbb, 0xf8, 0xcb, 0xa4, 0x5e, 0xfc, 0x6d, 0xc0,
+	0x34, 0xff, 0x3f, 0x19, 0x92, 0x0b, 0x13, 0x24, 0xcb, 0x9e, 0x49, 0xf8, 0xa7, 0x0c, 0x06, 0xd3,
+	0xe4, 0x6d, 0xb1, 0x02, 0xa7, 0xdc, 0xbe, 0x0f, 0x93, 0x34, 0x6f, 0x79, 0xc7, 0x70, 0x5c, 0xcb,
+	0x3e, 0xe0, 0xfe, 0x4f, 0x53, 0xe5, 0x71, 0x91, 0x54, 0xe5, 0xbb, 0x14, 0x46, 0x9b, 0x68, 0x71,
+	0x25, 0x8c, 0x65, 0x19, 0x8a, 0x24, 0x4e, 0x45, 0x7a, 0xe7, 0xa3, 0x89, 0xbe, 0x44, 0x9f, 0xb4,
+	0x02, 0x81, 0xc1, 0xf0, 0x62, 0x04, 0x64, 0xa4, 0x8f, 0x08, 0x88, 0x7c, 0x1b, 0x2a, 0x82, 0xa8,
+	0xe1, 0x8e, 0xa3, 0x51, 0x3a, 0x79, 0xf1, 0xd4, 0x04, 0xe1, 0xc4, 0x06, 0x7f, 0x03, 0x4e, 0xad,
+	0x13, 0x86, 0x7b, 0x2b, 0x36, 0xdc, 0x61, 0x7e, 0x1d, 0x66, 0xc2, 0xe8, 0x7a, 0x1d, 0xe8, 0x65,
+	0xc8, 0x11, 0x27, 0x8d, 0x29, 0x02, 0xfe, 0xc6, 0x57, 0x2a, 0xab, 0x5e, 0xa6, 0xf6, 0xd0, 0xd9,
+	0xcb, 0xea, 0x15, 0x38, 0x15, 0xc2, 0xd6, 0x2b, 0xa8, 0xf7, 0x1f, 0x12, 0x8c, 0xf1, 0xeb, 0x8b,
+	0x8d, 0x32, 0x15, 0x8a, 0xc0, 0x28, 0x93, 0x72, 0x5a, 0x5c, 0x94, 0xe8, 0x6b, 0xa3, 0x81, 0x1c,
+	0xc7, 0x4b, 0x1d, 0x66, 0x45, 0x1c, 0x52, 0x6a, 0xb4, 0xb0, 0x4e, 0xd7, 0x8d, 0x0e, 0xd3, 0xe5,
+	0x02, 0xad, 0xb8, 0xd7, 0xc1, 0x56, 0x9c, 0xe0, 0xd3, 0xb7, 0x11, 0x7b, 0x18, 0x5d, 0xd4, 0x8a,
+	0xb8, 0x66, 0x15, 0x57, 0x0c, 0x97, 0x07, 0xf2, 0xa3, 0x0c, 0xcc, 0xe2, 0x23, 0x87, 0x20, 0xbb,
+	0x3f, 0xbb, 0xc3, 0x15, 0xb7, 0x7c, 0xf9, 0xfe, 0xc3, 0x62, 0xdf, 0x01, 0x7c, 0x0d, 0x61, 0xbb,
+	0xfd, 0x4e, 0xb8, 0x48, 0xa0, 0x71, 0x19, 0x3f, 0x89, 0x1f, 0xc0, 0x84, 0x8d, 0x7a, 0x16, 0x6c,
+	0x0f, 0xaa, 0x51, 0x2e, 0xa5, 0x1e, 0xcd, 0x62, 0x8d, 0x46, 0x66, 0x40, 0xa3, 0x71, 0xed, 0x7f,
+	0x54, 0x98, 0xb8, 0xd7, 0x44, 0xa6, 0x6b, 0xb8, 0x07, 0x1b, 0xba, 0xa9, 0x6f, 0x23, 0x5b, 0xbe,
+	0x0f, 0x10, 0xfc, 0x06, 0x4f, 0x16, 0xbc, 0xe0, 0xc8, 0x3f, 0xf3, 0x94, 0xf9, 0xa4, 0x66, 0x46,
+	0xfc, 0x43, 0x28, 0x71, 0xee, 0xa2, 0xdc, 0xc3, 0x19, 0x55, 0x6a, 0x89, 0xed, 0x0c, 0xdf, 0x47,
+	0x30, 0xc6, 0xff, 0x55, 0x4c, 0x16, 0x3a, 0xc4, 0xfc, 0x3c, 0x4d, 0x59, 0x48, 0x06, 0x08, 0x48,
+	0xe4, 0xfe, 0xe3, 0x23, 0x92, 0x18, 0xfd, 0xa9, 0x92, 0x52, 0x4b, 0x6c, 0x67, 0xf8, 0xee, 0x42,
+	0xd1, 0xff, 0x9f, 0x8e, 0x7c, 0x56, 0x84, 0x16, 0xf3, 0x82, 0x95, 0xb9, 0x84, 0x56, 0x86, 0x69,
+	0x1d, 0x0a, 0xde, 0xdf, 0x06, 0xe4, 0x33, 0x21, 0x46, 0x0b, 0x78, 0xce, 0xc6, 0x37, 0x32, 0x34,
+	0xdf, 0x03, 0xd9, 0xab, 0x0b, 0x7e, 0x90, 0x21, 0xbf, 0x14, 0xd7, 0x27, 0xf2, 0x03, 0x8d, 0x1e,
+	0xa8, 0x1f, 0x07, 0x3f, 0xee, 0xf0, 0xff, 0x67, 0x92, 0x4a, 0xe9, 0x62, 0x5c, 0x63, 0xe4, 0x85,
+	0xf9, 0x47, 0x30, 0xc6, 0xff, 0x66, 0x41, 0x5c, 0xe5, 0x98, 0x3f, 0x6a, 0x28, 0x0b, 0xc9, 0x00,
+	0x0c, 0xe5, 0x17, 0x30, 0x19, 0x39, 0xbc, 0xc8, 0xd1, 0x03, 0x7a, 0xcc, 0x81, 0x4a, 0x79, 0xa9,
+	0x07, 0x14, 0x1b, 0xe1, 0x3e, 0x40, 0xf0, 0x68, 0x5e, 0xd4, 0x9b, 0xc8, 0x4f, 0x1e, 0x94, 0xf9,
+	0xa4, 0x66, 0x86, 0xec, 0x73, 0xfe, 0x75, 0xbf, 0xcf, 0xda, 0x1e, 0x48, 0x5f, 0x8e, 0x6f, 0x8e,
+	0xb0, 0xf7, 0x3e, 0x40, 0x70, 0xa2, 0x96, 0xd3, 0x0f, 0xeb, 0xca, 0x7c, 0x52, 0x73, 0xa0, 0x3e,
+	0xdc, 0xdb, 0x63, 0x51, 0x7d, 0xa2, 0x4f, 0x9c, 0x95, 0x5a, 0x62, 0x7b, 0x40, 0x5c, 0x70, 0x52,
+	0x96, 0xd3, 0x0f, 0xe1, 0xca, 0x7c, 0x52, 0x33, 0x43, 0xb6, 0x06, 0xa3, 0xec, 0xa1, 0x8f, 0xac,
+	0x84, 0x44, 0x84, 0x47, 0x73, 0x26, 0xb6, 0x8d, 0xe1, 0x78, 0x04, 0x15, 0x56, 0x15, 0xbc, 0xbd,
+	0x4a, 0x43, 0xb6, 0x18, 0xd3, 0x16, 0x7d, 0x81, 0xf1, 0x14, 0x2a, 0xe1, 0xd0, 0x86, 0x7c, 0x3e,
+	0x41, 0xd0, 0x04, 0x06, 0x2e, 0xa6, 0x03, 0x85, 0xd0, 0x07, 0x3c, 0x89, 0x43, 0x1f, 0x8d, 0x81,
+	0x28, 0x8b, 0xe9, 0x40, 0x81, 0x8d, 0xf3, 0x5f, 0x97, 0x88, 0x36, 0x2e, 0xfc, 0x16, 0x4a, 0x99,
+	0x4b, 0x68, 0x65, 0x98, 0xd8, 0x6f, 0x2c, 0xc4, 0x77, 0x2a, 0x3d, 0x50, 0xbe, 0x1c, 0xdb, 0x1a,
+	0xe5, 0xf1, 0x5d, 0x28, 0xfa, 0xaf, 0x3a, 0x44, 0x94, 0xe1, 0xf7, 0x2a, 0xca, 0x5c, 0x42, 0x2b,
+	0xa7, 0xdb, 0xfe, 0x73, 0x8a, 0x90, 0x1a, 0x86, 0x5f, 0x7b, 0x28, 0xf3, 0x49, 0xcd, 0xfe, 0x94,
+	0x27, 0x42, 0xef, 0x16, 0x64, 0x55, 0x88, 0xa8, 0xc6, 0xbe, 0xb6, 0x50, 0xce, 0xa7, 0xc2, 0x30,
+	0xdc, 0x0d, 0x90, 0xa3, 0x0f, 0x03, 0x44, 0x5b, 0x9f, 0xf8, 0xa8, 0x41, 0x79, 0xb9, 0x17, 0x58,
+	0x20, 0x5c, 0xe1, 0x84, 0x73, 0x51, 0xb8, 0x12, 0x12, 0xeb, 0x95, 0xc5, 0x74, 0x20, 0x86, 0xde,
+	0x86, 0xd9, 0x84, 0x74, 0x6d, 0xf9, 0xd5, 0xf0, 0xca, 0x27, 0x27, 0xa6, 0x2b, 0x17, 0xfb, 0x82,
+	0x65, 0x63, 0x5a, 0x34, 0x32, 0x1e, 0xcd, 0x15, 0x96, 0x2f, 0x84, 0xd1, 0x24, 0xa6, 0x34, 0x2b,
+	0xaf, 0xf6, 0x03, 0x1a, 0x98, 0x4d, 0xee, 0x65, 0x83, 0x68, 0x36, 0xa3, 0x0f, 0x2f, 0x94, 0x5a,
+	0x62, 0x3b, 0xc3, 0xb7, 0x45, 0x6f, 0xbf, 0x43, 0x99, 0xbb, 0x72, 0x44, 0x55, 0xe2, 0xb3, 0x92,
+	0x95, 0x57, 0x7a, 0xc2, 0xb1, 0x71, 0x0c, 0x98, 0x8e, 0x4b, 0x64, 0x95, 0x93, 0x11, 0x84, 0x84,
+	0x6c, 0xa9, 0x37, 0x60, 0xe0, 0xfe, 0x78, 0x39, 0xa2, 0xa2, 0x53, 0x11, 0x4a, 0x6d, 0x55, 0xce,
+	0xc6, 0x37, 0x06, 0xea, 0x16, 0xca, 0x8e, 0x14, 0xd5, 0x2d, 0x3e, 0x5d, 0x53, 0x39, 0x9f, 0x0a,
+	0xc3, 0x70, 0x7f, 0x0a, 0xe3, 0x62, 0x82, 0xa8, 0x7c, 0x2e, 0xba, 0x23, 0x85, 0x31, 0xab, 0x69,
+	0x20, 0xdc, 0x2e, 0xe8, 0x07, 0x09, 0x43, 0xbb, 0x60, 0x38, 0x5a, 0xaa, 0xcc, 0x27, 0x35, 0x07,
+	0x54, 0x8a, 0x67, 0x64, 0x91, 0xca, 0xd8, 0xe3, 0xb8, 0xa2, 0xa6, 0x81, 0xf8, 0x5b, 0x63, 0x59,
+	0x38, 0xe7, 0xca, 0x82, 0x1f, 0x16, 0x77, 0xa0, 0x56, 0xce, 0xa5, 0x40, 0x30, 0xac, 0x2d, 0x9a,
+	0x8f, 0x18, 0x49, 0x97, 0x94, 0x05, 0xd1, 0x49, 0xcb, 0x62, 0x55, 0x2e, 0xf4, 0x01, 0xc9, 0x46,
+	0xeb, 0x42, 0x35, 0x29, 0x3f, 0x53, 0xbe, 0x28, 0xca, 0x40, 0x6a, 0x26, 0xa8, 0x72, 0xa9, 0x3f,
+	0xe0, 0x80, 0x75, 0x42, 0xd2, 0xa5, 0xc8, 0xba, 0xb8, 0xf4, 0x4e, 0xe5, 0x5c, 0x0a, 0x44, 0x20,
+	0xeb, 0xa1, 0x54, 0x46, 0x51, 0xd6, 0xe3, 0xb3, 0x2a, 0x95, 0xf3, 0xa9, 0x30, 0x81, 0x48, 0x06,
+	0x19, 0x5c, 0xa2, 0x48, 0x46, 0x72, 0xc7, 0x94, 0xf9, 0xa4, 0xe6, 0xc0, 0xc3, 0xe7, 0xb3, 0x9e,
+	0x44, 0x0f, 0x3f, 0x26, 0x3d, 0x4b, 0x59, 0x48, 0x06, 0x08, 0x2c, 0x2a, 0x97, 0xff, 0x23, 0x47,
+	0x3c, 0x6c, 0x31, 0x5f, 0x4a, 0xa9, 0x25, 0xb6, 0x07, 0x24, 0xf2, 0xe9, 0x39, 0x22, 0x89, 0x31,
+	0x99, 0x40, 0xca, 0x42, 0x32, 0x40, 0x80, 0x92, 0x4f, 0xaa, 0x11, 0x51, 0xc6, 0xa4, 0xf5, 0x28,
+	0x0b, 0xc9, 0x00, 0x81, 0x8f, 0xe3, 0xe7, 0xb2, 0xc8, 0xe1, 0xc3, 0x9a, 0x88, 0x6c, 0x2e, 0xa1,
+	0x95, 0xb3, 0x93, 0xe2, 0x1d, 0x7a, 0xc8, 0x4e, 0xc6, 0xa6, 0x32, 0x28, 0xe7, 0x53, 0x61, 0x02,
+	0x0b, 0x24, 0x5e, 0x3c, 0x8b, 0x16, 0x28, 0xf6, 0xf2, 0x5d, 0x51, 0xd3, 0x40, 0x02, 0x57, 0x24,
+	0x7c, 0x11, 0x2c, 0xba, 0x22, 0x09, 0x77, 0xd0, 0xca, 0x62, 0x3a, 0x50, 0x80, 0x3e, 0x1c, 0x97,
+	0x11, 0xd1, 0x27, 0xc4, 0xb6, 0x94, 0xc5, 0x74, 0x20, 0x8a, 0x7e, 0x2d, 0xf7, 0x59, 0xa6, 0xb3,
+	0xb9, 0x39, 0x42, 0x22, 0x43, 0xaf, 0xfd, 0xef, 0x00, 0x50, 0x31, 0x73, 0xaf, 0x84, 0x61, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// IdentityManagerClient is the client API for IdentityManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type IdentityManagerClient interface {
+	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
+	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
+	DeleteGroups(ctx context.Context, in *DeleteGroupsRequest, opts ...grpc.CallOption) (*DeleteGroupsResponse, error)
+	ModifyGroup(ctx context.Context, in *ModifyGroupRequest, opts ...grpc.CallOption) (*ModifyGroupResponse, error)
+	MoveGroup(ctx context.Context, in *MoveGroupRequest, opts ...grpc.CallOption) (*MoveGroupResponse, error)
+	GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupResponse, error)
+	GetGroupByNamePath(ctx context.Context, in *GetGroupByNamePathRequest, opts ...grpc.CallOption) (*GetGroupResponse, error)
+	GetGroupWithUser(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupWithUserResponse, error)
+	GetGroupTree(ctx context.Context, in *GetGroupTreeRequest, opts ...grpc.CallOption) (*GetGroupTreeResponse, error)
+	BatchCreateGroups(ctx context.Context, in *BatchCreateGroupsRequest, opts ...grpc.CallOption) (*BatchCreateGroupsResponse, error)
+	ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error)
+	ListGroupsWithUser(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsWithUserResponse, error)
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
+	DeleteUsers(ctx context.Context, in *DeleteUsersRequest, opts ...grpc.CallOption) (*DeleteUsersResponse, error)
+	ModifyUser(ctx context.Context, in *ModifyUserRequest, opts ...grpc.CallOption) (*ModifyUserResponse, error)
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	GetUserWithGroup(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserWithGroupResponse, error)
+	BatchCreateUsers(ctx context.Context, in *BatchCreateUsersRequest, opts ...grpc.CallOption) (*BatchCreateUsersResponse, error)
+	BatchModifyUsers(ctx context.Context, in *BatchModifyUsersRequest, opts ...grpc.CallOption) (*BatchModifyUsersResponse, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	ListUsersWithGroup(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersWithGroupResponse, error)
+	JoinGroup(ctx context.Context, in *JoinGroupRequest, opts ...grpc.CallOption) (*JoinGroupResponse, error)
+	LeaveGroup(ctx context.Context, in *LeaveGroupRequest, opts ...grpc.CallOption) (*LeaveGroupResponse, error)
+	AddGroupMembers(ctx context.Context, in *AddGroupMembersRequest, opts ...grpc.CallOption) (*AddGroupMembersResponse, error)
+	RemoveGroupMembers(ctx context.Context, in *RemoveGroupMembersRequest, opts ...grpc.CallOption) (*RemoveGroupMembersResponse, error)
+	ExtendMembership(ctx context.Context, in *ExtendMembershipRequest, opts ...grpc.CallOption) (*ExtendMembershipResponse, error)
+	ListExpiringMemberships(ctx context.Context, in *ListExpiringMembershipsRequest, opts ...grpc.CallOption) (*ListExpiringMembershipsResponse, error)
+	ListMembershipRemovals(ctx context.Context, in *ListMembershipRemovalsRequest, opts ...grpc.CallOption) (*ListMembershipRemovalsResponse, error)
+	PreviewRule(ctx context.Context, in *PreviewRuleRequest, opts ...grpc.CallOption) (*PreviewRuleResponse, error)
+	ListEffectiveGroups(ctx context.Context, in *ListEffectiveGroupsRequest, opts ...grpc.CallOption) (*ListEffectiveGroupsResponse, error)
+	ListEffectiveMembers(ctx context.Context, in *ListEffectiveMembersRequest, opts ...grpc.CallOption) (*ListEffectiveMembersResponse, error)
+	IsMember(ctx context.Context, in *IsMemberRequest, opts ...grpc.CallOption) (*IsMemberResponse, error)
+	ComparePassword(ctx context.Context, in *ComparePasswordRequest, opts ...grpc.CallOption) (*ComparePasswordResponse, error)
+	ModifyPassword(ctx context.Context, in *ModifyPasswordRequest, opts ...grpc.CallOption) (*ModifyPasswordResponse, error)
+	MergeUsers(ctx context.Context, in *MergeUsersRequest, opts ...grpc.CallOption) (*MergeUsersResponse, error)
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error)
+	AnonymizeUser(ctx context.Context, in *AnonymizeUserRequest, opts ...grpc.CallOption) (*AnonymizeUserResponse, error)
+	SendEmailVerification(ctx context.Context, in *SendEmailVerificationRequest, opts ...grpc.CallOption) (*SendEmailVerificationResponse, error)
+	ConfirmEmailVerification(ctx context.Context, in *ConfirmEmailVerificationRequest, opts ...grpc.CallOption) (*ConfirmEmailVerificationResponse, error)
+	SendPhoneCode(ctx context.Context, in *SendPhoneCodeRequest, opts ...grpc.CallOption) (*SendPhoneCodeResponse, error)
+	VerifyPhoneCode(ctx context.Context, in *VerifyPhoneCodeRequest, opts ...grpc.CallOption) (*VerifyPhoneCodeResponse, error)
+	InviteUser(ctx context.Context, in *InviteUserRequest, opts ...grpc.CallOption) (*InviteUserResponse, error)
+	AcceptInvite(ctx context.Context, in *AcceptInviteRequest, opts ...grpc.CallOption) (*AcceptInviteResponse, error)
+	ListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*ListInvitesResponse, error)
+	RevokeInvite(ctx context.Context, in *RevokeInviteRequest, opts ...grpc.CallOption) (*RevokeInviteResponse, error)
+	UploadAvatar(ctx context.Context, in *UploadAvatarRequest, opts ...grpc.CallOption) (*UploadAvatarResponse, error)
+	GetAvatar(ctx context.Context, in *GetAvatarRequest, opts ...grpc.CallOption) (*GetAvatarResponse, error)
+	CreateAttribute(ctx context.Context, in *CreateAttributeRequest, opts ...grpc.CallOption) (*CreateAttributeResponse, error)
+	ListAttributes(ctx context.Context, in *ListAttributesRequest, opts ...grpc.CallOption) (*ListAttributesResponse, error)
+	DeleteAttributes(ctx context.Context, in *DeleteAttributesRequest, opts ...grpc.CallOption) (*DeleteAttributesResponse, error)
+	ListLoginHistory(ctx context.Context, in *ListLoginHistoryRequest, opts ...grpc.CallOption) (*ListLoginHistoryResponse, error)
+}
+
+type identityManagerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewIdentityManagerClient(cc *grpc.ClientConn) IdentityManagerClient {
+	return &identityManagerClient{cc}
+}
+
+func (c *identityManagerClient) GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error) {
+	out := new(GetVersionResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/GetVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error) {
+	out := new(CreateGroupResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/CreateGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) DeleteGroups(ctx context.Context, in *DeleteGroupsRequest, opts ...grpc.CallOption) (*DeleteGroupsResponse, error) {
+	out := new(DeleteGroupsResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/DeleteGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) ModifyGroup(ctx context.Context, in *ModifyGroupRequest, opts ...grpc.CallOption) (*ModifyGroupResponse, error) {
+	out := new(ModifyGroupResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ModifyGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) MoveGroup(ctx context.Context, in *MoveGroupRequest, opts ...grpc.CallOption) (*MoveGroupResponse, error) {
+	out := new(MoveGroupResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/MoveGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupResponse, error) {
+	out := new(GetGroupResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/GetGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) GetGroupByNamePath(ctx context.Context, in *GetGroupByNamePathRequest, opts ...grpc.CallOption) (*GetGroupResponse, error) {
+	out := new(GetGroupResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/GetGroupByNamePath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) GetGroupWithUser(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupWithUserResponse, error) {
+	out := new(GetGroupWithUserResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/GetGroupWithUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) GetGroupTree(ctx context.Context, in *GetGroupTreeRequest, opts ...grpc.CallOption) (*GetGroupTreeResponse, error) {
+	out := new(GetGroupTreeResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/GetGroupTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) BatchCreateGroups(ctx context.Context, in *BatchCreateGroupsRequest, opts ...grpc.CallOption) (*BatchCreateGroupsResponse, error) {
+	out := new(BatchCreateGroupsResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/BatchCreateGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error) {
+	out := new(ListGroupsResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ListGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) ListGroupsWithUser(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsWithUserResponse, error) {
+	out := new(ListGroupsWithUserResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ListGroupsWithUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
+	out := new(CreateUserResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/CreateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) DeleteUsers(ctx context.Context, in *DeleteUsersRequest, opts ...grpc.CallOption) (*DeleteUsersResponse, error) {
+	out := new(DeleteUsersResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/DeleteUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) ModifyUser(ctx context.Context, in *ModifyUserRequest, opts ...grpc.CallOption) (*ModifyUserResponse, error) {
+	out := new(ModifyUserResponse)
 	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ModifyUser", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *identityManagerClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/GetUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) GetUserWithGroup(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserWithGroupResponse, error) {
+	out := new(GetUserWithGroupResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/GetUserWithGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) BatchCreateUsers(ctx context.Context, in *BatchCreateUsersRequest, opts ...grpc.CallOption) (*BatchCreateUsersResponse, error) {
+	out := new(BatchCreateUsersResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/BatchCreateUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) BatchModifyUsers(ctx context.Context, in *BatchModifyUsersRequest, opts ...grpc.CallOption) (*BatchModifyUsersResponse, error) {
+	out := new(BatchModifyUsersResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/BatchModifyUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ListUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) ListUsersWithGroup(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersWithGroupResponse, error) {
+	out := new(ListUsersWithGroupResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ListUsersWithGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) JoinGroup(ctx context.Context, in *JoinGroupRequest, opts ...grpc.CallOption) (*JoinGroupResponse, error) {
+	out := new(JoinGroupResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/JoinGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) LeaveGroup(ctx context.Context, in *LeaveGroupRequest, opts ...grpc.CallOption) (*LeaveGroupResponse, error) {
+	out := new(LeaveGroupResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/LeaveGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) AddGroupMembers(ctx context.Context, in *AddGroupMembersRequest, opts ...grpc.CallOption) (*AddGroupMembersResponse, error) {
+	out := new(AddGroupMembersResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/AddGroupMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) RemoveGroupMembers(ctx context.Context, in *RemoveGroupMembersRequest, opts ...grpc.CallOption) (*RemoveGroupMembersResponse, error) {
+	out := new(RemoveGroupMembersResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/RemoveGroupMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) ExtendMembership(ctx context.Context, in *ExtendMembershipRequest, opts ...grpc.CallOption) (*ExtendMembershipResponse, error) {
+	out := new(ExtendMembershipResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ExtendMembership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) ListExpiringMemberships(ctx context.Context, in *ListExpiringMembershipsRequest, opts ...grpc.CallOption) (*ListExpiringMembershipsResponse, error) {
+	out := new(ListExpiringMembershipsResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ListExpiringMemberships", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) ListMembershipRemovals(ctx context.Context, in *ListMembershipRemovalsRequest, opts ...grpc.CallOption) (*ListMembershipRemovalsResponse, error) {
+	out := new(ListMembershipRemovalsResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ListMembershipRemovals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) PreviewRule(ctx context.Context, in *PreviewRuleRequest, opts ...grpc.CallOption) (*PreviewRuleResponse, error) {
+	out := new(PreviewRuleResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/PreviewRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) ListEffectiveGroups(ctx context.Context, in *ListEffectiveGroupsRequest, opts ...grpc.CallOption) (*ListEffectiveGroupsResponse, error) {
+	out := new(ListEffectiveGroupsResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ListEffectiveGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) ListEffectiveMembers(ctx context.Context, in *ListEffectiveMembersRequest, opts ...grpc.CallOption) (*ListEffectiveMembersResponse, error) {
+	out := new(ListEffectiveMembersResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ListEffectiveMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) IsMember(ctx context.Context, in *IsMemberRequest, opts ...grpc.CallOption) (*IsMemberResponse, error) {
+	out := new(IsMemberResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/IsMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) ComparePassword(ctx context.Context, in *ComparePasswordRequest, opts ...grpc.CallOption) (*ComparePasswordResponse, error) {
+	out := new(ComparePasswordResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ComparePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) ModifyPassword(ctx context.Context, in *ModifyPasswordRequest, opts ...grpc.CallOption) (*ModifyPasswordResponse, error) {
+	out := new(ModifyPasswordResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ModifyPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) MergeUsers(ctx context.Context, in *MergeUsersRequest, opts ...grpc.CallOption) (*MergeUsersResponse, error) {
+	out := new(MergeUsersResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/MergeUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error) {
+	out := new(ExportUserDataResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ExportUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) AnonymizeUser(ctx context.Context, in *AnonymizeUserRequest, opts ...grpc.CallOption) (*AnonymizeUserResponse, error) {
+	out := new(AnonymizeUserResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/AnonymizeUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) SendEmailVerification(ctx context.Context, in *SendEmailVerificationRequest, opts ...grpc.CallOption) (*SendEmailVerificationResponse, error) {
+	out := new(SendEmailVerificationResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/SendEmailVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) ConfirmEmailVerification(ctx context.Context, in *ConfirmEmailVerificationRequest, opts ...grpc.CallOption) (*ConfirmEmailVerificationResponse, error) {
+	out := new(ConfirmEmailVerificationResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ConfirmEmailVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) SendPhoneCode(ctx context.Context, in *SendPhoneCodeRequest, opts ...grpc.CallOption) (*SendPhoneCodeResponse, error) {
+	out := new(SendPhoneCodeResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/SendPhoneCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) VerifyPhoneCode(ctx context.Context, in *VerifyPhoneCodeRequest, opts ...grpc.CallOption) (*VerifyPhoneCodeResponse, error) {
+	out := new(VerifyPhoneCodeResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/VerifyPhoneCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) InviteUser(ctx context.Context, in *InviteUserRequest, opts ...grpc.CallOption) (*InviteUserResponse, error) {
+	out := new(InviteUserResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/InviteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) AcceptInvite(ctx context.Context, in *AcceptInviteRequest, opts ...grpc.CallOption) (*AcceptInviteResponse, error) {
+	out := new(AcceptInviteResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/AcceptInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) ListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*ListInvitesResponse, error) {
+	out := new(ListInvitesResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ListInvites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) RevokeInvite(ctx context.Context, in *RevokeInviteRequest, opts ...grpc.CallOption) (*RevokeInviteResponse, error) {
+	out := new(RevokeInviteResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/RevokeInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) UploadAvatar(ctx context.Context, in *UploadAvatarRequest, opts ...grpc.CallOption) (*UploadAvatarResponse, error) {
+	out := new(UploadAvatarResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/UploadAvatar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) GetAvatar(ctx context.Context, in *GetAvatarRequest, opts ...grpc.CallOption) (*GetAvatarResponse, error) {
+	out := new(GetAvatarResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/GetAvatar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) CreateAttribute(ctx context.Context, in *CreateAttributeRequest, opts ...grpc.CallOption) (*CreateAttributeResponse, error) {
+	out := new(CreateAttributeResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/CreateAttribute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) ListAttributes(ctx context.Context, in *ListAttributesRequest, opts ...grpc.CallOption) (*ListAttributesResponse, error) {
+	out := new(ListAttributesResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ListAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) DeleteAttributes(ctx context.Context, in *DeleteAttributesRequest, opts ...grpc.CallOption) (*DeleteAttributesResponse, error) {
+	out := new(DeleteAttributesResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/DeleteAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityManagerClient) ListLoginHistory(ctx context.Context, in *ListLoginHistoryRequest, opts ...grpc.CallOption) (*ListLoginHistoryResponse, error) {
+	out := new(ListLoginHistoryResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ListLoginHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IdentityManagerServer is the server API for IdentityManager service.
+type IdentityManagerServer interface {
+	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
+	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
+	DeleteGroups(context.Context, *DeleteGroupsRequest) (*DeleteGroupsResponse, error)
+	ModifyGroup(context.Context, *ModifyGroupRequest) (*ModifyGroupResponse, error)
+	MoveGroup(context.Context, *MoveGroupRequest) (*MoveGroupResponse, error)
+	GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error)
+	GetGroupByNamePath(context.Context, *GetGroupByNamePathRequest) (*GetGroupResponse, error)
+	GetGroupWithUser(context.Context, *GetGroupRequest) (*GetGroupWithUserResponse, error)
+	GetGroupTree(context.Context, *GetGroupTreeRequest) (*GetGroupTreeResponse, error)
+	BatchCreateGroups(context.Context, *BatchCreateGroupsRequest) (*BatchCreateGroupsResponse, error)
+	ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error)
+	ListGroupsWithUser(context.Context, *ListGroupsRequest) (*ListGroupsWithUserResponse, error)
+	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	DeleteUsers(context.Context, *DeleteUsersRequest) (*DeleteUsersResponse, error)
+	ModifyUser(context.Context, *ModifyUserRequest) (*ModifyUserResponse, error)
+	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	GetUserWithGroup(context.Context, *GetUserRequest) (*GetUserWithGroupResponse, error)
+	BatchCreateUsers(context.Context, *BatchCreateUsersRequest) (*BatchCreateUsersResponse, error)
+	BatchModifyUsers(context.Context, *BatchModifyUsersRequest) (*BatchModifyUsersResponse, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	ListUsersWithGroup(context.Context, *ListUsersRequest) (*ListUsersWithGroupResponse, error)
+	JoinGroup(context.Context, *JoinGroupRequest) (*JoinGroupResponse, error)
+	LeaveGroup(context.Context, *LeaveGroupRequest) (*LeaveGroupResponse, error)
+	AddGroupMembers(context.Context, *AddGroupMembersRequest) (*AddGroupMembersResponse, error)
+	RemoveGroupMembers(context.Context, *RemoveGroupMembersRequest) (*RemoveGroupMembersResponse, error)
+	ExtendMembership(context.Context, *ExtendMembershipRequest) (*ExtendMembershipResponse, error)
+	ListExpiringMemberships(context.Context, *ListExpiringMembershipsRequest) (*ListExpiringMembershipsResponse, error)
+	ListMembershipRemovals(context.Context, *ListMembershipRemovalsRequest) (*ListMembershipRemovalsResponse, error)
+	PreviewRule(context.Context, *PreviewRuleRequest) (*PreviewRuleResponse, error)
+	ListEffectiveGroups(context.Context, *ListEffectiveGroupsRequest) (*ListEffectiveGroupsResponse, error)
+	ListEffectiveMembers(context.Context, *ListEffectiveMembersRequest) (*ListEffectiveMembersResponse, error)
+	IsMember(context.Context, *IsMemberRequest) (*IsMemberResponse, error)
+	ComparePassword(context.Context, *ComparePasswordRequest) (*ComparePasswordResponse, error)
+	ModifyPassword(context.Context, *ModifyPasswordRequest) (*ModifyPasswordResponse, error)
+	MergeUsers(context.Context, *MergeUsersRequest) (*MergeUsersResponse, error)
+	ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error)
+	AnonymizeUser(context.Context, *AnonymizeUserRequest) (*AnonymizeUserResponse, error)
+	SendEmailVerification(context.Context, *SendEmailVerificationRequest) (*SendEmailVerificationResponse, error)
+	ConfirmEmailVerification(context.Context, *ConfirmEmailVerificationRequest) (*ConfirmEmailVerificationResponse, error)
+	SendPhoneCode(context.Context, *SendPhoneCodeRequest) (*SendPhoneCodeResponse, error)
+	VerifyPhoneCode(context.Context, *VerifyPhoneCodeRequest) (*VerifyPhoneCodeResponse, error)
+	InviteUser(context.Context, *InviteUserRequest) (*InviteUserResponse, error)
+	AcceptInvite(context.Context, *AcceptInviteRequest) (*AcceptInviteResponse, error)
+	ListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error)
+	RevokeInvite(context.Context, *RevokeInviteRequest) (*RevokeInviteResponse, error)
+	UploadAvatar(context.Context, *UploadAvatarRequest) (*UploadAvatarResponse, error)
+	GetAvatar(context.Context, *GetAvatarRequest) (*GetAvatarResponse, error)
+	CreateAttribute(context.Context, *CreateAttributeRequest) (*CreateAttributeResponse, error)
+	ListAttributes(context.Context, *ListAttributesRequest) (*ListAttributesResponse, error)
+	DeleteAttributes(context.Context, *DeleteAttributesRequest) (*DeleteAttributesResponse, error)
+	ListLoginHistory(context.Context, *ListLoginHistoryRequest) (*ListLoginHistoryResponse, error)
+}
+
+func RegisterIdentityManagerServer(s *grpc.Server, srv IdentityManagerServer) {
+	s.RegisterService(&_IdentityManager_serviceDesc, srv)
+}
+
+func _IdentityManager_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/GetVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).GetVersion(ctx, req.(*GetVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/CreateGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_DeleteGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).DeleteGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/DeleteGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).DeleteGroups(ctx, req.(*DeleteGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_ModifyGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).ModifyGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/ModifyGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).ModifyGroup(ctx, req.(*ModifyGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_MoveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).MoveGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/MoveGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).MoveGroup(ctx, req.(*MoveGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).GetGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/GetGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).GetGroup(ctx, req.(*GetGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_GetGroupByNamePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupByNamePathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).GetGroupByNamePath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/GetGroupByNamePath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).GetGroupByNamePath(ctx, req.(*GetGroupByNamePathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_GetGroupWithUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).GetGroupWithUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/GetGroupWithUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).GetGroupWithUser(ctx, req.(*GetGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_GetGroupTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).GetGroupTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/GetGroupTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).GetGroupTree(ctx, req.(*GetGroupTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_BatchCreateGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).BatchCreateGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/BatchCreateGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).BatchCreateGroups(ctx, req.(*BatchCreateGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).ListGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/ListGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).ListGroups(ctx, req.(*ListGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_ListGroupsWithUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).ListGroupsWithUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/ListGroupsWithUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).ListGroupsWithUser(ctx, req.(*ListGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/CreateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *identityManagerClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
-	out := new(GetUserResponse)
-	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/GetUser", in, out, opts...)
-	if err != nil {
+func _IdentityManager_DeleteUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUsersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).DeleteUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/DeleteUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).DeleteUsers(ctx, req.(*DeleteUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *identityManagerClient) GetUserWithGroup(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserWithGroupResponse, error) {
-	out := new(GetUserWithGroupResponse)
-	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/GetUserWithGroup", in, out, opts...)
-	if err != nil {
+func _IdentityManager_ModifyUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyUserRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).ModifyUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/ModifyUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).ModifyUser(ctx, req.(*ModifyUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *identityManagerClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
-	out := new(ListUsersResponse)
-	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ListUsers", in, out, opts...)
-	if err != nil {
+func _IdentityManager_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/GetUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *identityManagerClient) ListUsersWithGroup(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersWithGroupResponse, error) {
-	out := new(ListUsersWithGroupResponse)
-	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ListUsersWithGroup", in, out, opts...)
-	if err != nil {
+func _IdentityManager_GetUserWithGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).GetUserWithGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/GetUserWithGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).GetUserWithGroup(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_BatchCreateUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).BatchCreateUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/BatchCreateUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).BatchCreateUsers(ctx, req.(*BatchCreateUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_BatchModifyUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchModifyUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).BatchModifyUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/BatchModifyUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).BatchModifyUsers(ctx, req.(*BatchModifyUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_ListUsersWithGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).ListUsersWithGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/ListUsersWithGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).ListUsersWithGroup(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_JoinGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).JoinGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/JoinGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).JoinGroup(ctx, req.(*JoinGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).LeaveGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/LeaveGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).LeaveGroup(ctx, req.(*LeaveGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_AddGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).AddGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/AddGroupMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).AddGroupMembers(ctx, req.(*AddGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_RemoveGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).RemoveGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/RemoveGroupMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).RemoveGroupMembers(ctx, req.(*RemoveGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_ExtendMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).ExtendMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/ExtendMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).ExtendMembership(ctx, req.(*ExtendMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_ListExpiringMemberships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpiringMembershipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).ListExpiringMemberships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/ListExpiringMemberships",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).ListExpiringMemberships(ctx, req.(*ListExpiringMembershipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityManager_ListMembershipRemovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMembershipRemovalsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).ListMembershipRemovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/ListMembershipRemovals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).ListMembershipRemovals(ctx, req.(*ListMembershipRemovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *identityManagerClient) JoinGroup(ctx context.Context, in *JoinGroupRequest, opts ...grpc.CallOption) (*JoinGroupResponse, error) {
-	out := new(JoinGroupResponse)
-	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/JoinGroup", in, out, opts...)
-	if err != nil {
+func _IdentityManager_PreviewRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewRuleRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).PreviewRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/PreviewRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).PreviewRule(ctx, req.(*PreviewRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *identityManagerClient) LeaveGroup(ctx context.Context, in *LeaveGroupRequest, opts ...grpc.CallOption) (*LeaveGroupResponse, error) {
-	out := new(LeaveGroupResponse)
-	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/LeaveGroup", in, out, opts...)
-	if err != nil {
+func _IdentityManager_ListEffectiveGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEffectiveGroupsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).ListEffectiveGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/ListEffectiveGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).ListEffectiveGroups(ctx, req.(*ListEffectiveGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *identityManagerClient) ComparePassword(ctx context.Context, in *ComparePasswordRequest, opts ...grpc.CallOption) (*ComparePasswordResponse, error) {
-	out := new(ComparePasswordResponse)
-	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ComparePassword", in, out, opts...)
-	if err != nil {
+func _IdentityManager_ListEffectiveMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEffectiveMembersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).ListEffectiveMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/ListEffectiveMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).ListEffectiveMembers(ctx, req.(*ListEffectiveMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *identityManagerClient) ModifyPassword(ctx context.Context, in *ModifyPasswordRequest, opts ...grpc.CallOption) (*ModifyPasswordResponse, error) {
-	out := new(ModifyPasswordResponse)
-	err := c.cc.Invoke(ctx, "/kubesphere.IdentityManager/ModifyPassword", in, out, opts...)
-	if err != nil {
+func _IdentityManager_IsMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsMemberRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// IdentityManagerServer is the server API for IdentityManager service.
-type IdentityManagerServer interface {
-	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
-	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
-	DeleteGroups(context.Context, *DeleteGroupsRequest) (*DeleteGroupsResponse, error)
-	ModifyGroup(context.Context, *ModifyGroupRequest) (*ModifyGroupResponse, error)
-	GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error)
-	GetGroupWithUser(context.Context, *GetGroupRequest) (*GetGroupWithUserResponse, error)
-	ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error)
-	ListGroupsWithUser(context.Context, *ListGroupsRequest) (*ListGroupsWithUserResponse, error)
-	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
-	DeleteUsers(context.Context, *DeleteUsersRequest) (*DeleteUsersResponse, error)
-	ModifyUser(context.Context, *ModifyUserRequest) (*ModifyUserResponse, error)
-	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
-	GetUserWithGroup(context.Context, *GetUserRequest) (*GetUserWithGroupResponse, error)
-	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
-	ListUsersWithGroup(context.Context, *ListUsersRequest) (*ListUsersWithGroupResponse, error)
-	JoinGroup(context.Context, *JoinGroupRequest) (*JoinGroupResponse, error)
-	LeaveGroup(context.Context, *LeaveGroupRequest) (*LeaveGroupResponse, error)
-	ComparePassword(context.Context, *ComparePasswordRequest) (*ComparePasswordResponse, error)
-	ModifyPassword(context.Context, *ModifyPasswordRequest) (*ModifyPasswordResponse, error)
-}
-
-func RegisterIdentityManagerServer(s *grpc.Server, srv IdentityManagerServer) {
-	s.RegisterService(&_IdentityManager_serviceDesc, srv)
+	if interceptor == nil {
+		return srv.(IdentityManagerServer).IsMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.IdentityManager/IsMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityManagerServer).IsMember(ctx, req.(*IsMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _IdentityManager_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVersionRequest)
+func _IdentityManager_ComparePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComparePasswordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdentityManagerServer).GetVersion(ctx, in)
+		return srv.(IdentityManagerServer).ComparePassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kubesphere.IdentityManager/GetVersion",
+		FullMethod: "/kubesphere.IdentityManager/ComparePassword",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdentityManagerServer).GetVersion(ctx, req.(*GetVersionRequest))
+		return srv.(IdentityManagerServer).ComparePassword(ctx, req.(*ComparePasswordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdentityManager_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateGroupRequest)
+func _IdentityManager_ModifyPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyPasswordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdentityManagerServer).CreateGroup(ctx, in)
+		return srv.(IdentityManagerServer).ModifyPassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kubesphere.IdentityManager/CreateGroup",
+		FullMethod: "/kubesphere.IdentityManager/ModifyPassword",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdentityManagerServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+		return srv.(IdentityManagerServer).ModifyPassword(ctx, req.(*ModifyPasswordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdentityManager_DeleteGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteGroupsRequest)
+func _IdentityManager_MergeUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeUsersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdentityManagerServer).DeleteGroups(ctx, in)
+		return srv.(IdentityManagerServer).MergeUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kubesphere.IdentityManager/DeleteGroups",
+		FullMethod: "/kubesphere.IdentityManager/MergeUsers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdentityManagerServer).DeleteGroups(ctx, req.(*DeleteGroupsRequest))
+		return srv.(IdentityManagerServer).MergeUsers(ctx, req.(*MergeUsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdentityManager_ModifyGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ModifyGroupRequest)
+func _IdentityManager_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdentityManagerServer).ModifyGroup(ctx, in)
+		return srv.(IdentityManagerServer).ExportUserData(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kubesphere.IdentityManager/ModifyGroup",
+		FullMethod: "/kubesphere.IdentityManager/ExportUserData",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdentityManagerServer).ModifyGroup(ctx, req.(*ModifyGroupRequest))
+		return srv.(IdentityManagerServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdentityManager_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGroupRequest)
+func _IdentityManager_AnonymizeUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnonymizeUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdentityManagerServer).GetGroup(ctx, in)
+		return srv.(IdentityManagerServer).AnonymizeUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kubesphere.IdentityManager/GetGroup",
+		FullMethod: "/kubesphere.IdentityManager/AnonymizeUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdentityManagerServer).GetGroup(ctx, req.(*GetGroupRequest))
+		return srv.(IdentityManagerServer).AnonymizeUser(ctx, req.(*AnonymizeUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdentityManager_GetGroupWithUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGroupRequest)
+func _IdentityManager_SendEmailVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendEmailVerificationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdentityManagerServer).GetGroupWithUser(ctx, in)
+		return srv.(IdentityManagerServer).SendEmailVerification(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kubesphere.IdentityManager/GetGroupWithUser",
+		FullMethod: "/kubesphere.IdentityManager/SendEmailVerification",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdentityManagerServer).GetGroupWithUser(ctx, req.(*GetGroupRequest))
+		return srv.(IdentityManagerServer).SendEmailVerification(ctx, req.(*SendEmailVerificationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdentityManager_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListGroupsRequest)
+func _IdentityManager_ConfirmEmailVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEmailVerificationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdentityManagerServer).ListGroups(ctx, in)
+		return srv.(IdentityManagerServer).ConfirmEmailVerification(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kubesphere.IdentityManager/ListGroups",
+		FullMethod: "/kubesphere.IdentityManager/ConfirmEmailVerification",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdentityManagerServer).ListGroups(ctx, req.(*ListGroupsRequest))
+		return srv.(IdentityManagerServer).ConfirmEmailVerification(ctx, req.(*ConfirmEmailVerificationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdentityManager_ListGroupsWithUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListGroupsRequest)
+func _IdentityManager_SendPhoneCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendPhoneCodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdentityManagerServer).ListGroupsWithUser(ctx, in)
+		return srv.(IdentityManagerServer).SendPhoneCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kubesphere.IdentityManager/ListGroupsWithUser",
+		FullMethod: "/kubesphere.IdentityManager/SendPhoneCode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdentityManagerServer).ListGroupsWithUser(ctx, req.(*ListGroupsRequest))
+		return srv.(IdentityManagerServer).SendPhoneCode(ctx, req.(*SendPhoneCodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdentityManager_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _IdentityManager_VerifyPhoneCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPhoneCodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdentityManagerServer).CreateUser(ctx, in)
+		return srv.(IdentityManagerServer).VerifyPhoneCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kubesphere.IdentityManager/CreateUser",
+		FullMethod: "/kubesphere.IdentityManager/VerifyPhoneCode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdentityManagerServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(IdentityManagerServer).VerifyPhoneCode(ctx, req.(*VerifyPhoneCodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdentityManager_DeleteUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUsersRequest)
+func _IdentityManager_InviteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdentityManagerServer).DeleteUsers(ctx, in)
+		return srv.(IdentityManagerServer).InviteUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kubesphere.IdentityManager/DeleteUsers",
+		FullMethod: "/kubesphere.IdentityManager/InviteUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdentityManagerServer).DeleteUsers(ctx, req.(*DeleteUsersRequest))
+		return srv.(IdentityManagerServer).InviteUser(ctx, req.(*InviteUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdentityManager_ModifyUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ModifyUserRequest)
+func _IdentityManager_AcceptInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInviteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdentityManagerServer).ModifyUser(ctx, in)
+		return srv.(IdentityManagerServer).AcceptInvite(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kubesphere.IdentityManager/ModifyUser",
+		FullMethod: "/kubesphere.IdentityManager/AcceptInvite",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdentityManagerServer).ModifyUser(ctx, req.(*ModifyUserRequest))
+		return srv.(IdentityManagerServer).AcceptInvite(ctx, req.(*AcceptInviteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdentityManager_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRequest)
+func _IdentityManager_ListInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdentityManagerServer).GetUser(ctx, in)
+		return srv.(IdentityManagerServer).ListInvites(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kubesphere.IdentityManager/GetUser",
+		FullMethod: "/kubesphere.IdentityManager/ListInvites",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdentityManagerServer).GetUser(ctx, req.(*GetUserRequest))
+		return srv.(IdentityManagerServer).ListInvites(ctx, req.(*ListInvitesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdentityManager_GetUserWithGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRequest)
+func _IdentityManager_RevokeInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdentityManagerServer).GetUserWithGroup(ctx, in)
+		return srv.(IdentityManagerServer).RevokeInvite(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kubesphere.IdentityManager/GetUserWithGroup",
+		FullMethod: "/kubesphere.IdentityManager/RevokeInvite",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdentityManagerServer).GetUserWithGroup(ctx, req.(*GetUserRequest))
+		return srv.(IdentityManagerServer).RevokeInvite(ctx, req.(*RevokeInviteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdentityManager_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUsersRequest)
+func _IdentityManager_UploadAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadAvatarRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdentityManagerServer).ListUsers(ctx, in)
+		return srv.(IdentityManagerServer).UploadAvatar(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kubesphere.IdentityManager/ListUsers",
+		FullMethod: "/kubesphere.IdentityManager/UploadAvatar",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdentityManagerServer).ListUsers(ctx, req.(*ListUsersRequest))
+		return srv.(IdentityManagerServer).UploadAvatar(ctx, req.(*UploadAvatarRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdentityManager_ListUsersWithGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUsersRequest)
+func _IdentityManager_GetAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvatarRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdentityManagerServer).ListUsersWithGroup(ctx, in)
+		return srv.(IdentityManagerServer).GetAvatar(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kubesphere.IdentityManager/ListUsersWithGroup",
+		FullMethod: "/kubesphere.IdentityManager/GetAvatar",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdentityManagerServer).ListUsersWithGroup(ctx, req.(*ListUsersRequest))
+		return srv.(IdentityManagerServer).GetAvatar(ctx, req.(*GetAvatarRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdentityManager_JoinGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JoinGroupRequest)
+func _IdentityManager_CreateAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAttributeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdentityManagerServer).JoinGroup(ctx, in)
+		return srv.(IdentityManagerServer).CreateAttribute(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kubesphere.IdentityManager/JoinGroup",
+		FullMethod: "/kubesphere.IdentityManager/CreateAttribute",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdentityManagerServer).JoinGroup(ctx, req.(*JoinGroupRequest))
+		return srv.(IdentityManagerServer).CreateAttribute(ctx, req.(*CreateAttributeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdentityManager_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LeaveGroupRequest)
+func _IdentityManager_ListAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttributesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdentityManagerServer).LeaveGroup(ctx, in)
+		return srv.(IdentityManagerServer).ListAttributes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kubesphere.IdentityManager/LeaveGroup",
+		FullMethod: "/kubesphere.IdentityManager/ListAttributes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdentityManagerServer).LeaveGroup(ctx, req.(*LeaveGroupRequest))
+		return srv.(IdentityManagerServer).ListAttributes(ctx, req.(*ListAttributesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdentityManager_ComparePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ComparePasswordRequest)
+func _IdentityManager_DeleteAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttributesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdentityManagerServer).ComparePassword(ctx, in)
+		return srv.(IdentityManagerServer).DeleteAttributes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kubesphere.IdentityManager/ComparePassword",
+		FullMethod: "/kubesphere.IdentityManager/DeleteAttributes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdentityManagerServer).ComparePassword(ctx, req.(*ComparePasswordRequest))
+		return srv.(IdentityManagerServer).DeleteAttributes(ctx, req.(*DeleteAttributesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdentityManager_ModifyPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ModifyPasswordRequest)
+func _IdentityManager_ListLoginHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoginHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdentityManagerServer).ModifyPassword(ctx, in)
+		return srv.(IdentityManagerServer).ListLoginHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kubesphere.IdentityManager/ModifyPassword",
+		FullMethod: "/kubesphere.IdentityManager/ListLoginHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdentityManagerServer).ModifyPassword(ctx, req.(*ModifyPasswordRequest))
+		return srv.(IdentityManagerServer).ListLoginHistory(ctx, req.(*ListLoginHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2789,14 +8890,30 @@ var _IdentityManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ModifyGroup",
 			Handler:    _IdentityManager_ModifyGroup_Handler,
 		},
+		{
+			MethodName: "MoveGroup",
+			Handler:    _IdentityManager_MoveGroup_Handler,
+		},
 		{
 			MethodName: "GetGroup",
 			Handler:    _IdentityManager_GetGroup_Handler,
 		},
+		{
+			MethodName: "GetGroupByNamePath",
+			Handler:    _IdentityManager_GetGroupByNamePath_Handler,
+		},
 		{
 			MethodName: "GetGroupWithUser",
 			Handler:    _IdentityManager_GetGroupWithUser_Handler,
 		},
+		{
+			MethodName: "GetGroupTree",
+			Handler:    _IdentityManager_GetGroupTree_Handler,
+		},
+		{
+			MethodName: "BatchCreateGroups",
+			Handler:    _IdentityManager_BatchCreateGroups_Handler,
+		},
 		{
 			MethodName: "ListGroups",
 			Handler:    _IdentityManager_ListGroups_Handler,
@@ -2825,6 +8942,14 @@ var _IdentityManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserWithGroup",
 			Handler:    _IdentityManager_GetUserWithGroup_Handler,
 		},
+		{
+			MethodName: "BatchCreateUsers",
+			Handler:    _IdentityManager_BatchCreateUsers_Handler,
+		},
+		{
+			MethodName: "BatchModifyUsers",
+			Handler:    _IdentityManager_BatchModifyUsers_Handler,
+		},
 		{
 			MethodName: "ListUsers",
 			Handler:    _IdentityManager_ListUsers_Handler,
@@ -2841,6 +8966,42 @@ var _IdentityManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _IdentityManager_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "AddGroupMembers",
+			Handler:    _IdentityManager_AddGroupMembers_Handler,
+		},
+		{
+			MethodName: "RemoveGroupMembers",
+			Handler:    _IdentityManager_RemoveGroupMembers_Handler,
+		},
+		{
+			MethodName: "ExtendMembership",
+			Handler:    _IdentityManager_ExtendMembership_Handler,
+		},
+		{
+			MethodName: "ListExpiringMemberships",
+			Handler:    _IdentityManager_ListExpiringMemberships_Handler,
+		},
+		{
+			MethodName: "ListMembershipRemovals",
+			Handler:    _IdentityManager_ListMembershipRemovals_Handler,
+		},
+		{
+			MethodName: "PreviewRule",
+			Handler:    _IdentityManager_PreviewRule_Handler,
+		},
+		{
+			MethodName: "ListEffectiveGroups",
+			Handler:    _IdentityManager_ListEffectiveGroups_Handler,
+		},
+		{
+			MethodName: "ListEffectiveMembers",
+			Handler:    _IdentityManager_ListEffectiveMembers_Handler,
+		},
+		{
+			MethodName: "IsMember",
+			Handler:    _IdentityManager_IsMember_Handler,
+		},
 		{
 			MethodName: "ComparePassword",
 			Handler:    _IdentityManager_ComparePassword_Handler,
@@ -2849,6 +9010,74 @@ var _IdentityManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ModifyPassword",
 			Handler:    _IdentityManager_ModifyPassword_Handler,
 		},
+		{
+			MethodName: "MergeUsers",
+			Handler:    _IdentityManager_MergeUsers_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _IdentityManager_ExportUserData_Handler,
+		},
+		{
+			MethodName: "AnonymizeUser",
+			Handler:    _IdentityManager_AnonymizeUser_Handler,
+		},
+		{
+			MethodName: "SendEmailVerification",
+			Handler:    _IdentityManager_SendEmailVerification_Handler,
+		},
+		{
+			MethodName: "ConfirmEmailVerification",
+			Handler:    _IdentityManager_ConfirmEmailVerification_Handler,
+		},
+		{
+			MethodName: "SendPhoneCode",
+			Handler:    _IdentityManager_SendPhoneCode_Handler,
+		},
+		{
+			MethodName: "VerifyPhoneCode",
+			Handler:    _IdentityManager_VerifyPhoneCode_Handler,
+		},
+		{
+			MethodName: "InviteUser",
+			Handler:    _IdentityManager_InviteUser_Handler,
+		},
+		{
+			MethodName: "AcceptInvite",
+			Handler:    _IdentityManager_AcceptInvite_Handler,
+		},
+		{
+			MethodName: "ListInvites",
+			Handler:    _IdentityManager_ListInvites_Handler,
+		},
+		{
+			MethodName: "RevokeInvite",
+			Handler:    _IdentityManager_RevokeInvite_Handler,
+		},
+		{
+			MethodName: "UploadAvatar",
+			Handler:    _IdentityManager_UploadAvatar_Handler,
+		},
+		{
+			MethodName: "GetAvatar",
+			Handler:    _IdentityManager_GetAvatar_Handler,
+		},
+		{
+			MethodName: "CreateAttribute",
+			Handler:    _IdentityManager_CreateAttribute_Handler,
+		},
+		{
+			MethodName: "ListAttributes",
+			Handler:    _IdentityManager_ListAttributes_Handler,
+		},
+		{
+			MethodName: "DeleteAttributes",
+			Handler:    _IdentityManager_DeleteAttributes_Handler,
+		},
+		{
+			MethodName: "ListLoginHistory",
+			Handler:    _IdentityManager_ListLoginHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "im.proto",
@@ -49,7 +49,7 @@ func (p *Server) GetGroup(ctx context.Context, req *pb.GetGroupRequest) (*pb.Get
 }
 
 func (p *Server) GetGroupWithUser(ctx context.Context, req *pb.GetGroupRequest) (*pb.GetGroupWithUserResponse, error) {
-	groupWithUser, err := resource.GetGroupWithUser(ctx, req.GroupId)
+	groupWithUser, err := resource.GetGroupWithUser(ctx, req.GroupId, req.ExpandNested)
 	if err != nil {
 		return nil, err
 	} else {
@@ -80,7 +80,11 @@ func (p *Server) DeleteUsers(ctx context.Context, req *pb.DeleteUsersRequest) (*
 }
 
 func (p *Server) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
-	user, err := resource.GetUser(ctx, req.UserId)
+	getUser := resource.GetUser
+	if req.FollowMerged {
+		getUser = resource.GetMergedUser
+	}
+	user, err := getUser(ctx, req.UserId)
 	if err != nil {
 		return nil, err
 	} else {
@@ -91,7 +95,7 @@ func (p *Server) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUs
 }
 
 func (p *Server) GetUserWithGroup(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserWithGroupResponse, error) {
-	userWithGroup, err := resource.GetUserWithGroup(ctx, req.UserId)
+	userWithGroup, err := resource.GetUserWithGroup(ctx, req.UserId, req.FollowMerged)
 	if err != nil {
 		return nil, err
 	} else {
@@ -128,3 +132,131 @@ func (p *Server) ComparePassword(ctx context.Context, req *pb.ComparePasswordReq
 func (p *Server) ModifyPassword(ctx context.Context, req *pb.ModifyPasswordRequest) (*pb.ModifyPasswordResponse, error) {
 	return resource.ModifyPassword(ctx, req)
 }
+
+func (p *Server) MergeUsers(ctx context.Context, req *pb.MergeUsersRequest) (*pb.MergeUsersResponse, error) {
+	return resource.MergeUsers(ctx, req)
+}
+
+func (p *Server) SendEmailVerification(ctx context.Context, req *pb.SendEmailVerificationRequest) (*pb.SendEmailVerificationResponse, error) {
+	return resource.SendEmailVerification(ctx, req)
+}
+
+func (p *Server) ConfirmEmailVerification(ctx context.Context, req *pb.ConfirmEmailVerificationRequest) (*pb.ConfirmEmailVerificationResponse, error) {
+	return resource.ConfirmEmailVerification(ctx, req)
+}
+
+func (p *Server) SendPhoneCode(ctx context.Context, req *pb.SendPhoneCodeRequest) (*pb.SendPhoneCodeResponse, error) {
+	return resource.SendPhoneCode(ctx, req)
+}
+
+func (p *Server) VerifyPhoneCode(ctx context.Context, req *pb.VerifyPhoneCodeRequest) (*pb.VerifyPhoneCodeResponse, error) {
+	return resource.VerifyPhoneCode(ctx, req)
+}
+
+func (p *Server) InviteUser(ctx context.Context, req *pb.InviteUserRequest) (*pb.InviteUserResponse, error) {
+	return resource.InviteUser(ctx, req)
+}
+
+func (p *Server) AcceptInvite(ctx context.Context, req *pb.AcceptInviteRequest) (*pb.AcceptInviteResponse, error) {
+	return resource.AcceptInvite(ctx, req)
+}
+
+func (p *Server) ListInvites(ctx context.Context, req *pb.ListInvitesRequest) (*pb.ListInvitesResponse, error) {
+	return resource.ListInvites(ctx, req)
+}
+
+func (p *Server) RevokeInvite(ctx context.Context, req *pb.RevokeInviteRequest) (*pb.RevokeInviteResponse, error) {
+	return resource.RevokeInvite(ctx, req)
+}
+
+func (p *Server) CreateAttribute(ctx context.Context, req *pb.CreateAttributeRequest) (*pb.CreateAttributeResponse, error) {
+	return resource.CreateAttribute(ctx, req)
+}
+
+func (p *Server) ListAttributes(ctx context.Context, req *pb.ListAttributesRequest) (*pb.ListAttributesResponse, error) {
+	return resource.ListAttributes(ctx, req)
+}
+
+func (p *Server) DeleteAttributes(ctx context.Context, req *pb.DeleteAttributesRequest) (*pb.DeleteAttributesResponse, error) {
+	return resource.DeleteAttributes(ctx, req)
+}
+
+func (p *Server) UploadAvatar(ctx context.Context, req *pb.UploadAvatarRequest) (*pb.UploadAvatarResponse, error) {
+	return resource.UploadAvatar(ctx, req)
+}
+
+func (p *Server) GetAvatar(ctx context.Context, req *pb.GetAvatarRequest) (*pb.GetAvatarResponse, error) {
+	return resource.GetAvatar(ctx, req)
+}
+
+func (p *Server) ListLoginHistory(ctx context.Context, req *pb.ListLoginHistoryRequest) (*pb.ListLoginHistoryResponse, error) {
+	return resource.ListLoginHistory(ctx, req)
+}
+
+func (p *Server) BatchCreateUsers(ctx context.Context, req *pb.BatchCreateUsersRequest) (*pb.BatchCreateUsersResponse, error) {
+	return resource.BatchCreateUsers(ctx, req)
+}
+
+func (p *Server) BatchModifyUsers(ctx context.Context, req *pb.BatchModifyUsersRequest) (*pb.BatchModifyUsersResponse, error) {
+	return resource.BatchModifyUsers(ctx, req)
+}
+
+func (p *Server) BatchCreateGroups(ctx context.Context, req *pb.BatchCreateGroupsRequest) (*pb.BatchCreateGroupsResponse, error) {
+	return resource.BatchCreateGroups(ctx, req)
+}
+
+func (p *Server) ExportUserData(ctx context.Context, req *pb.ExportUserDataRequest) (*pb.ExportUserDataResponse, error) {
+	return resource.ExportUserData(ctx, req)
+}
+
+func (p *Server) AnonymizeUser(ctx context.Context, req *pb.AnonymizeUserRequest) (*pb.AnonymizeUserResponse, error) {
+	return resource.AnonymizeUser(ctx, req)
+}
+
+func (p *Server) MoveGroup(ctx context.Context, req *pb.MoveGroupRequest) (*pb.MoveGroupResponse, error) {
+	return resource.MoveGroup(ctx, req)
+}
+
+func (p *Server) GetGroupTree(ctx context.Context, req *pb.GetGroupTreeRequest) (*pb.GetGroupTreeResponse, error) {
+	return resource.GetGroupTree(ctx, req)
+}
+
+func (p *Server) ListEffectiveGroups(ctx context.Context, req *pb.ListEffectiveGroupsRequest) (*pb.ListEffectiveGroupsResponse, error) {
+	return resource.ListEffectiveGroups(ctx, req)
+}
+
+func (p *Server) ListEffectiveMembers(ctx context.Context, req *pb.ListEffectiveMembersRequest) (*pb.ListEffectiveMembersResponse, error) {
+	return resource.ListEffectiveMembers(ctx, req)
+}
+
+func (p *Server) IsMember(ctx context.Context, req *pb.IsMemberRequest) (*pb.IsMemberResponse, error) {
+	return resource.IsMember(ctx, req)
+}
+
+func (p *Server) AddGroupMembers(ctx context.Context, req *pb.AddGroupMembersRequest) (*pb.AddGroupMembersResponse, error) {
+	return resource.AddGroupMembers(ctx, req)
+}
+
+func (p *Server) RemoveGroupMembers(ctx context.Context, req *pb.RemoveGroupMembersRequest) (*pb.RemoveGroupMembersResponse, error) {
+	return resource.RemoveGroupMembers(ctx, req)
+}
+
+func (p *Server) PreviewRule(ctx context.Context, req *pb.PreviewRuleRequest) (*pb.PreviewRuleResponse, error) {
+	return resource.PreviewRule(ctx, req)
+}
+
+func (p *Server) ExtendMembership(ctx context.Context, req *pb.ExtendMembershipRequest) (*pb.ExtendMembershipResponse, error) {
+	return resource.ExtendMembership(ctx, req)
+}
+
+func (p *Server) ListExpiringMemberships(ctx context.Context, req *pb.ListExpiringMembershipsRequest) (*pb.ListExpiringMembershipsResponse, error) {
+	return resource.ListExpiringMemberships(ctx, req)
+}
+
+func (p *Server) ListMembershipRemovals(ctx context.Context, req *pb.ListMembershipRemovalsRequest) (*pb.ListMembershipRemovalsResponse, error) {
+	return resource.ListMembershipRemovals(ctx, req)
+}
+
+func (p *Server) GetGroupByNamePath(ctx context.Context, req *pb.GetGroupByNamePathRequest) (*pb.GetGroupResponse, error) {
+	return resource.GetGroupByNamePath(ctx, req)
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/db"
+	"kubesphere.io/im/pkg/gerr"
+	"kubesphere.io/im/pkg/global"
+	"kubesphere.io/im/pkg/models"
+	"kubesphere.io/im/pkg/pb"
+	"kubesphere.io/im/pkg/util/jsonutil"
+	"kubesphere.io/im/pkg/util/stringutil"
+)
+
+func CreateAttribute(ctx context.Context, req *pb.CreateAttributeRequest) (*pb.CreateAttributeResponse, error) {
+	if !stringutil.Contains(constants.AttributeTargets, req.Target) {
+		err := gerr.NewInvalidArgument("target", "unsupported target [%s]", req.Target)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	if !stringutil.Contains(constants.AttributeTypes, req.Type) {
+		err := gerr.NewInvalidArgument("type", "unsupported type [%s]", req.Type)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	if req.Type == constants.AttributeTypeEnum && len(req.EnumValue) == 0 {
+		err := gerr.NewInvalidArgument("enum_value", "empty enum value of enum attribute [%s]", req.Name)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	if req.Type != constants.AttributeTypeEnum && len(req.EnumValue) > 0 {
+		err := gerr.NewInvalidArgument("enum_value", "enum value is only allowed for enum attribute")
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	if req.Type == constants.AttributeTypeStringList && req.Unique {
+		err := gerr.NewInvalidArgument("unique", "string_list attribute [%s] can not be unique", req.Name)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	var count int
+	if err := global.Global().Database.Table(constants.TableAttribute).
+		Where(constants.ColumnTarget+" = ?", req.Target).
+		Where(constants.ColumnName+" = ?", req.Name).
+		Count(&count).Error; err != nil {
+		logger.Errorf(ctx, "Get attribute [%s] of [%s] failed: %+v", req.Name, req.Target, err)
+		return nil, err
+	}
+	if count > 0 {
+		err := gerr.NewAlreadyExists(constants.TableAttribute, constants.ColumnName, req.Name)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	attribute := models.NewAttribute(req.Target, req.Name, req.Type, req.Required, req.Unique, req.EnumValue, req.Description)
+	if err := global.Global().Database.Create(attribute).Error; err != nil {
+		logger.Errorf(ctx, "Insert attribute failed: %+v", err)
+		return nil, err
+	}
+
+	return &pb.CreateAttributeResponse{
+		AttributeId: attribute.AttributeId,
+	}, nil
+}
+
+func ListAttributes(ctx context.Context, req *pb.ListAttributesRequest) (*pb.ListAttributesResponse, error) {
+	req.AttributeId = stringutil.SimplifyStringList(req.AttributeId)
+	req.Target = stringutil.SimplifyStringList(req.Target)
+	req.Name = stringutil.SimplifyStringList(req.Name)
+
+	limit := db.GetLimitFromRequest(req)
+	offset := db.GetOffsetFromRequest(req)
+
+	var attributes []*models.Attribute
+	var count int
+
+	if err := db.GetChain(global.Global().Database.Table(constants.TableAttribute)).
+		AddQueryOrderDir(req, constants.ColumnCreateTime).
+		BuildFilterConditions(req, constants.TableAttribute).
+		Offset(offset).
+		Limit(limit).
+		Find(&attributes).Error; err != nil {
+		logger.Errorf(ctx, "List attributes failed: %+v", err)
+		return nil, err
+	}
+
+	if err := db.GetChain(global.Global().Database.Table(constants.TableAttribute)).
+		BuildFilterConditions(req, constants.TableAttribute).
+		Count(&count).Error; err != nil {
+		logger.Errorf(ctx, "List attributes count failed: %+v", err)
+		return nil, err
+	}
+
+	var pbAttributes []*pb.Attribute
+	for _, attribute := range attributes {
+		pbAttributes = append(pbAttributes, attribute.ToPB())
+	}
+
+	return &pb.ListAttributesResponse{
+		AttributeSet: pbAttributes,
+		Total:        uint32(count),
+	}, nil
+}
+
+// DeleteAttributes removes the definitions only, the values are kept in the extra as untyped keys.
+func DeleteAttributes(ctx context.Context, req *pb.DeleteAttributesRequest) (*pb.DeleteAttributesResponse, error) {
+	attributeIds := req.AttributeId
+	if len(attributeIds) == 0 {
+		err := gerr.NewInvalidArgument("attribute_id", "empty attribute id")
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	if err := global.Global().Database.
+		Delete(models.Attribute{}, constants.ColumnAttributeId+" in (?)", attributeIds).Error; err != nil {
+		logger.Errorf(ctx, "Delete attributes failed: %+v", err)
+		return nil, err
+	}
+
+	return &pb.DeleteAttributesResponse{
+		AttributeId: attributeIds,
+	}, nil
+}
+
+func getAttributes(ctx context.Context, target string) ([]*models.Attribute, error) {
+	var attributes []*models.Attribute
+	if err := global.Global().Database.Table(constants.TableAttribute).
+		Where(constants.ColumnTarget+" = ?", target).
+		Find(&attributes).Error; err != nil {
+		logger.Errorf(ctx, "Get attributes of [%s] failed: %+v", target, err)
+		return nil, err
+	}
+	return attributes, nil
+}
+
+// encodeExtra checks the extra of the user or group against the attribute definitions
+// and returns the typed json to be saved, keys without definition are kept as strings.
+func encodeExtra(ctx context.Context, target, id string, extra map[string]string) (*string, error) {
+	attributes, err := getAttributes(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]interface{})
+	for key, value := range extra {
+		values[key] = value
+	}
+	for _, attribute := range attributes {
+		field := constants.ColumnExtra + "." + attribute.Name
+		value, ok := extra[attribute.Name]
+		if !ok || value == "" {
+			if attribute.Required {
+				err := gerr.NewInvalidArgument(field, "attribute [%s] is required", attribute.Name)
+				logger.Errorf(ctx, "%+v", err)
+				return nil, err
+			}
+			delete(values, attribute.Name)
+			continue
+		}
+		v, err := attribute.ParseValue(value)
+		if err != nil {
+			err := gerr.NewInvalidArgument(field, "invalid %s value [%s] of attribute [%s]: %v", attribute.Type, value, attribute.Name, err)
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+		values[attribute.Name] = v
+
+		if attribute.Unique {
+			if err := checkAttributeConflict(ctx, target, id, attribute, v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return stringutil.NewString(jsonutil.ToString(values)), nil
+}
+
+func checkAttributeConflict(ctx context.Context, target, id string, attribute *models.Attribute, value interface{}) error {
+	idColumn := constants.ColumnUserId
+	if target == constants.TableGroup {
+		idColumn = constants.ColumnGroupId
+	}
+
+	var count int
+	if err := db.GetChain(global.Global().Database.Table(target)).
+		BuildExtraConditions([]db.ExtraCondition{{Key: attribute.Name, Op: constants.FilterOpEq, Value: value}}).
+		Where(idColumn+" != ?", id).
+		Where(constants.ColumnStatus+" != ?", constants.StatusDeleted).
+		Count(&count).Error; err != nil {
+		logger.Errorf(ctx, "Get [%s] by attribute [%s] failed: %+v", target, attribute.Name, err)
+		return err
+	}
+	if count > 0 {
+		err := gerr.NewAlreadyExists(target, constants.ColumnExtra+"."+attribute.Name, fmt.Sprint(value))
+		logger.Errorf(ctx, "%+v", err)
+		return err
+	}
+	return nil
+}
+
+// getExtraConditions converts the attribute filters of the request to conditions of the extra,
+// the value is parsed according to the attribute definition.
+func getExtraConditions(ctx context.Context, target string, filters []*pb.AttributeFilter) ([]db.ExtraCondition, error) {
+	if len(filters) == 0 {
+		return nil, nil
+	}
+	attributes, err := getAttributes(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	attributeMap := make(map[string]*models.Attribute)
+	for _, attribute := range attributes {
+		attributeMap[attribute.Name] = attribute
+	}
+
+	var conditions []db.ExtraCondition
+	for _, filter := range filters {
+		attribute, ok := attributeMap[filter.Name]
+		if !ok {
+			err := gerr.NewInvalidArgument("attribute_filter", "attribute [%s] of [%s] is not defined", filter.Name, target)
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+		op := filter.Op
+		if op == "" {
+			op = constants.FilterOpEq
+		}
+
+		condition := db.ExtraCondition{Key: attribute.Name, Op: op}
+		switch attribute.Type {
+		case constants.AttributeTypeStringList:
+			condition.List = true
+			condition.Value = filter.Value
+		case constants.AttributeTypeString, constants.AttributeTypeEnum:
+			// partial values are allowed for contains
+			condition.Value = filter.Value
+		default:
+			condition.Value, err = attribute.ParseValue(filter.Value)
+			if err != nil {
+				err := gerr.NewInvalidArgument("attribute_filter", "invalid %s value [%s] of attribute [%s]: %v",
+					attribute.Type, filter.Value, attribute.Name, err)
+				logger.Errorf(ctx, "%+v", err)
+				return nil, err
+			}
+		}
+		if !isValidFilterOp(attribute.Type, op) {
+			err := gerr.NewInvalidArgument("attribute_filter", "unsupported op [%s] of %s attribute [%s]", op, attribute.Type, attribute.Name)
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions, nil
+}
+
+func isValidFilterOp(attributeType, op string) bool {
+	switch attributeType {
+	case constants.AttributeTypeStringList:
+		return op == constants.FilterOpContains
+	case constants.AttributeTypeBool:
+		return op == constants.FilterOpEq || op == constants.FilterOpNe
+	case constants.AttributeTypeInt, constants.AttributeTypeDate:
+		return op != constants.FilterOpContains
+	}
+	return true
+}
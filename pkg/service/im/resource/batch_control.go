@@ -0,0 +1,311 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/db"
+	"kubesphere.io/im/pkg/gerr"
+	"kubesphere.io/im/pkg/global"
+	"kubesphere.io/im/pkg/models"
+	"kubesphere.io/im/pkg/pb"
+)
+
+var errBatchAborted = gerr.New(codes.Aborted, gerr.ReasonAborted, nil, "atomic batch aborted by the failed items")
+
+func BatchCreateUsers(ctx context.Context, req *pb.BatchCreateUsersRequest) (*pb.BatchCreateUsersResponse, error) {
+	results := newBatchResults(len(req.User))
+	var users []interface{}
+	var indexes []int
+	usernames := make(map[string]bool)
+	emails := make(map[string]bool)
+	for i, item := range req.User {
+		user, err := newUser(ctx, item)
+		if err != nil {
+			results[i].Error = newBatchError(err)
+			continue
+		}
+		// users of the batch are not seen by checkUserConflict
+		if usernames[user.Username] {
+			results[i].Error = newBatchError(gerr.NewAlreadyExists(constants.TableUser, constants.ColumnUsername, user.Username))
+			continue
+		}
+		if user.Email != "" && emails[user.Email] {
+			results[i].Error = newBatchError(gerr.NewAlreadyExists(constants.TableUser, constants.ColumnEmail, user.Email))
+			continue
+		}
+		usernames[user.Username] = true
+		emails[user.Email] = true
+
+		results[i].Id = user.UserId
+		users = append(users, user)
+		indexes = append(indexes, i)
+	}
+
+	insertBatch(ctx, users, indexes, results, req.Atomic, nil)
+	syncBatchUserRuleGroups(ctx, results)
+	return &pb.BatchCreateUsersResponse{
+		ResultSet: results,
+	}, nil
+}
+
+func BatchCreateGroups(ctx context.Context, req *pb.BatchCreateGroupsRequest) (*pb.BatchCreateGroupsResponse, error) {
+	results := newBatchResults(len(req.Group))
+	var groups []interface{}
+	var indexes []int
+	namePaths := make(map[string]bool)
+	parentGroups := make(map[string]*models.Group)
+	children := make(map[string][]string)
+	for i, item := range req.Group {
+		group, err := newGroup(ctx, item)
+		if err != nil {
+			results[i].Error = newBatchError(err)
+			continue
+		}
+		// the items are checked before the insert to report the failed ones, and checked
+		// again by checkNewGroups once the parents are locked
+		if err := checkSiblingGroupName(ctx, global.Global().Database.DB, group.ParentGroupId, group.GroupName, ""); err != nil {
+			results[i].Error = newBatchError(err)
+			continue
+		}
+		// groups of the batch are not seen by checkSiblingGroupName, the names are compared
+		// case-insensitively as mysql does
+		namePath := strings.ToLower(group.GroupNamePath)
+		if global.Global().Config.UniqueGroupNames && namePaths[namePath] {
+			results[i].Error = newBatchError(gerr.NewAlreadyExists(constants.TableGroup, constants.ColumnGroupName, group.GroupName))
+			continue
+		}
+		// nor by checkGroupChildLimit
+		if parentGroupId := group.ParentGroupId; parentGroupId != "" {
+			if parentGroups[parentGroupId] == nil {
+				parentGroup, err := GetGroup(ctx, parentGroupId)
+				if err != nil {
+					results[i].Error = newBatchError(err)
+					continue
+				}
+				parentGroups[parentGroupId] = parentGroup
+			}
+			groupIds := append(append([]string{}, children[parentGroupId]...), group.GroupId)
+			if err := checkGroupChildLimit(ctx, global.Global().Database.DB, parentGroups[parentGroupId], groupIds); err != nil {
+				results[i].Error = newBatchError(err)
+				continue
+			}
+			children[parentGroupId] = groupIds
+		}
+		namePaths[namePath] = true
+		results[i].Id = group.GroupId
+		groups = append(groups, group)
+		indexes = append(indexes, i)
+	}
+
+	insertBatch(ctx, groups, indexes, results, req.Atomic, afterInsertBatchGroups)
+	for j, i := range indexes {
+		if results[i].Error == nil {
+			reconcileGroupRule(ctx, groups[j].(*models.Group))
+		}
+	}
+	return &pb.BatchCreateGroupsResponse{
+		ResultSet: results,
+	}, nil
+}
+
+func BatchModifyUsers(ctx context.Context, req *pb.BatchModifyUsersRequest) (*pb.BatchModifyUsersResponse, error) {
+	results := newBatchResults(len(req.User))
+	type userUpdates struct {
+		attributes map[string]interface{}
+		paths      []string
+	}
+	updates := make([]*userUpdates, len(req.User))
+	for i, item := range req.User {
+		attributes, paths, err := getUserUpdates(ctx, item)
+		if err != nil {
+			results[i].Error = newBatchError(err)
+			continue
+		}
+		results[i].Id = item.UserId
+		updates[i] = &userUpdates{attributes: attributes, paths: paths}
+	}
+
+	if !req.Atomic {
+		for i, item := range req.User {
+			if updates[i] == nil {
+				continue
+			}
+			tx := global.Global().Database.Begin()
+			err := updateUser(ctx, tx, item, updates[i].attributes, updates[i].paths)
+			if err == nil {
+				err = tx.Commit().Error
+			} else {
+				tx.Rollback()
+			}
+			if err != nil {
+				setBatchError(results[i], err)
+			}
+		}
+		syncBatchUserRuleGroups(ctx, results)
+		return &pb.BatchModifyUsersResponse{
+			ResultSet: results,
+		}, nil
+	}
+
+	if abortBatch(results) {
+		return &pb.BatchModifyUsersResponse{
+			ResultSet: results,
+		}, nil
+	}
+	tx := global.Global().Database.Begin()
+	for i, item := range req.User {
+		if err := updateUser(ctx, tx, item, updates[i].attributes, updates[i].paths); err != nil {
+			tx.Rollback()
+			setBatchError(results[i], err)
+			abortBatch(results)
+			return &pb.BatchModifyUsersResponse{
+				ResultSet: results,
+			}, nil
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf(ctx, "Modify users failed: %+v", err)
+		for _, result := range results {
+			setBatchError(result, err)
+		}
+	}
+	syncBatchUserRuleGroups(ctx, results)
+	return &pb.BatchModifyUsersResponse{
+		ResultSet: results,
+	}, nil
+}
+
+// insertBatch inserts the checked values in one transaction, values[i] is the item
+// indexes[i] of the batch. If a best-effort batch fails, the values are inserted
+// one by one so that only the failed items are reported. The optional after is run
+// in the same transaction to insert the rows derived from the values.
+func insertBatch(ctx context.Context, values []interface{}, indexes []int, results []*pb.BatchResult, atomic bool, after batchInsertHook) {
+	if atomic && abortBatch(results) {
+		return
+	}
+	if len(values) == 0 {
+		return
+	}
+
+	err := insertValues(ctx, values, after)
+	if err == nil {
+		return
+	}
+	if atomic {
+		for _, i := range indexes {
+			setBatchError(results[i], err)
+		}
+		return
+	}
+	for n, value := range values {
+		if err := insertValues(ctx, []interface{}{value}, after); err != nil {
+			logger.Errorf(ctx, "Insert item [%d] of batch failed: %+v", indexes[n], err)
+			setBatchError(results[indexes[n]], err)
+		}
+	}
+}
+
+type batchInsertHook func(ctx context.Context, tx *gorm.DB, values []interface{}) error
+
+func insertValues(ctx context.Context, values []interface{}, after batchInsertHook) error {
+	tx := global.Global().Database.Begin()
+	if err := db.BatchInsert(tx, values); err != nil {
+		tx.Rollback()
+		logger.Errorf(ctx, "Insert batch failed: %+v", err)
+		return err
+	}
+	if after != nil {
+		if err := after(ctx, tx, values); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf(ctx, "Insert batch failed: %+v", err)
+		return err
+	}
+	return nil
+}
+
+// afterInsertBatchGroups checks the limits of the inserted groups with their parents
+// locked and inserts their closures, in the transaction of the insert.
+func afterInsertBatchGroups(ctx context.Context, tx *gorm.DB, values []interface{}) error {
+	var groups []*models.Group
+	for _, value := range values {
+		groups = append(groups, value.(*models.Group))
+	}
+	if err := checkNewGroups(ctx, tx, groups); err != nil {
+		return err
+	}
+	return insertGroupClosures(ctx, tx, groups)
+}
+
+func newBatchResults(n int) []*pb.BatchResult {
+	results := make([]*pb.BatchResult, n)
+	for i := range results {
+		results[i] = &pb.BatchResult{Index: uint32(i)}
+	}
+	return results
+}
+
+// abortBatch marks the items without error as aborted if any item failed.
+func abortBatch(results []*pb.BatchResult) bool {
+	failed := false
+	for _, result := range results {
+		if result.Error != nil {
+			failed = true
+			break
+		}
+	}
+	if !failed {
+		return false
+	}
+	for _, result := range results {
+		if result.Error == nil {
+			setBatchError(result, errBatchAborted)
+		}
+	}
+	return true
+}
+
+func setBatchError(result *pb.BatchResult, err error) {
+	result.Id = ""
+	result.Error = newBatchError(err)
+}
+
+func newBatchError(err error) *pb.BatchError {
+	err = gerr.FromError(err)
+	s, _ := status.FromError(err)
+	batchError := &pb.BatchError{
+		Code:    s.Code().String(),
+		Message: s.Message(),
+	}
+	if info := gerr.GetErrorInfo(err); info != nil {
+		batchError.Reason = info.Reason
+		batchError.Metadata = info.Metadata
+	}
+	return batchError
+}
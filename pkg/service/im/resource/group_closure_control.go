@@ -0,0 +1,105 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/db"
+	"kubesphere.io/im/pkg/models"
+	"kubesphere.io/im/pkg/util/stringutil"
+)
+
+// attachGroupClosureSql pairs every ancestor of the parent with every group of the subtree
+const attachGroupClosureSql = "INSERT INTO group_closure (ancestor_group_id, descendant_group_id, depth) " +
+	"SELECT p.ancestor_group_id, s.descendant_group_id, p.depth + s.depth + 1 " +
+	"FROM group_closure p, group_closure s " +
+	"WHERE p.descendant_group_id = ? AND s.ancestor_group_id = ?"
+
+// insertGroupClosures inserts the closure rows of the new groups in the transaction,
+// the parents of the groups must already be in the closure.
+func insertGroupClosures(ctx context.Context, tx *gorm.DB, groups []*models.Group) error {
+	var parentGroupIds []string
+	for _, group := range groups {
+		if group.ParentGroupId != "" {
+			parentGroupIds = append(parentGroupIds, group.ParentGroupId)
+		}
+	}
+
+	parentClosures := make(map[string][]*models.GroupClosure)
+	if len(parentGroupIds) > 0 {
+		var closures []*models.GroupClosure
+		if err := tx.Table(constants.TableGroupClosure).
+			Where(constants.ColumnDescendantGroupId+" in (?)", stringutil.Unique(parentGroupIds)).
+			Find(&closures).Error; err != nil {
+			logger.Errorf(ctx, "Get ancestors of groups %v failed: %+v", parentGroupIds, err)
+			return err
+		}
+		for _, closure := range closures {
+			parentClosures[closure.DescendantGroupId] = append(parentClosures[closure.DescendantGroupId], closure)
+		}
+	}
+
+	var values []interface{}
+	for _, group := range groups {
+		for _, closure := range models.NewGroupClosures(group.GroupId, parentClosures[group.ParentGroupId]) {
+			values = append(values, closure)
+		}
+	}
+	if err := db.BatchInsert(tx, values); err != nil {
+		logger.Errorf(ctx, "Insert group closure failed: %+v", err)
+		return err
+	}
+	return nil
+}
+
+// getSubtreeGroupIds returns the groups and all of their descendants.
+func getSubtreeGroupIds(ctx context.Context, tx *gorm.DB, groupIds []string) ([]string, error) {
+	var subtreeGroupIds []string
+	if err := tx.Table(constants.TableGroupClosure).
+		Where(constants.ColumnAncestorGroupId+" in (?)", groupIds).
+		Pluck(constants.ColumnDescendantGroupId, &subtreeGroupIds).Error; err != nil {
+		logger.Errorf(ctx, "Get descendants of groups %v failed: %+v", groupIds, err)
+		return nil, err
+	}
+	return stringutil.Unique(subtreeGroupIds), nil
+}
+
+// moveGroupClosures detaches the subtree of the group from its old ancestors and
+// attaches it under the ancestors of the new parent, an empty parent makes it a root.
+func moveGroupClosures(ctx context.Context, tx *gorm.DB, groupId, parentGroupId string, subtreeGroupIds []string) error {
+	if err := tx.
+		Where(constants.ColumnDescendantGroupId+" in (?)", subtreeGroupIds).
+		Where(constants.ColumnAncestorGroupId+" not in (?)", subtreeGroupIds).
+		Delete(models.GroupClosure{}).Error; err != nil {
+		logger.Errorf(ctx, "Detach group [%s] from its ancestors failed: %+v", groupId, err)
+		return err
+	}
+	if parentGroupId == "" {
+		return nil
+	}
+
+	if err := tx.Exec(attachGroupClosureSql, parentGroupId, groupId).Error; err != nil {
+		logger.Errorf(ctx, "Attach group [%s] to group [%s] failed: %+v", groupId, parentGroupId, err)
+		return err
+	}
+	return nil
+}
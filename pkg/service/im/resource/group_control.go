@@ -18,117 +18,329 @@ package resource
 
 import (
 	"context"
-	"strings"
 	"time"
 
+	"github.com/jinzhu/gorm"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"openpitrix.io/logger"
 
 	"kubesphere.io/im/pkg/constants"
 	"kubesphere.io/im/pkg/db"
+	"kubesphere.io/im/pkg/gerr"
 	"kubesphere.io/im/pkg/global"
 	"kubesphere.io/im/pkg/models"
 	"kubesphere.io/im/pkg/pb"
-	"kubesphere.io/im/pkg/util/jsonutil"
 	"kubesphere.io/im/pkg/util/stringutil"
 )
 
 func CreateGroup(ctx context.Context, req *pb.CreateGroupRequest) (*pb.CreateGroupResponse, error) {
-	parentGroupId := stringutil.SimplifyString(req.ParentGroupId)
-	parentGroupPath, err := GetParentGroupPath(ctx, parentGroupId)
+	group, err := newGroup(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
-	group := models.NewGroup(parentGroupId, parentGroupPath, req.GroupName, req.Description, req.Extra)
-
-	var allParentGroupIds []string
-	// skip groupId
-	for _, groupId := range strings.Split(group.GroupPath, ".") {
-		if groupId != group.GroupId {
-			allParentGroupIds = append(allParentGroupIds, groupId)
+	// create new record
+	tx := global.Global().Database.Begin()
+	{
+		if err := checkNewGroups(ctx, tx, []*models.Group{group}); err != nil {
+			tx.Rollback()
+			return nil, err
 		}
-	}
-
-	// check all parent group id exists
-	if len(allParentGroupIds) > 0 {
-
-		var total int
-		if err := global.Global().Database.Table(constants.TableGroup).
-			Where(constants.ColumnGroupId+" in (?)", allParentGroupIds).
-			Count(&total).Error; err != nil {
-			logger.Errorf(ctx, "Get parent group ids failed: %+v", err)
+		if err := tx.Create(group).Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Insert group failed: %+v", err)
 			return nil, err
 		}
-		if total != len(allParentGroupIds) {
-			err := status.Errorf(codes.InvalidArgument,
-				"some groupId in allParentGroupIds (%q) do not exists",
-				group.GroupPath,
-			)
-			logger.Errorf(ctx, "%+v", err)
+		if err := insertGroupClosures(ctx, tx, []*models.Group{group}); err != nil {
+			tx.Rollback()
 			return nil, err
 		}
 	}
-
-	// create new record
-	if err := global.Global().Database.Create(group).Error; err != nil {
+	if err := tx.Commit().Error; err != nil {
 		logger.Errorf(ctx, "Insert group failed: %+v", err)
 		return nil, err
 	}
+	reconcileGroupRule(ctx, group)
 
 	return &pb.CreateGroupResponse{
 		GroupId: group.GroupId,
 	}, nil
 }
 
-func DeleteGroups(ctx context.Context, req *pb.DeleteGroupsRequest) (*pb.DeleteGroupsResponse, error) {
-	groupIds := req.GroupId
-	if len(groupIds) == 0 {
-		err := status.Errorf(codes.InvalidArgument, "empty group id")
-		logger.Errorf(ctx, "%+v", err)
+// newGroup checks the request and returns the group to be inserted.
+func newGroup(ctx context.Context, req *pb.CreateGroupRequest) (*models.Group, error) {
+	parentGroupId := stringutil.SimplifyString(req.ParentGroupId)
+	parentGroup, err := GetParentGroup(ctx, parentGroupId)
+	if err != nil {
+		return nil, err
+	}
+	parentGroupPath, parentGroupNamePath := "", ""
+	if parentGroup != nil {
+		parentGroupPath = parentGroup.GroupPath
+		parentGroupNamePath = parentGroup.GroupNamePath
+	}
+	if err := checkGroupName(ctx, req.GroupName); err != nil {
 		return nil, err
 	}
 
-	// 1. check sub groups
-	subGroupIds, err := getAllSubGroupIds(ctx, groupIds, constants.StatusActive)
+	group := models.NewGroup(parentGroupId, parentGroupPath, req.GroupName, req.Description, req.Extra)
+	group.GroupNamePath = models.GetGroupNamePath(parentGroupNamePath, group.GroupName)
+	group.InactivityExempt = req.InactivityExempt
+	if req.MembershipRule != "" {
+		if _, err := parseMembershipRule(ctx, constants.ColumnMembershipRule, req.MembershipRule); err != nil {
+			return nil, err
+		}
+		group.MembershipRule = req.MembershipRule
+	}
+	group.Extra, err = encodeExtra(ctx, constants.TableGroup, group.GroupId, req.Extra)
 	if err != nil {
 		return nil, err
 	}
-	if len(subGroupIds) > 0 {
-		err := status.Errorf(codes.PermissionDenied, "there are still sub groups %v in group: %v", subGroupIds, groupIds)
+
+	// the parent exists, so do all of its ancestors in the closure
+	if len(group.GroupPath) > maxGroupPathLength {
+		err := gerr.NewInvalidArgument("parent_group_id", "group path exceeds %d characters", maxGroupPathLength)
 		logger.Errorf(ctx, "%+v", err)
 		return nil, err
 	}
+	if err := checkGroupNamePathLength(ctx, constants.ColumnGroupName, group.GroupId, group.GroupNamePath); err != nil {
+		return nil, err
+	}
 
-	// 2. check users
-	users, err := GetUserIdsByGroupIds(ctx, groupIds)
-	if err != nil {
+	// the sibling names and the limits are checked by checkNewGroups once the parent is locked
+	group.MaxMembers = int(req.MaxMembers)
+	group.MaxChildGroups = int(req.MaxChildGroups)
+	group.MaxDepth = int(req.MaxDepth)
+
+	return group, nil
+}
+
+func DeleteGroups(ctx context.Context, req *pb.DeleteGroupsRequest) (*pb.DeleteGroupsResponse, error) {
+	groupIds := req.GroupId
+	if len(groupIds) == 0 {
+		err := gerr.NewInvalidArgument("group_id", "empty group id")
+		logger.Errorf(ctx, "%+v", err)
 		return nil, err
 	}
-	if len(users) > 0 {
-		err := status.Errorf(codes.PermissionDenied, "there are still users in group: %v", subGroupIds)
+	moveMembersTo := stringutil.SimplifyString(req.MoveMembersTo)
+	if moveMembersTo != "" && !req.Cascade {
+		err := gerr.NewInvalidArgument("move_members_to", "members can only be moved with cascade")
 		logger.Errorf(ctx, "%+v", err)
 		return nil, err
 	}
 
-	// 3. update group status to deleted
-	now := time.Now()
-	attributes := map[string]interface{}{
-		constants.ColumnStatusTime: now,
-		constants.ColumnUpdateTime: now,
-		constants.ColumnStatus:     constants.StatusDeleted,
+	var response *pb.DeleteGroupsResponse
+	tx := global.Global().Database.Begin()
+	{
+		// 1. check sub groups, the groups are locked so that no sub group or member is added meanwhile
+		if _, err := lockGroups(ctx, tx, groupIds); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		subGroups, err := getClosureGroups(ctx, tx, constants.ColumnAncestorGroupId, groupIds)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		var subGroupIds []string
+		for _, subGroup := range subGroups {
+			if !stringutil.Contains(groupIds, subGroup.GroupId) {
+				subGroupIds = append(subGroupIds, subGroup.GroupId)
+			}
+		}
+		subGroupIds = stringutil.Unique(subGroupIds)
+		if len(subGroupIds) > 0 && !req.Cascade {
+			tx.Rollback()
+			err := status.Errorf(codes.PermissionDenied, "there are still sub groups %v in group: %v", subGroupIds, groupIds)
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+		if len(subGroupIds) > 0 {
+			if _, err := lockGroups(ctx, tx, subGroupIds); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
+		allGroupIds := append(append([]string{}, groupIds...), subGroupIds...)
+
+		// 2. check users
+		var bindings []*models.UserGroupBinding
+		if err := activeBindings(tx.Table(constants.TableUserGroupBinding)).
+			Where(constants.ColumnGroupId+" in (?)", allGroupIds).
+			Order(constants.ColumnCreateTime).
+			Find(&bindings).Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Get user group binding failed: %+v", err)
+			return nil, err
+		}
+		if len(bindings) > 0 && !req.Cascade {
+			tx.Rollback()
+			err := status.Errorf(codes.PermissionDenied, "there are still users in group: %v", groupIds)
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+		if moveMembersTo != "" {
+			if err := checkMoveMembersTo(ctx, moveMembersTo, allGroupIds); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
+
+		// the groups leave the groups they are nested in and lose their member groups
+		var members []*models.GroupMember
+		if err := tx.Table(constants.TableGroupMember).
+			Where(constants.ColumnGroupId+" in (?) OR "+constants.ColumnMemberGroupId+" in (?)", allGroupIds, allGroupIds).
+			Order(constants.ColumnCreateTime).
+			Find(&members).Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Get group member failed: %+v", err)
+			return nil, err
+		}
+
+		response = &pb.DeleteGroupsResponse{
+			GroupId: allGroupIds,
+			DryRun:  req.DryRun,
+		}
+		for _, binding := range bindings {
+			response.BindingSet = append(response.BindingSet, binding.ToPB())
+		}
+		for _, member := range members {
+			response.GroupMemberSet = append(response.GroupMemberSet, member.ToPB())
+		}
+		if req.DryRun {
+			tx.Rollback()
+			return response, nil
+		}
+
+		// 3. update group status to deleted and remove the members
+		now := time.Now()
+		attributes := map[string]interface{}{
+			constants.ColumnStatusTime: now,
+			constants.ColumnUpdateTime: now,
+			constants.ColumnStatus:     constants.StatusDeleted,
+		}
+		if err := tx.Table(constants.TableGroup).
+			Where(constants.ColumnGroupId+" in (?)", allGroupIds).
+			Updates(attributes).Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Update group status failed: %+v", err)
+			return nil, err
+		}
+
+		if len(members) > 0 {
+			if err := tx.
+				Where(constants.ColumnGroupId+" in (?) OR "+constants.ColumnMemberGroupId+" in (?)", allGroupIds, allGroupIds).
+				Delete(models.GroupMember{}).Error; err != nil {
+				tx.Rollback()
+				logger.Errorf(ctx, "Delete group member failed: %+v", err)
+				return nil, err
+			}
+		}
+
+		if len(bindings) > 0 {
+			if err := removeGroupMembers(ctx, tx, allGroupIds, bindings, moveMembersTo); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
 	}
-	if err := global.Global().Database.Table(constants.TableGroup).
-		Where(constants.ColumnGroupId+" in (?)", groupIds).
-		Updates(attributes).Error; err != nil {
-		logger.Errorf(ctx, "Update group status failed: %+v", err)
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf(ctx, "Delete groups %v failed: %+v", response.GroupId, err)
 		return nil, err
 	}
+	resetNestedGroupCache()
 
-	return &pb.DeleteGroupsResponse{
-		GroupId: groupIds,
-	}, nil
+	return response, nil
+}
+
+// checkMoveMembersTo checks that the members of the deleted groups can join the group.
+func checkMoveMembersTo(ctx context.Context, groupId string, deletedGroupIds []string) error {
+	if stringutil.Contains(deletedGroupIds, groupId) {
+		err := gerr.NewInvalidArgument("move_members_to", "group [%s] is being deleted", groupId)
+		logger.Errorf(ctx, "%+v", err)
+		return err
+	}
+	group, err := GetGroup(ctx, groupId)
+	if err != nil {
+		err = gerr.NewInvalidArgument("move_members_to", "get group failed: %v", err)
+		logger.Errorf(ctx, "%+v", err)
+		return err
+	}
+	if group.Status != constants.StatusActive {
+		err := gerr.NewInvalidArgument("move_members_to", "group [%s] is %s", groupId, group.Status)
+		logger.Errorf(ctx, "%+v", err)
+		return err
+	}
+	if group.MembershipRule != "" {
+		err := gerr.NewInvalidArgument("move_members_to", "members of dynamic group [%s] are managed by its rule", groupId)
+		logger.Errorf(ctx, "%+v", err)
+		return err
+	}
+	return nil
+}
+
+// removeGroupMembers deletes the bindings of the groups in the transaction,
+// the users join the group moveMembersTo if it is not empty.
+func removeGroupMembers(ctx context.Context, tx *gorm.DB, groupIds []string, bindings []*models.UserGroupBinding, moveMembersTo string) error {
+	if err := tx.Delete(models.UserGroupBinding{}, constants.ColumnGroupId+" in (?)", groupIds).Error; err != nil {
+		logger.Errorf(ctx, "Delete user group binding failed: %+v", err)
+		return err
+	}
+	if moveMembersTo == "" {
+		return nil
+	}
+
+	groups, err := getGroupsForUpdate(ctx, tx, []string{moveMembersTo})
+	if err != nil {
+		return err
+	}
+	var userIds []string
+	for _, binding := range bindings {
+		userIds = append(userIds, binding.UserId)
+	}
+	if err := removeExpiredBindings(ctx, tx, userIds, []string{moveMembersTo}); err != nil {
+		return err
+	}
+	var memberIds []string
+	if err := tx.Table(constants.TableUserGroupBinding).
+		Where(constants.ColumnGroupId+" = ?", moveMembersTo).
+		Pluck(constants.ColumnUserId, &memberIds).Error; err != nil {
+		logger.Errorf(ctx, "Get users of group [%s] failed: %+v", moveMembersTo, err)
+		return err
+	}
+	var moved []*models.UserGroupBinding
+	for _, binding := range bindings {
+		if stringutil.Contains(memberIds, binding.UserId) {
+			continue
+		}
+		movedBinding := models.NewUserGroupBinding(binding.UserId, moveMembersTo)
+		movedBinding.ExpireTime = binding.ExpireTime
+		moved = append(moved, movedBinding)
+		memberIds = append(memberIds, binding.UserId)
+	}
+	if err := checkGroupMemberLimits(ctx, tx, groups, len(moved)); err != nil {
+		return err
+	}
+	for _, binding := range moved {
+		if err := tx.Create(binding).Error; err != nil {
+			logger.Errorf(ctx, "Insert user group binding failed: %+v", err)
+			return err
+		}
+	}
+	return nil
+}
+
+var groupUpdatePaths = []string{
+	constants.ColumnParentGroupId,
+	constants.ColumnGroupName,
+	constants.ColumnDescription,
+	constants.ColumnExtra,
+	constants.ColumnInactivityExempt,
+	constants.ColumnMembershipRule,
+	constants.ColumnMaxMembers,
+	constants.ColumnMaxChildGroups,
+	constants.ColumnMaxDepth,
 }
 
 func ModifyGroup(ctx context.Context, req *pb.ModifyGroupRequest) (*pb.ModifyGroupResponse, error) {
@@ -138,58 +350,156 @@ func ModifyGroup(ctx context.Context, req *pb.ModifyGroupRequest) (*pb.ModifyGro
 		return nil, err
 	}
 
-	attributes := make(map[string]interface{})
-	if req.ParentGroupId != "" && req.ParentGroupId != group.ParentGroupId {
-		group.ParentGroupId = req.ParentGroupId
-		parentGroupPath, err := GetParentGroupPath(ctx, group.ParentGroupId)
-		if err != nil {
-			return nil, err
-		}
-		groupPath := models.GetGroupPath(parentGroupPath, group.GroupId)
-		attributes[constants.ColumnParentGroupId] = req.ParentGroupId
-		attributes[constants.ColumnGroupPath] = groupPath
-		attributes[constants.ColumnGroupPathLevel] = strings.Count(stringutil.SimplifyString(groupPath), constants.GroupPathSep) + 1
+	var defaultPaths []string
+	if req.ParentGroupId != "" {
+		defaultPaths = append(defaultPaths, constants.ColumnParentGroupId)
 	}
 	if req.GroupName != "" {
-		attributes[constants.ColumnGroupName] = req.GroupName
+		defaultPaths = append(defaultPaths, constants.ColumnGroupName)
 	}
 	if req.Description != "" {
-		attributes[constants.ColumnDescription] = req.Description
+		defaultPaths = append(defaultPaths, constants.ColumnDescription)
 	}
 	if len(req.Extra) > 0 {
-		attributes[constants.ColumnExtra] = stringutil.NewString(jsonutil.ToString(req.Extra))
+		defaultPaths = append(defaultPaths, constants.ColumnExtra)
+	}
+	if req.InactivityExempt {
+		defaultPaths = append(defaultPaths, constants.ColumnInactivityExempt)
+	}
+	if req.MembershipRule != "" {
+		defaultPaths = append(defaultPaths, constants.ColumnMembershipRule)
+	}
+	if req.MaxMembers > 0 {
+		defaultPaths = append(defaultPaths, constants.ColumnMaxMembers)
+	}
+	if req.MaxChildGroups > 0 {
+		defaultPaths = append(defaultPaths, constants.ColumnMaxChildGroups)
+	}
+	if req.MaxDepth > 0 {
+		defaultPaths = append(defaultPaths, constants.ColumnMaxDepth)
+	}
+	paths, err := getUpdatePaths(ctx, req.UpdateMask, groupUpdatePaths, defaultPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make(map[string]interface{})
+	if stringutil.Contains(paths, constants.ColumnGroupName) {
+		if req.GroupName == "" {
+			err := gerr.NewInvalidArgument(constants.ColumnGroupName, "empty group name")
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+		if err := checkGroupName(ctx, req.GroupName); err != nil {
+			return nil, err
+		}
+	}
+	// the name is written with the name paths of the subtree
+	renamed := stringutil.Contains(paths, constants.ColumnGroupName) && req.GroupName != group.GroupName
+	moved := stringutil.Contains(paths, constants.ColumnParentGroupId) && req.ParentGroupId != group.ParentGroupId
+	if stringutil.Contains(paths, constants.ColumnDescription) {
+		attributes[constants.ColumnDescription] = req.Description
+	}
+	if stringutil.Contains(paths, constants.ColumnInactivityExempt) {
+		attributes[constants.ColumnInactivityExempt] = req.InactivityExempt
+	}
+	// lowered limits only stop the groups from growing further
+	if stringutil.Contains(paths, constants.ColumnMaxMembers) {
+		attributes[constants.ColumnMaxMembers] = req.MaxMembers
+	}
+	if stringutil.Contains(paths, constants.ColumnMaxChildGroups) {
+		attributes[constants.ColumnMaxChildGroups] = req.MaxChildGroups
+	}
+	if stringutil.Contains(paths, constants.ColumnMaxDepth) {
+		attributes[constants.ColumnMaxDepth] = req.MaxDepth
+	}
+	// an empty rule turns the group static and keeps its current members
+	ruleChanged := stringutil.Contains(paths, constants.ColumnMembershipRule) && req.MembershipRule != group.MembershipRule
+	if ruleChanged {
+		if req.MembershipRule != "" {
+			if _, err := parseMembershipRule(ctx, constants.ColumnMembershipRule, req.MembershipRule); err != nil {
+				return nil, err
+			}
+		}
+		attributes[constants.ColumnMembershipRule] = req.MembershipRule
 	}
 	attributes[constants.ColumnUpdateTime] = time.Now()
 
-	if err := global.Global().Database.Table(constants.TableGroup).
-		Where(constants.ColumnGroupId+" = ?", groupId).
-		Updates(attributes).Error; err != nil {
+	tx := global.Global().Database.Begin()
+	{
+		if renamed {
+			if err := renameGroup(ctx, tx, group, req.GroupName); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
+		// the group is moved with its new name, which must be unique under the new parent
+		if moved {
+			if _, _, err := moveGroup(ctx, tx, groupId, req.ParentGroupId); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		} else if renamed {
+			if err := checkSiblingGroupName(ctx, tx, group.ParentGroupId, req.GroupName, groupId); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
+
+		if hasExtraPath(paths) {
+			extra, err := mergeExtra(ctx, tx, constants.TableGroup, groupId, req.Extra, paths)
+			if err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+			attributes[constants.ColumnExtra] = extra
+		}
+
+		if err := tx.Table(constants.TableGroup).
+			Where(constants.ColumnGroupId+" = ?", groupId).
+			Updates(attributes).Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Update group [%s] failed: %+v", groupId, err)
+			return nil, err
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
 		logger.Errorf(ctx, "Update group [%s] failed: %+v", groupId, err)
 		return nil, err
 	}
+	if moved {
+		resetNestedGroupCache()
+	}
+	if ruleChanged {
+		group.MembershipRule = req.MembershipRule
+		reconcileGroupRule(ctx, group)
+	}
 
 	return &pb.ModifyGroupResponse{
 		GroupId: groupId,
 	}, nil
 }
 
-func GetParentGroupPath(ctx context.Context, parentGroupId string) (string, error) {
-	parentGroupPath := ""
-	if parentGroupId != "" {
-		parentGroup, err := GetGroup(ctx, parentGroupId)
-		if err != nil {
-			err = status.Errorf(codes.InvalidArgument, "get parent group failed: %v", err)
-			logger.Errorf(ctx, "%+v", err)
-			return parentGroupPath, err
-		}
-		parentGroupPath = parentGroup.GroupPath
+// GetParentGroup returns the parent group, or nil for a root group.
+func GetParentGroup(ctx context.Context, parentGroupId string) (*models.Group, error) {
+	if parentGroupId == "" {
+		return nil, nil
 	}
-	return parentGroupPath, nil
+	parentGroup, err := GetGroup(ctx, parentGroupId)
+	if err != nil {
+		err = gerr.NewInvalidArgument("parent_group_id", "get parent group failed: %v", err)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	return parentGroup, nil
 }
 
 func GetGroup(ctx context.Context, groupId string) (*models.Group, error) {
 	var group = &models.Group{GroupId: groupId}
 	if err := global.Global().Database.Table(constants.TableGroup).Take(group).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			err = gerr.NewNotFound(constants.TableGroup, groupId)
+		}
 		logger.Errorf(ctx, "Get group [%s] failed: %+v", groupId, err)
 		return nil, err
 	}
@@ -197,32 +507,70 @@ func GetGroup(ctx context.Context, groupId string) (*models.Group, error) {
 	return group, nil
 }
 
-func GetGroupWithUser(ctx context.Context, groupId string) (*models.GroupWithUser, error) {
+// GetGroupWithUser returns the group with its users, expandNested includes the users
+// of the sub groups and the nested member groups.
+func GetGroupWithUser(ctx context.Context, groupId string, expandNested bool) (*models.GroupWithUser, error) {
 	group, err := GetGroup(ctx, groupId)
 	if err != nil {
 		return nil, err
 	}
-	users, err := GetUsersByGroupIds(ctx, []string{groupId})
+	groupIds := []string{groupId}
+	if expandNested {
+		paths, err := getNestedGroupPaths(ctx, global.Global().Database.DB, groupId)
+		if err != nil {
+			return nil, err
+		}
+		for nestedGroupId := range paths {
+			if nestedGroupId != groupId {
+				groupIds = append(groupIds, nestedGroupId)
+			}
+		}
+	}
+	users, err := GetUsersByGroupIds(ctx, groupIds)
+	if err != nil {
+		return nil, err
+	}
+	memberGroups, err := GetMemberGroups(ctx, groupId)
 	if err != nil {
 		return nil, err
 	}
 	return &models.GroupWithUser{
-		Group: group,
-		Users: users,
+		Group:        group,
+		Users:        uniqueUsers(users),
+		MemberGroups: memberGroups,
 	}, nil
 }
 
+// uniqueUsers drops the users joined more than once, a user may be in several groups.
+func uniqueUsers(users []*models.User) []*models.User {
+	seen := make(map[string]bool)
+	var unique []*models.User
+	for _, user := range users {
+		if !seen[user.UserId] {
+			seen[user.UserId] = true
+			unique = append(unique, user)
+		}
+	}
+	return unique
+}
+
 func ListGroups(ctx context.Context, req *pb.ListGroupsRequest) (*pb.ListGroupsResponse, error) {
 	req.RootGroupId = stringutil.SimplifyStringList(req.RootGroupId)
 	req.ParentGroupId = stringutil.SimplifyStringList(req.ParentGroupId)
 	req.GroupId = stringutil.SimplifyStringList(req.GroupId)
 	req.GroupPath = stringutil.SimplifyStringList(req.GroupPath)
+	req.GroupNamePath = normalizeGroupNamePaths(req.GroupNamePath)
 	req.GroupName = stringutil.SimplifyStringList(req.GroupName)
 	req.Status = stringutil.SimplifyStringList(req.Status)
 
 	limit := db.GetLimitFromRequest(req)
 	offset := db.GetOffsetFromRequest(req)
 
+	extraConditions, err := getExtraConditions(ctx, constants.TableGroup, req.AttributeFilter)
+	if err != nil {
+		return nil, err
+	}
+
 	var groups []*models.Group
 	var count int
 
@@ -230,6 +578,7 @@ func ListGroups(ctx context.Context, req *pb.ListGroupsRequest) (*pb.ListGroupsR
 		AddQueryOrderDir(req, constants.ColumnCreateTime).
 		BuildFilterConditions(req, constants.TableGroup).
 		BuildRootGroupIdConditions(req.GetRootGroupId()).
+		BuildExtraConditions(extraConditions).
 		Offset(offset).
 		Limit(limit).
 		Find(&groups).Error; err != nil {
@@ -240,6 +589,7 @@ func ListGroups(ctx context.Context, req *pb.ListGroupsRequest) (*pb.ListGroupsR
 	if err := db.GetChain(global.Global().Database.Table(constants.TableGroup)).
 		BuildFilterConditions(req, constants.TableGroup).
 		BuildRootGroupIdConditions(req.GetRootGroupId()).
+		BuildExtraConditions(extraConditions).
 		Count(&count).Error; err != nil {
 		logger.Errorf(ctx, "List group count failed: %+v", err)
 		return nil, err
@@ -288,12 +638,14 @@ func ListGroupsWithUser(ctx context.Context, req *pb.ListGroupsRequest) (*pb.Lis
 func getAllSubGroupIds(ctx context.Context, groupIds []string, status ...string) ([]string, error) {
 	var groups []*models.Group
 
-	tx := global.Global().Database.Table(constants.TableGroup)
-	for _, groupId := range groupIds {
-		likeGroupId := "%" + groupId + "%"
-		tx = tx.Or(constants.ColumnGroupPath+" LIKE ?", likeGroupId)
+	tx := global.Global().Database.Table(constants.TableGroup).
+		Select("`group`.*").
+		Joins("JOIN `group_closure` on `group_closure`.descendant_group_id=`group`.group_id").
+		Where("`group_closure`.ancestor_group_id in (?) AND `group_closure`.depth > 0", groupIds)
+	if len(status) > 0 {
+		tx = tx.Where("`group`.status in (?)", status)
 	}
-	if err := tx.Find(&groups).Error; err != nil {
+	if err := tx.Scan(&groups).Error; err != nil {
 		logger.Errorf(ctx, "Get all sub groups failed: %+v", err)
 		return nil, err
 	}
@@ -301,11 +653,9 @@ func getAllSubGroupIds(ctx context.Context, groupIds []string, status ...string)
 	var allGroupId []string
 	for _, group := range groups {
 		if !stringutil.Contains(groupIds, group.GroupId) {
-			if len(status) == 0 || stringutil.Contains(status, group.Status) {
-				allGroupId = append(allGroupId, group.GroupId)
-			}
+			allGroupId = append(allGroupId, group.GroupId)
 		}
 	}
 
-	return allGroupId, nil
+	return stringutil.Unique(allGroupId), nil
 }
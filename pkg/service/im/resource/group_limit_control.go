@@ -0,0 +1,187 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/gerr"
+	"kubesphere.io/im/pkg/global"
+	"kubesphere.io/im/pkg/models"
+)
+
+// getGroupLimit returns the limit of the group, the override or else the default.
+func getGroupLimit(override, defaultLimit int) int {
+	if override > 0 {
+		return override
+	}
+	return defaultLimit
+}
+
+func newGroupLimitError(code codes.Code, groupId, limit string, value int, format string, a ...interface{}) error {
+	reason := gerr.ReasonFailedPrecondition
+	if code == codes.ResourceExhausted {
+		reason = gerr.ReasonResourceExhausted
+	}
+	return gerr.New(code, reason, map[string]string{
+		gerr.MetadataResource: constants.TableGroup,
+		gerr.MetadataId:       groupId,
+		gerr.MetadataLimit:    limit,
+		gerr.MetadataValue:    strconv.Itoa(value),
+	}, format, a...)
+}
+
+// checkGroupMemberLimits checks that the groups can take count more users,
+// the groups must be locked by the transaction.
+func checkGroupMemberLimits(ctx context.Context, tx *gorm.DB, groups []*models.Group, count int) error {
+	var groupIds []string
+	for _, group := range groups {
+		groupIds = append(groupIds, group.GroupId)
+	}
+	var memberCounts []*groupCount
+	if err := activeBindings(tx.Table(constants.TableUserGroupBinding)).
+		Select(constants.ColumnGroupId+", COUNT(*) AS count").
+		Where(constants.ColumnGroupId+" in (?)", groupIds).
+		Group(constants.ColumnGroupId).
+		Scan(&memberCounts).Error; err != nil {
+		logger.Errorf(ctx, "Count group members failed: %+v", err)
+		return err
+	}
+	counts := make(map[string]int)
+	for _, c := range memberCounts {
+		counts[c.GroupId] = int(c.Count)
+	}
+
+	for _, group := range groups {
+		limit := getGroupLimit(group.MaxMembers, global.Global().Config.GroupLimit.MaxMembers)
+		if limit > 0 && counts[group.GroupId]+count > limit {
+			err := newGroupLimitError(codes.ResourceExhausted, group.GroupId, constants.ColumnMaxMembers, limit,
+				"group [%s] has %d members, adding %d exceeds the limit %d", group.GroupId, counts[group.GroupId], count, limit)
+			logger.Errorf(ctx, "%+v", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// checkGroupChildLimit checks that the parent group can take the groups as sub groups,
+// the groups are not counted twice if they are inserted already by the transaction.
+func checkGroupChildLimit(ctx context.Context, tx *gorm.DB, parentGroup *models.Group, groupIds []string) error {
+	limit := getGroupLimit(parentGroup.MaxChildGroups, global.Global().Config.GroupLimit.MaxChildGroups)
+	if limit <= 0 {
+		return nil
+	}
+	var count int
+	if err := tx.Table(constants.TableGroup).
+		Where(constants.ColumnParentGroupId+" = ?", parentGroup.GroupId).
+		Where(constants.ColumnStatus+" = ?", constants.StatusActive).
+		Where(constants.ColumnGroupId+" not in (?)", groupIds).
+		Count(&count).Error; err != nil {
+		logger.Errorf(ctx, "Count sub groups of group [%s] failed: %+v", parentGroup.GroupId, err)
+		return err
+	}
+	if count+len(groupIds) > limit {
+		err := newGroupLimitError(codes.ResourceExhausted, parentGroup.GroupId, constants.ColumnMaxChildGroups, limit,
+			"group [%s] has %d sub groups, adding %d exceeds the limit %d", parentGroup.GroupId, count, len(groupIds), limit)
+		logger.Errorf(ctx, "%+v", err)
+		return err
+	}
+	return nil
+}
+
+// checkNewGroups locks the parents of the new groups in the transaction and checks the
+// sibling names and the sub group and depth limits, the parents exist before the groups
+// are inserted.
+func checkNewGroups(ctx context.Context, tx *gorm.DB, groups []*models.Group) error {
+	children := make(map[string][]string)
+	for _, group := range groups {
+		if group.ParentGroupId != "" {
+			children[group.ParentGroupId] = append(children[group.ParentGroupId], group.GroupId)
+		}
+	}
+	var parentGroupIds []string
+	for parentGroupId := range children {
+		parentGroupIds = append(parentGroupIds, parentGroupId)
+	}
+	parentGroups, err := getGroupsForUpdate(ctx, tx, parentGroupIds)
+	if err != nil {
+		err = gerr.NewInvalidArgument(constants.ColumnParentGroupId, "get parent group failed: %v", err)
+		logger.Errorf(ctx, "%+v", err)
+		return err
+	}
+
+	parentGroupPaths := make(map[string]string)
+	for _, parentGroup := range parentGroups {
+		if parentGroup.Status == constants.StatusDeleted {
+			err := gerr.NewInvalidArgument(constants.ColumnParentGroupId, "parent group [%s] has been deleted", parentGroup.GroupId)
+			logger.Errorf(ctx, "%+v", err)
+			return err
+		}
+		if err := checkGroupChildLimit(ctx, tx, parentGroup, children[parentGroup.GroupId]); err != nil {
+			return err
+		}
+		parentGroupPaths[parentGroup.GroupId] = parentGroup.GroupPath
+	}
+	for _, group := range groups {
+		if err := checkSiblingGroupName(ctx, tx, group.ParentGroupId, group.GroupName, group.GroupId); err != nil {
+			return err
+		}
+		if err := checkGroupDepthLimits(ctx, tx, parentGroupPaths[group.ParentGroupId], group, 1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkGroupDepthLimits checks that a subtree of the height put under the parent path keeps
+// within the depth limit of each ancestor, the root of the tree falls back to the default.
+// The subtree root is passed as group, it becomes the tree root if the parent path is empty.
+func checkGroupDepthLimits(ctx context.Context, tx *gorm.DB, parentGroupPath string, group *models.Group, height int) error {
+	var ancestors []*models.Group
+	if parentGroupPath != "" {
+		if err := tx.Table(constants.TableGroup).
+			Where(constants.ColumnGroupId+" in (?)", strings.Split(parentGroupPath, constants.GroupPathSep)).
+			Order(constants.ColumnGroupPathLevel).
+			Find(&ancestors).Error; err != nil {
+			logger.Errorf(ctx, "Get ancestors of group [%s] failed: %+v", group.GroupId, err)
+			return err
+		}
+	}
+	chain := append(ancestors, group)
+
+	for i, ancestor := range chain {
+		limit := ancestor.MaxDepth
+		if i == 0 {
+			limit = getGroupLimit(limit, global.Global().Config.GroupLimit.MaxDepth)
+		}
+		depth := len(chain) - 1 - i + height
+		if limit > 0 && depth > limit {
+			err := newGroupLimitError(codes.FailedPrecondition, ancestor.GroupId, constants.ColumnMaxDepth, limit,
+				"group tree under [%s] would be %d levels deep, the limit is %d", ancestor.GroupId, depth, limit)
+			logger.Errorf(ctx, "%+v", err)
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"sort"
+
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/gerr"
+	"kubesphere.io/im/pkg/global"
+	"kubesphere.io/im/pkg/models"
+	"kubesphere.io/im/pkg/pb"
+	"kubesphere.io/im/pkg/util/stringutil"
+)
+
+func AddGroupMembers(ctx context.Context, req *pb.AddGroupMembersRequest) (*pb.AddGroupMembersResponse, error) {
+	groupId := req.GroupId
+	memberGroupIds := stringutil.Unique(req.MemberGroupId)
+
+	tx := global.Global().Database.Begin()
+	{
+		// the groups are locked in the order of their ids, so that concurrent
+		// requests can not make two groups members of each other
+		lockGroupIds := append([]string{groupId}, memberGroupIds...)
+		sort.Strings(lockGroupIds)
+		groups := make(map[string]*models.Group)
+		for _, lockGroupId := range stringutil.Unique(lockGroupIds) {
+			group, err := getGroupForUpdate(ctx, tx, lockGroupId)
+			if err != nil {
+				tx.Rollback()
+				if lockGroupId != groupId {
+					err = gerr.NewInvalidArgument(constants.ColumnMemberGroupId, "get member group failed: %v", err)
+					logger.Errorf(ctx, "%+v", err)
+				}
+				return nil, err
+			}
+			groups[lockGroupId] = group
+		}
+		group := groups[groupId]
+		if group.Status != constants.StatusActive {
+			tx.Rollback()
+			err := status.Errorf(codes.FailedPrecondition, "group [%s] is %s", groupId, group.Status)
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+
+		var boundGroupIds []string
+		if err := tx.Table(constants.TableGroupMember).
+			Where(constants.ColumnGroupId+" = ?", groupId).
+			Where(constants.ColumnMemberGroupId+" in (?)", memberGroupIds).
+			Pluck(constants.ColumnMemberGroupId, &boundGroupIds).Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Get member groups of group [%s] failed: %+v", groupId, err)
+			return nil, err
+		}
+		if len(boundGroupIds) > 0 {
+			tx.Rollback()
+			err := gerr.NewAlreadyExists(constants.TableGroupMember, constants.ColumnMemberGroupId, boundGroupIds[0])
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+
+		for _, memberGroupId := range memberGroupIds {
+			if err := checkGroupMember(ctx, tx, groupId, groups[memberGroupId]); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+			if err := tx.Create(models.NewGroupMember(groupId, memberGroupId)).Error; err != nil {
+				tx.Rollback()
+				logger.Errorf(ctx, "Insert group member failed: %+v", err)
+				return nil, err
+			}
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf(ctx, "Add members to group [%s] failed: %+v", groupId, err)
+		return nil, err
+	}
+	resetNestedGroupCache()
+
+	return &pb.AddGroupMembersResponse{
+		GroupId:       groupId,
+		MemberGroupId: memberGroupIds,
+	}, nil
+}
+
+// checkGroupMember checks that the member group is active and that the group is not
+// reachable from it, or the membership would be a cycle; both groups are locked by tx.
+func checkGroupMember(ctx context.Context, tx *gorm.DB, groupId string, memberGroup *models.Group) error {
+	memberGroupId := memberGroup.GroupId
+	if memberGroup.Status != constants.StatusActive {
+		err := gerr.NewInvalidArgument(constants.ColumnMemberGroupId, "member group [%s] is %s", memberGroupId, memberGroup.Status)
+		logger.Errorf(ctx, "%+v", err)
+		return err
+	}
+
+	nestedGroupPaths, err := getNestedGroupPaths(ctx, tx, memberGroupId)
+	if err != nil {
+		return err
+	}
+	if path, ok := nestedGroupPaths[groupId]; ok {
+		err := gerr.NewInvalidArgument(constants.ColumnMemberGroupId,
+			"group [%s] can not be a member of group [%s], it contains the group through %v", memberGroupId, groupId, path)
+		logger.Errorf(ctx, "%+v", err)
+		return err
+	}
+	return nil
+}
+
+func RemoveGroupMembers(ctx context.Context, req *pb.RemoveGroupMembersRequest) (*pb.RemoveGroupMembersResponse, error) {
+	groupId := req.GroupId
+	memberGroupIds := stringutil.Unique(req.MemberGroupId)
+
+	var count int
+	if err := global.Global().Database.Table(constants.TableGroupMember).
+		Where(constants.ColumnGroupId+" = ?", groupId).
+		Where(constants.ColumnMemberGroupId+" in (?)", memberGroupIds).
+		Count(&count).Error; err != nil {
+		logger.Errorf(ctx, "Get member groups of group [%s] failed: %+v", groupId, err)
+		return nil, err
+	}
+	if count != len(memberGroupIds) {
+		err := status.Errorf(codes.PermissionDenied, "group not member of group [%s]", groupId)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	if err := global.Global().Database.
+		Where(constants.ColumnGroupId+" = ?", groupId).
+		Where(constants.ColumnMemberGroupId+" in (?)", memberGroupIds).
+		Delete(models.GroupMember{}).Error; err != nil {
+		logger.Errorf(ctx, "Delete group member failed: %+v", err)
+		return nil, err
+	}
+	resetNestedGroupCache()
+
+	return &pb.RemoveGroupMembersResponse{
+		GroupId:       groupId,
+		MemberGroupId: memberGroupIds,
+	}, nil
+}
+
+// GetMemberGroups returns the active groups bound as members of the group.
+func GetMemberGroups(ctx context.Context, groupId string) ([]*models.Group, error) {
+	var groups []*models.Group
+	if err := global.Global().Database.
+		Table(constants.TableGroup).
+		Select("`group`.*").
+		Joins("JOIN `group_member` on `group_member`.group_id = ? AND `group_member`.member_group_id=`group`.group_id", groupId).
+		Where("`group`.status = ?", constants.StatusActive).
+		Order("`group_member`.create_time").
+		Scan(&groups).Error; err != nil {
+		logger.Errorf(ctx, "Get member groups of group [%s] failed: %+v", groupId, err)
+		return nil, err
+	}
+	return groups, nil
+}
+
+// closureGroup is an active group related to an ancestor by the closure.
+type closureGroup struct {
+	AncestorGroupId string
+	GroupId         string
+	GroupPath       string
+	Depth           int
+}
+
+func (p *closureGroup) pathFromAncestor() []string {
+	group := &models.Group{GroupId: p.GroupId, GroupPath: p.GroupPath}
+	return group.PathFrom(p.AncestorGroupId)
+}
+
+func getClosureGroups(ctx context.Context, tx *gorm.DB, column string, groupIds []string) ([]*closureGroup, error) {
+	var groups []*closureGroup
+	if err := tx.Table(constants.TableGroupClosure).
+		Select("`group_closure`.ancestor_group_id, `group`.group_id, `group`.group_path, `group_closure`.depth").
+		Joins("JOIN `group` on `group`.group_id=`group_closure`.descendant_group_id AND `group`.status = ?", constants.StatusActive).
+		Where("`group_closure`."+column+" in (?)", groupIds).
+		Order("`group_closure`.depth").
+		Scan(&groups).Error; err != nil {
+		logger.Errorf(ctx, "Get group closure of groups %v failed: %+v", groupIds, err)
+		return nil, err
+	}
+	return groups, nil
+}
+
+// getNestedGroupPaths walks down from the group through sub groups and nested member
+// groups, it returns each active group reached with the group ids from the group to it.
+func getNestedGroupPaths(ctx context.Context, tx *gorm.DB, groupId string) (map[string][]string, error) {
+	paths := make(map[string][]string)
+	entries := map[string][]string{groupId: {groupId}}
+	for len(entries) > 0 {
+		var entryIds []string
+		for entryId := range entries {
+			entryIds = append(entryIds, entryId)
+		}
+		subGroups, err := getClosureGroups(ctx, tx, constants.ColumnAncestorGroupId, entryIds)
+		if err != nil {
+			return nil, err
+		}
+		var reachedGroupIds []string
+		for _, subGroup := range subGroups {
+			if _, ok := paths[subGroup.GroupId]; ok {
+				continue
+			}
+			path := append([]string{}, entries[subGroup.AncestorGroupId]...)
+			paths[subGroup.GroupId] = append(path, subGroup.pathFromAncestor()[1:]...)
+			reachedGroupIds = append(reachedGroupIds, subGroup.GroupId)
+		}
+
+		entries = make(map[string][]string)
+		if len(reachedGroupIds) == 0 {
+			break
+		}
+		var members []*models.GroupMember
+		if err := tx.Table(constants.TableGroupMember).
+			Where(constants.ColumnGroupId+" in (?)", reachedGroupIds).
+			Order(constants.ColumnCreateTime).
+			Find(&members).Error; err != nil {
+			logger.Errorf(ctx, "Get member groups of groups %v failed: %+v", reachedGroupIds, err)
+			return nil, err
+		}
+		for _, member := range members {
+			if _, ok := paths[member.MemberGroupId]; ok {
+				continue
+			}
+			if _, ok := entries[member.MemberGroupId]; ok {
+				continue
+			}
+			path := append([]string{}, paths[member.GroupId]...)
+			entries[member.MemberGroupId] = append(path, member.MemberGroupId)
+		}
+	}
+	return paths, nil
+}
+
+// getContainingGroupPaths walks up from the groups through the ancestors and the groups
+// they are nested in, it returns each active group reached with the group ids from it
+// down to one of the groups.
+func getContainingGroupPaths(ctx context.Context, groupIds []string) (map[string][]string, error) {
+	paths := make(map[string][]string)
+	entries := make(map[string][]string)
+	for _, groupId := range groupIds {
+		entries[groupId] = []string{groupId}
+	}
+	for len(entries) > 0 {
+		var entryIds []string
+		for entryId := range entries {
+			entryIds = append(entryIds, entryId)
+		}
+		ancestors, err := getClosureGroups(ctx, global.Global().Database.DB, constants.ColumnDescendantGroupId, entryIds)
+		if err != nil {
+			return nil, err
+		}
+		var reachedGroupIds []string
+		for _, ancestor := range ancestors {
+			if _, ok := paths[ancestor.AncestorGroupId]; ok {
+				continue
+			}
+			path := ancestor.pathFromAncestor()
+			paths[ancestor.AncestorGroupId] = append(path, entries[ancestor.GroupId][1:]...)
+			reachedGroupIds = append(reachedGroupIds, ancestor.AncestorGroupId)
+		}
+
+		entries = make(map[string][]string)
+		if len(reachedGroupIds) == 0 {
+			break
+		}
+		var containers []*models.GroupMember
+		if err := global.Global().Database.Table(constants.TableGroupMember).
+			Select("`group_member`.*").
+			Joins("JOIN `group` on `group`.group_id=`group_member`.group_id AND `group`.status = ?", constants.StatusActive).
+			Where("`group_member`.member_group_id in (?)", reachedGroupIds).
+			Order("`group_member`.create_time").
+			Scan(&containers).Error; err != nil {
+			logger.Errorf(ctx, "Get groups containing groups %v failed: %+v", reachedGroupIds, err)
+			return nil, err
+		}
+		for _, container := range containers {
+			if _, ok := paths[container.GroupId]; ok {
+				continue
+			}
+			if _, ok := entries[container.GroupId]; ok {
+				continue
+			}
+			entries[container.GroupId] = append([]string{container.GroupId}, paths[container.MemberGroupId]...)
+		}
+	}
+	return paths, nil
+}
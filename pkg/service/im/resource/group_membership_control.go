@@ -0,0 +1,205 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/db"
+	"kubesphere.io/im/pkg/global"
+	"kubesphere.io/im/pkg/models"
+	"kubesphere.io/im/pkg/pb"
+)
+
+func ListEffectiveGroups(ctx context.Context, req *pb.ListEffectiveGroupsRequest) (*pb.ListEffectiveGroupsResponse, error) {
+	userId := req.UserId
+	if _, err := GetUser(ctx, userId); err != nil {
+		return nil, err
+	}
+
+	var boundGroupIds []string
+	if err := activeBindings(global.Global().Database.Table(constants.TableUserGroupBinding)).
+		Joins("JOIN `group` on `group`.group_id=`user_group_binding`.group_id AND `group`.status = ?", constants.StatusActive).
+		Where("`user_group_binding`.user_id = ?", userId).
+		Pluck("`user_group_binding`.group_id", &boundGroupIds).Error; err != nil {
+		logger.Errorf(ctx, "Get groups of user [%s] failed: %+v", userId, err)
+		return nil, err
+	}
+	if len(boundGroupIds) == 0 {
+		return &pb.ListEffectiveGroupsResponse{}, nil
+	}
+
+	paths, err := getContainingGroupPaths(ctx, boundGroupIds)
+	if err != nil {
+		return nil, err
+	}
+	var groupIds []string
+	for groupId := range paths {
+		groupIds = append(groupIds, groupId)
+	}
+
+	var groups []*models.Group
+	if err := global.Global().Database.Table(constants.TableGroup).
+		Where(constants.ColumnGroupId+" in (?)", groupIds).
+		Order(constants.ColumnGroupPathLevel + ", " + constants.ColumnCreateTime).
+		Find(&groups).Error; err != nil {
+		logger.Errorf(ctx, "Get effective groups of user [%s] failed: %+v", userId, err)
+		return nil, err
+	}
+
+	var groupSet []*pb.EffectiveGroup
+	for _, group := range groups {
+		path := paths[group.GroupId]
+		groupSet = append(groupSet, &pb.EffectiveGroup{
+			Group:  group.ToPB(),
+			Direct: len(path) == 1,
+			Path:   path,
+		})
+	}
+	return &pb.ListEffectiveGroupsResponse{
+		Total:    uint32(len(groupSet)),
+		GroupSet: groupSet,
+	}, nil
+}
+
+func ListEffectiveMembers(ctx context.Context, req *pb.ListEffectiveMembersRequest) (*pb.ListEffectiveMembersResponse, error) {
+	groupId := req.GroupId
+	if _, err := GetGroup(ctx, groupId); err != nil {
+		return nil, err
+	}
+	limit := db.GetLimitFromRequest(req)
+	offset := db.GetOffsetFromRequest(req)
+
+	paths, err := getNestedGroupPaths(ctx, global.Global().Database.DB, groupId)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return &pb.ListEffectiveMembersResponse{}, nil
+	}
+	var groupIds []string
+	for nestedGroupId := range paths {
+		groupIds = append(groupIds, nestedGroupId)
+	}
+
+	var count int
+	if err := activeBindings(global.Global().Database.Table(constants.TableUserGroupBinding)).
+		Where(constants.ColumnGroupId+" in (?)", groupIds).
+		Select("COUNT(DISTINCT " + constants.ColumnUserId + ")").
+		Count(&count).Error; err != nil {
+		logger.Errorf(ctx, "Count effective members of group [%s] failed: %+v", groupId, err)
+		return nil, err
+	}
+
+	var userIds []string
+	if err := activeBindings(global.Global().Database.Table(constants.TableUserGroupBinding)).
+		Where(constants.ColumnGroupId+" in (?)", groupIds).
+		Group(constants.ColumnUserId).
+		Order(constants.ColumnUserId).
+		Offset(offset).
+		Limit(limit).
+		Pluck(constants.ColumnUserId, &userIds).Error; err != nil {
+		logger.Errorf(ctx, "Get effective members of group [%s] failed: %+v", groupId, err)
+		return nil, err
+	}
+	if len(userIds) == 0 {
+		return &pb.ListEffectiveMembersResponse{Total: uint32(count)}, nil
+	}
+
+	var bindings []*models.UserGroupBinding
+	if err := activeBindings(global.Global().Database.Table(constants.TableUserGroupBinding)).
+		Where(constants.ColumnGroupId+" in (?)", groupIds).
+		Where(constants.ColumnUserId+" in (?)", userIds).
+		Find(&bindings).Error; err != nil {
+		logger.Errorf(ctx, "Get effective members of group [%s] failed: %+v", groupId, err)
+		return nil, err
+	}
+	// the nearest binding of each user has the shortest path
+	nearest := make(map[string][]string)
+	for _, binding := range bindings {
+		path := paths[binding.GroupId]
+		if p, ok := nearest[binding.UserId]; !ok || len(path) < len(p) {
+			nearest[binding.UserId] = path
+		}
+	}
+
+	var users []*models.User
+	if err := global.Global().Database.Table(constants.TableUser).
+		Where(constants.ColumnUserId+" in (?)", userIds).
+		Order(constants.ColumnUserId).
+		Find(&users).Error; err != nil {
+		logger.Errorf(ctx, "Get effective members of group [%s] failed: %+v", groupId, err)
+		return nil, err
+	}
+
+	var userSet []*pb.EffectiveMember
+	for _, user := range users {
+		path := nearest[user.UserId]
+		userSet = append(userSet, &pb.EffectiveMember{
+			User:   user.ToPB(),
+			Direct: len(path) == 1,
+			Path:   path,
+		})
+	}
+	return &pb.ListEffectiveMembersResponse{
+		Total:   uint32(count),
+		UserSet: userSet,
+	}, nil
+}
+
+// IsMember answers the direct and the hierarchy check with one indexed lookup of the
+// bindings of the user joined with the closure of the group, only a transitive miss
+// looks up the bindings under the nested member groups, see getNestedMemberGroupIds.
+func IsMember(ctx context.Context, req *pb.IsMemberRequest) (*pb.IsMemberResponse, error) {
+	query := activeBindings(global.Global().Database.Table(constants.TableUserGroupBinding)).
+		Joins("JOIN `group_closure` on `group_closure`.descendant_group_id=`user_group_binding`.group_id").
+		Joins("JOIN `group` on `group`.group_id=`user_group_binding`.group_id AND `group`.status = ?", constants.StatusActive).
+		Where("`user_group_binding`.user_id = ?", req.UserId)
+	if !req.Transitive {
+		query = query.Where("`group_closure`.depth = 0")
+	}
+
+	var count int
+	if err := query.Where("`group_closure`.ancestor_group_id = ?", req.GroupId).
+		Count(&count).Error; err != nil {
+		logger.Errorf(ctx, "Check user [%s] in group [%s] failed: %+v", req.UserId, req.GroupId, err)
+		return nil, err
+	}
+	if count > 0 || !req.Transitive {
+		return &pb.IsMemberResponse{
+			IsMember: count > 0,
+		}, nil
+	}
+
+	memberGroupIds, err := getNestedMemberGroupIds(ctx, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if len(memberGroupIds) > 0 {
+		if err := query.Where("`group_closure`.ancestor_group_id in (?)", memberGroupIds).
+			Count(&count).Error; err != nil {
+			logger.Errorf(ctx, "Check user [%s] in group [%s] failed: %+v", req.UserId, req.GroupId, err)
+			return nil, err
+		}
+	}
+	return &pb.IsMemberResponse{
+		IsMember: count > 0,
+	}, nil
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/db"
+	"kubesphere.io/im/pkg/gerr"
+	"kubesphere.io/im/pkg/global"
+	"kubesphere.io/im/pkg/models"
+	"kubesphere.io/im/pkg/pb"
+	"kubesphere.io/im/pkg/util/stringutil"
+)
+
+// maxGroupPathLength is the size of the group_path column.
+const maxGroupPathLength = 2000
+
+func MoveGroup(ctx context.Context, req *pb.MoveGroupRequest) (*pb.MoveGroupResponse, error) {
+	groupId := req.GroupId
+	parentGroupId := stringutil.SimplifyString(req.ParentGroupId)
+
+	var group *models.Group
+	var count int
+	tx := global.Global().Database.Begin()
+	{
+		var err error
+		group, count, err = moveGroup(ctx, tx, groupId, parentGroupId)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf(ctx, "Move group [%s] failed: %+v", groupId, err)
+		return nil, err
+	}
+	resetNestedGroupCache()
+
+	return &pb.MoveGroupResponse{
+		GroupId:       groupId,
+		GroupPath:     group.GroupPath,
+		AffectedCount: uint32(count),
+		GroupNamePath: group.GroupNamePath,
+	}, nil
+}
+
+func getGroupForUpdate(ctx context.Context, tx *gorm.DB, groupId string) (*models.Group, error) {
+	var group = &models.Group{GroupId: groupId}
+	if err := db.GetChain(tx.Table(constants.TableGroup)).
+		ForUpdate().
+		Take(group).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			err = gerr.NewNotFound(constants.TableGroup, groupId)
+		}
+		logger.Errorf(ctx, "Get group [%s] failed: %+v", groupId, err)
+		return nil, err
+	}
+	return group, nil
+}
+
+// getGroupsForUpdate locks the groups in the order of their ids,
+// so that transactions locking some of the same groups do not deadlock.
+func getGroupsForUpdate(ctx context.Context, tx *gorm.DB, groupIds []string) ([]*models.Group, error) {
+	groupIds = stringutil.Unique(groupIds)
+	sort.Strings(groupIds)
+	var groups []*models.Group
+	for _, groupId := range groupIds {
+		group, err := getGroupForUpdate(ctx, tx, groupId)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// lockGroups locks and returns the existing groups among the ids with one query in the
+// order of their ids.
+func lockGroups(ctx context.Context, tx *gorm.DB, groupIds []string) ([]*models.Group, error) {
+	var groups []*models.Group
+	if err := db.GetChain(tx.Table(constants.TableGroup).
+		Where(constants.ColumnGroupId+" in (?)", groupIds).
+		Order(constants.ColumnGroupId)).
+		ForUpdate().
+		Find(&groups).Error; err != nil {
+		logger.Errorf(ctx, "Lock groups %v failed: %+v", groupIds, err)
+		return nil, err
+	}
+	return groups, nil
+}
+
+// moveGroup puts the group under the parent group in the transaction and rewrites the path of
+// the group and all of its descendants, it returns the moved group and the number of groups touched.
+func moveGroup(ctx context.Context, tx *gorm.DB, groupId, parentGroupId string) (*models.Group, int, error) {
+	// the group and the new parent are locked together in the order of their ids,
+	// so that moves in opposite directions can not deadlock
+	lockGroupIds := []string{groupId}
+	if parentGroupId != "" {
+		lockGroupIds = append(lockGroupIds, parentGroupId)
+	}
+	lockedGroups, err := getGroupsForUpdate(ctx, tx, lockGroupIds)
+	if err != nil {
+		info := gerr.GetErrorInfo(err)
+		if info != nil && info.Reason == gerr.ReasonNotFound && parentGroupId != groupId &&
+			info.Metadata[gerr.MetadataId] == parentGroupId {
+			err = gerr.NewInvalidArgument(constants.ColumnParentGroupId, "get parent group failed: %v", err)
+			logger.Errorf(ctx, "%+v", err)
+		}
+		return nil, 0, err
+	}
+	var group, parentGroup *models.Group
+	for _, g := range lockedGroups {
+		if g.GroupId == groupId {
+			group = g
+		}
+		if g.GroupId == parentGroupId {
+			parentGroup = g
+		}
+	}
+	if group.ParentGroupId == parentGroupId {
+		return group, 0, nil
+	}
+
+	subtreeGroupIds, err := getSubtreeGroupIds(ctx, tx, []string{groupId})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	parentGroupPath, parentGroupNamePath := "", ""
+	if parentGroupId != "" {
+		// the parent must not be reachable from the group through sub groups or nested member groups
+		nestedGroupPaths, err := getNestedGroupPaths(ctx, tx, groupId)
+		if err != nil {
+			return nil, 0, err
+		}
+		_, nested := nestedGroupPaths[parentGroupId]
+		if nested || stringutil.Contains(subtreeGroupIds, parentGroupId) {
+			err := gerr.NewInvalidArgument(constants.ColumnParentGroupId,
+				"group [%s] can not be moved under itself or a group it contains [%s]", groupId, parentGroupId)
+			logger.Errorf(ctx, "%+v", err)
+			return nil, 0, err
+		}
+		if parentGroup.Status == constants.StatusDeleted {
+			err := gerr.NewInvalidArgument(constants.ColumnParentGroupId, "parent group [%s] has been deleted", parentGroupId)
+			logger.Errorf(ctx, "%+v", err)
+			return nil, 0, err
+		}
+		if err := checkGroupChildLimit(ctx, tx, parentGroup, []string{groupId}); err != nil {
+			return nil, 0, err
+		}
+		parentGroupPath = parentGroup.GroupPath
+		parentGroupNamePath = parentGroup.GroupNamePath
+	}
+	if err := checkSiblingGroupName(ctx, tx, parentGroupId, group.GroupName, groupId); err != nil {
+		return nil, 0, err
+	}
+
+	var subtree []*models.Group
+	if err := db.GetChain(tx.Table(constants.TableGroup)).
+		ForUpdate().
+		Where(constants.ColumnGroupId+" in (?)", subtreeGroupIds).
+		Find(&subtree).Error; err != nil {
+		logger.Errorf(ctx, "Get sub groups of group [%s] failed: %+v", groupId, err)
+		return nil, 0, err
+	}
+	height := 1
+	for _, g := range subtree {
+		if g.Status == constants.StatusActive && g.GroupPathLevel-group.GroupPathLevel+1 > height {
+			height = g.GroupPathLevel - group.GroupPathLevel + 1
+		}
+	}
+	if err := checkGroupDepthLimits(ctx, tx, parentGroupPath, group, height); err != nil {
+		return nil, 0, err
+	}
+	if err := moveGroupClosures(ctx, tx, groupId, parentGroupId, subtreeGroupIds); err != nil {
+		return nil, 0, err
+	}
+
+	oldGroupPath := group.GroupPath
+	newGroupPath := models.GetGroupPath(parentGroupPath, groupId)
+	oldGroupNamePath := group.GroupNamePath
+	newGroupNamePath := models.GetGroupNamePath(parentGroupNamePath, group.GroupName)
+	now := time.Now()
+	for _, g := range subtree {
+		groupPath := newGroupPath + strings.TrimPrefix(g.GroupPath, oldGroupPath)
+		if len(groupPath) > maxGroupPathLength {
+			err := gerr.NewInvalidArgument(constants.ColumnParentGroupId,
+				"group path of [%s] exceeds %d characters after the move", g.GroupId, maxGroupPathLength)
+			logger.Errorf(ctx, "%+v", err)
+			return nil, 0, err
+		}
+		groupNamePath := newGroupNamePath + strings.TrimPrefix(g.GroupNamePath, oldGroupNamePath)
+		if err := checkGroupNamePathLength(ctx, constants.ColumnParentGroupId, g.GroupId, groupNamePath); err != nil {
+			return nil, 0, err
+		}
+		attributes := map[string]interface{}{
+			constants.ColumnGroupPath:      groupPath,
+			constants.ColumnGroupNamePath:  groupNamePath,
+			constants.ColumnGroupPathLevel: strings.Count(groupPath, constants.GroupPathSep) + 1,
+			constants.ColumnUpdateTime:     now,
+		}
+		if g.GroupId == groupId {
+			attributes[constants.ColumnParentGroupId] = parentGroupId
+		}
+		if err := tx.Table(constants.TableGroup).
+			Where(constants.ColumnGroupId+" = ?", g.GroupId).
+			UpdateColumns(attributes).Error; err != nil {
+			logger.Errorf(ctx, "Update path of group [%s] failed: %+v", g.GroupId, err)
+			return nil, 0, err
+		}
+	}
+
+	group.ParentGroupId = parentGroupId
+	group.GroupPath = newGroupPath
+	group.GroupNamePath = newGroupNamePath
+	return group, len(subtree), nil
+}
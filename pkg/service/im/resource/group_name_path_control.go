@@ -0,0 +1,171 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/db"
+	"kubesphere.io/im/pkg/gerr"
+	"kubesphere.io/im/pkg/global"
+	"kubesphere.io/im/pkg/models"
+	"kubesphere.io/im/pkg/pb"
+	"kubesphere.io/im/pkg/util/stringutil"
+)
+
+// maxGroupNamePathLength is the size of the group_name_path column.
+const maxGroupNamePathLength = 4000
+
+// normalizeGroupNamePath returns the name path with a leading and without a trailing "/".
+func normalizeGroupNamePath(namePath string) string {
+	namePath = strings.Trim(strings.TrimSpace(namePath), constants.GroupNamePathSep)
+	return constants.GroupNamePathSep + namePath
+}
+
+// checkGroupName rejects the names that can not be a segment of a name path.
+func checkGroupName(ctx context.Context, groupName string) error {
+	if strings.Contains(groupName, constants.GroupNamePathSep) {
+		err := gerr.NewInvalidArgument(constants.ColumnGroupName, "group name [%s] contains %q", groupName, constants.GroupNamePathSep)
+		logger.Errorf(ctx, "%+v", err)
+		return err
+	}
+	return nil
+}
+
+// checkGroupNamePathLength rejects the name path longer than the column.
+func checkGroupNamePathLength(ctx context.Context, field, groupId, groupNamePath string) error {
+	if utf8.RuneCountInString(groupNamePath) > maxGroupNamePathLength {
+		err := gerr.NewInvalidArgument(field, "group name path of [%s] exceeds %d characters", groupId, maxGroupNamePathLength)
+		logger.Errorf(ctx, "%+v", err)
+		return err
+	}
+	return nil
+}
+
+// checkSiblingGroupName rejects the name taken by another active group under the parent,
+// unless unique group names are turned off.
+func checkSiblingGroupName(ctx context.Context, tx *gorm.DB, parentGroupId, groupName, groupId string) error {
+	if !global.Global().Config.UniqueGroupNames {
+		return nil
+	}
+	var count int
+	if err := tx.Table(constants.TableGroup).
+		Where(constants.ColumnParentGroupId+" = ?", parentGroupId).
+		Where(constants.ColumnGroupName+" = ?", groupName).
+		Where(constants.ColumnGroupId+" != ?", groupId).
+		Where(constants.ColumnStatus+" = ?", constants.StatusActive).
+		Count(&count).Error; err != nil {
+		logger.Errorf(ctx, "Count groups named [%s] failed: %+v", groupName, err)
+		return err
+	}
+	if count > 0 {
+		err := gerr.NewAlreadyExists(constants.TableGroup, constants.ColumnGroupName, groupName)
+		logger.Errorf(ctx, "%+v", err)
+		return err
+	}
+	return nil
+}
+
+// renameGroup changes the name of the group in the transaction and rewrites the name path
+// of the group and all of its descendants.
+func renameGroup(ctx context.Context, tx *gorm.DB, group *models.Group, groupName string) error {
+	subtreeGroupIds, err := getSubtreeGroupIds(ctx, tx, []string{group.GroupId})
+	if err != nil {
+		return err
+	}
+	var subtree []*models.Group
+	if err := db.GetChain(tx.Table(constants.TableGroup)).
+		ForUpdate().
+		Where(constants.ColumnGroupId+" in (?)", subtreeGroupIds).
+		Find(&subtree).Error; err != nil {
+		logger.Errorf(ctx, "Get sub groups of group [%s] failed: %+v", group.GroupId, err)
+		return err
+	}
+
+	oldNamePath := group.GroupNamePath
+	newNamePath := strings.TrimSuffix(oldNamePath, group.GroupName) + groupName
+	for _, g := range subtree {
+		namePath := newNamePath + strings.TrimPrefix(g.GroupNamePath, oldNamePath)
+		if err := checkGroupNamePathLength(ctx, constants.ColumnGroupName, g.GroupId, namePath); err != nil {
+			return err
+		}
+		attributes := map[string]interface{}{
+			constants.ColumnGroupNamePath: namePath,
+		}
+		if g.GroupId == group.GroupId {
+			attributes[constants.ColumnGroupName] = groupName
+		}
+		if err := tx.Table(constants.TableGroup).
+			Where(constants.ColumnGroupId+" = ?", g.GroupId).
+			UpdateColumns(attributes).Error; err != nil {
+			logger.Errorf(ctx, "Update name path of group [%s] failed: %+v", g.GroupId, err)
+			return err
+		}
+	}
+	group.GroupName = groupName
+	group.GroupNamePath = newNamePath
+	return nil
+}
+
+func GetGroupByNamePath(ctx context.Context, req *pb.GetGroupByNamePathRequest) (*pb.GetGroupResponse, error) {
+	namePath := normalizeGroupNamePath(req.GroupNamePath)
+
+	var groups []*models.Group
+	if err := global.Global().Database.Table(constants.TableGroup).
+		Where(constants.ColumnGroupNamePath+" = ?", namePath).
+		Where(constants.ColumnStatus+" = ?", constants.StatusActive).
+		Find(&groups).Error; err != nil {
+		logger.Errorf(ctx, "Get group [%s] failed: %+v", namePath, err)
+		return nil, err
+	}
+	if len(groups) == 0 {
+		err := gerr.NewNotFound(constants.TableGroup, namePath)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	// siblings of the same name are left from before the names were unique
+	if len(groups) > 1 {
+		var groupIds []string
+		for _, group := range groups {
+			groupIds = append(groupIds, group.GroupId)
+		}
+		err := status.Errorf(codes.FailedPrecondition, "name path [%s] matches groups %v", namePath, groupIds)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	return &pb.GetGroupResponse{
+		Group: groups[0].ToPB(),
+	}, nil
+}
+
+// normalizeGroupNamePaths normalizes the name paths of a filter.
+func normalizeGroupNamePaths(namePaths []string) []string {
+	var result []string
+	for _, namePath := range stringutil.SimplifyStringList(namePaths) {
+		result = append(result, normalizeGroupNamePath(namePath))
+	}
+	return result
+}
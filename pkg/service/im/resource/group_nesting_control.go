@@ -0,0 +1,90 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/global"
+)
+
+type nestedGroupEntry struct {
+	memberGroupIds []string
+	expireTime     time.Time
+}
+
+// nestedGroupCache keeps the member groups nested in a group for the transitive
+// membership check, it is reset by the nesting changes made through this instance.
+var nestedGroupCache = struct {
+	sync.Mutex
+	entries map[string]*nestedGroupEntry
+}{entries: make(map[string]*nestedGroupEntry)}
+
+func resetNestedGroupCache() {
+	nestedGroupCache.Lock()
+	defer nestedGroupCache.Unlock()
+	nestedGroupCache.entries = make(map[string]*nestedGroupEntry)
+}
+
+// getNestedMemberGroupIds returns the active groups nested as members in the group or
+// its sub groups, level by level up to MaxNestingDepth, with one query per level.
+func getNestedMemberGroupIds(ctx context.Context, groupId string) ([]string, error) {
+	cfg := global.Global().Config.Membership
+	now := time.Now()
+	nestedGroupCache.Lock()
+	entry, ok := nestedGroupCache.entries[groupId]
+	nestedGroupCache.Unlock()
+	if ok && now.Before(entry.expireTime) {
+		return entry.memberGroupIds, nil
+	}
+
+	var memberGroupIds []string
+	reached := map[string]bool{groupId: true}
+	entryIds := []string{groupId}
+	for level := 0; len(entryIds) > 0 && (cfg.MaxNestingDepth == 0 || level < cfg.MaxNestingDepth); level++ {
+		var ids []string
+		if err := global.Global().Database.Table(constants.TableGroupMember).
+			Joins("JOIN `group_closure` on `group_closure`.descendant_group_id=`group_member`.group_id").
+			Joins("JOIN `group` on `group`.group_id=`group_member`.member_group_id AND `group`.status = ?", constants.StatusActive).
+			Where("`group_closure`.ancestor_group_id in (?)", entryIds).
+			Pluck("`group_member`.member_group_id", &ids).Error; err != nil {
+			logger.Errorf(ctx, "Get nested member groups of groups %v failed: %+v", entryIds, err)
+			return nil, err
+		}
+		entryIds = nil
+		for _, id := range ids {
+			if !reached[id] {
+				reached[id] = true
+				entryIds = append(entryIds, id)
+				memberGroupIds = append(memberGroupIds, id)
+			}
+		}
+	}
+
+	nestedGroupCache.Lock()
+	nestedGroupCache.entries[groupId] = &nestedGroupEntry{
+		memberGroupIds: memberGroupIds,
+		expireTime:     now.Add(cfg.NestedGroupCacheTTL),
+	}
+	nestedGroupCache.Unlock()
+	return memberGroupIds, nil
+}
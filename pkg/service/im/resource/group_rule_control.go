@@ -0,0 +1,480 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/db"
+	"kubesphere.io/im/pkg/gerr"
+	"kubesphere.io/im/pkg/global"
+	"kubesphere.io/im/pkg/models"
+	"kubesphere.io/im/pkg/pb"
+	"kubesphere.io/im/pkg/util/ruleutil"
+	"kubesphere.io/im/pkg/util/stringutil"
+)
+
+// ruleUserBatchSize is the number of users read at a time to evaluate a rule.
+const ruleUserBatchSize = 1000
+
+// ruleGroupQueueSize is the number of changed dynamic groups waiting for the reconciler.
+const ruleGroupQueueSize = 1000
+
+// ruleGroupQueue passes the dynamic groups whose rules are changed to ServeRuleGroupReconciler,
+// so that the users are not scanned on the request path.
+var ruleGroupQueue = make(chan string, ruleGroupQueueSize)
+
+// ruleGroup is a dynamic group with its parsed membership rule.
+type ruleGroup struct {
+	group *models.Group
+	rule  *ruleutil.Rule
+}
+
+// parseMembershipRule parses the rule and checks the fields it refers to.
+func parseMembershipRule(ctx context.Context, field, rule string) (*ruleutil.Rule, error) {
+	r, err := ruleutil.Parse(rule)
+	if err != nil {
+		err := gerr.NewInvalidArgument(field, "invalid membership rule: %v", err)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	for _, f := range r.Fields() {
+		if !stringutil.Contains(models.RuleFields, f) && !strings.HasPrefix(f, constants.ColumnExtra+".") {
+			err := gerr.NewInvalidArgument(field, "unknown field [%s] of membership rule", f)
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// getRuleGroupIds returns the ids of the active dynamic groups.
+func getRuleGroupIds(ctx context.Context) ([]string, error) {
+	var groupIds []string
+	if err := global.Global().Database.Table(constants.TableGroup).
+		Where(constants.ColumnMembershipRule+" != ?", "").
+		Where(constants.ColumnStatus+" = ?", constants.StatusActive).
+		Pluck(constants.ColumnGroupId, &groupIds).Error; err != nil {
+		logger.Errorf(ctx, "Get dynamic groups failed: %+v", err)
+		return nil, err
+	}
+	return groupIds, nil
+}
+
+// parseRuleGroups parses the rules of the active dynamic groups among the groups,
+// a group whose rule can not be parsed is skipped.
+func parseRuleGroups(ctx context.Context, groups []*models.Group) []*ruleGroup {
+	var ruleGroups []*ruleGroup
+	for _, group := range groups {
+		if group.Status != constants.StatusActive || group.MembershipRule == "" {
+			continue
+		}
+		rule, err := ruleutil.Parse(group.MembershipRule)
+		if err != nil {
+			logger.Errorf(ctx, "Parse membership rule of group [%s] failed: %+v", group.GroupId, err)
+			continue
+		}
+		ruleGroups = append(ruleGroups, &ruleGroup{group: group, rule: rule})
+	}
+	return ruleGroups
+}
+
+// getRuleGroups returns the active dynamic groups with their rules, without lock.
+func getRuleGroups(ctx context.Context) ([]*ruleGroup, error) {
+	var groups []*models.Group
+	if err := global.Global().Database.Table(constants.TableGroup).
+		Where(constants.ColumnMembershipRule+" != ?", "").
+		Where(constants.ColumnStatus+" = ?", constants.StatusActive).
+		Find(&groups).Error; err != nil {
+		logger.Errorf(ctx, "Get dynamic groups failed: %+v", err)
+		return nil, err
+	}
+	return parseRuleGroups(ctx, groups), nil
+}
+
+// lockRuleGroups locks the groups in the transaction with one query and parses their rules,
+// so that the changes of their members are applied one after the other from the latest rules.
+func lockRuleGroups(ctx context.Context, tx *gorm.DB, groupIds []string) ([]*ruleGroup, error) {
+	groups, err := lockGroups(ctx, tx, groupIds)
+	if err != nil {
+		return nil, err
+	}
+	return parseRuleGroups(ctx, groups), nil
+}
+
+// matchRule tells whether the user is a member of a dynamic group with the rule, any user
+// other than deleted is matched, pending and disabled ones included like the members of a
+// static group; a rule may exclude them by the status field.
+func matchRule(rule *ruleutil.Rule, user *models.User) bool {
+	return user.Status != constants.StatusDeleted && rule.Match(user.GetRuleFields())
+}
+
+// checkStaticGroups rejects the manual membership changes of dynamic groups.
+func checkStaticGroups(ctx context.Context, groupIds []string) error {
+	if len(groupIds) == 0 {
+		return nil
+	}
+	var dynamicGroupIds []string
+	if err := global.Global().Database.Table(constants.TableGroup).
+		Where(constants.ColumnGroupId+" in (?)", groupIds).
+		Where(constants.ColumnMembershipRule+" != ?", "").
+		Pluck(constants.ColumnGroupId, &dynamicGroupIds).Error; err != nil {
+		logger.Errorf(ctx, "Get dynamic groups failed: %+v", err)
+		return err
+	}
+	if len(dynamicGroupIds) > 0 {
+		err := status.Errorf(codes.FailedPrecondition, "members of dynamic groups %v are managed by their rules", dynamicGroupIds)
+		logger.Errorf(ctx, "%+v", err)
+		return err
+	}
+	return nil
+}
+
+// syncUserRuleGroups puts the user in the dynamic groups whose rules it matches and
+// removes it from the others, it is called once the user is changed, failures are
+// repaired by the periodic reconcile.
+func syncUserRuleGroups(ctx context.Context, userId string) {
+	if err := updateUserRuleGroups(ctx, userId); err != nil {
+		logger.Errorf(ctx, "Update dynamic groups of user [%s] failed: %+v", userId, err)
+	}
+}
+
+// syncBatchUserRuleGroups syncs the dynamic groups of the succeeded users of a batch.
+func syncBatchUserRuleGroups(ctx context.Context, results []*pb.BatchResult) {
+	for _, result := range results {
+		if result.Error == nil && result.Id != "" {
+			syncUserRuleGroups(ctx, result.Id)
+		}
+	}
+}
+
+// updateUserRuleGroups binds the user to the dynamic groups whose rules it matches and unbinds
+// it from the others, without the member limit like reconcileRuleGroup. The rules are evaluated
+// first, then only the groups whose members change are locked and evaluated again.
+func updateUserRuleGroups(ctx context.Context, userId string) error {
+	ruleGroups, err := getRuleGroups(ctx)
+	if err != nil || len(ruleGroups) == 0 {
+		return err
+	}
+	var user = &models.User{UserId: userId}
+	if err := global.Global().Database.Table(constants.TableUser).Take(user).Error; err != nil {
+		return err
+	}
+	leftGroupIds, joinedGroupIds, err := diffUserRuleGroups(ctx, global.Global().Database.DB, user, ruleGroups)
+	if err != nil || (len(leftGroupIds) == 0 && len(joinedGroupIds) == 0) {
+		return err
+	}
+
+	tx := global.Global().Database.Begin()
+	{
+		ruleGroups, err := lockRuleGroups(ctx, tx, append(leftGroupIds, joinedGroupIds...))
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		var user = &models.User{UserId: userId}
+		if err := tx.Table(constants.TableUser).Take(user).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+		leftGroupIds, joinedGroupIds, err = diffUserRuleGroups(ctx, tx, user, ruleGroups)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		if len(leftGroupIds) == 0 && len(joinedGroupIds) == 0 {
+			tx.Rollback()
+			return nil
+		}
+		if len(leftGroupIds) > 0 {
+			if err := tx.Delete(models.UserGroupBinding{},
+				constants.ColumnUserId+" = ? AND "+constants.ColumnGroupId+" in (?)", userId, leftGroupIds).Error; err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+		for _, groupId := range joinedGroupIds {
+			if err := tx.Create(models.NewUserGroupBinding(userId, groupId)).Error; err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+	}
+	return tx.Commit().Error
+}
+
+// diffUserRuleGroups returns the dynamic groups the user is bound to but does not match,
+// and the ones it matches but is not bound to.
+func diffUserRuleGroups(ctx context.Context, tx *gorm.DB, user *models.User, ruleGroups []*ruleGroup) ([]string, []string, error) {
+	if len(ruleGroups) == 0 {
+		return nil, nil, nil
+	}
+	var ruleGroupIds, matchedGroupIds []string
+	for _, g := range ruleGroups {
+		ruleGroupIds = append(ruleGroupIds, g.group.GroupId)
+		if matchRule(g.rule, user) {
+			matchedGroupIds = append(matchedGroupIds, g.group.GroupId)
+		}
+	}
+
+	var boundGroupIds []string
+	if err := tx.Table(constants.TableUserGroupBinding).
+		Where(constants.ColumnUserId+" = ?", user.UserId).
+		Where(constants.ColumnGroupId+" in (?)", ruleGroupIds).
+		Pluck(constants.ColumnGroupId, &boundGroupIds).Error; err != nil {
+		logger.Errorf(ctx, "Get dynamic groups of user [%s] failed: %+v", user.UserId, err)
+		return nil, nil, err
+	}
+	return stringutil.Diff(boundGroupIds, matchedGroupIds), stringutil.Diff(matchedGroupIds, boundGroupIds), nil
+}
+
+// scanRuleUsers calls fn with each user matching the rule, in the order of user ids;
+// the users are read a batch at a time and not kept.
+func scanRuleUsers(ctx context.Context, tx *gorm.DB, rule *ruleutil.Rule, fn func(user *models.User)) error {
+	lastUserId := ""
+	for {
+		var users []*models.User
+		if err := tx.Table(constants.TableUser).
+			Where(constants.ColumnStatus+" != ?", constants.StatusDeleted).
+			Where(constants.ColumnUserId+" > ?", lastUserId).
+			Order(constants.ColumnUserId).
+			Limit(ruleUserBatchSize).
+			Find(&users).Error; err != nil {
+			logger.Errorf(ctx, "Get users after [%s] failed: %+v", lastUserId, err)
+			return err
+		}
+		for _, user := range users {
+			if matchRule(rule, user) {
+				fn(user)
+			}
+		}
+		if len(users) < ruleUserBatchSize {
+			return nil
+		}
+		lastUserId = users[len(users)-1].UserId
+	}
+}
+
+// reconcileRuleGroup makes the members of the dynamic group the users matching its rule.
+// The member limit does not apply, the rule alone defines the members of a dynamic group.
+// The users are scanned without lock, then the changed ones are evaluated again and applied
+// in a short transaction with the group locked, so that user writes do not wait for a scan.
+func reconcileRuleGroup(ctx context.Context, groupId string) error {
+	group, err := GetGroup(ctx, groupId)
+	if err != nil {
+		return err
+	}
+	ruleGroups := parseRuleGroups(ctx, []*models.Group{group})
+	if len(ruleGroups) == 0 {
+		return nil
+	}
+	var matchedUserIds []string
+	if err := scanRuleUsers(ctx, global.Global().Database.DB, ruleGroups[0].rule, func(user *models.User) {
+		matchedUserIds = append(matchedUserIds, user.UserId)
+	}); err != nil {
+		return err
+	}
+	var boundUserIds []string
+	if err := global.Global().Database.Table(constants.TableUserGroupBinding).
+		Where(constants.ColumnGroupId+" = ?", groupId).
+		Pluck(constants.ColumnUserId, &boundUserIds).Error; err != nil {
+		logger.Errorf(ctx, "Get users of group [%s] failed: %+v", groupId, err)
+		return err
+	}
+	changedUserIds := append(stringutil.Diff(boundUserIds, matchedUserIds), stringutil.Diff(matchedUserIds, boundUserIds)...)
+	if len(changedUserIds) == 0 {
+		return nil
+	}
+
+	var leftUserIds, joinedUserIds []string
+	tx := global.Global().Database.Begin()
+	{
+		ruleGroups, err := lockRuleGroups(ctx, tx, []string{groupId})
+		if err != nil || len(ruleGroups) == 0 {
+			tx.Rollback()
+			return err
+		}
+		// a rule changed since the scan queues another reconcile
+		if ruleGroups[0].group.MembershipRule != group.MembershipRule {
+			tx.Rollback()
+			return nil
+		}
+		leftUserIds, joinedUserIds, err = diffRuleGroupUsers(ctx, tx, ruleGroups[0], changedUserIds)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		if len(leftUserIds) == 0 && len(joinedUserIds) == 0 {
+			tx.Rollback()
+			return nil
+		}
+		for _, userIds := range splitRuleUserIds(leftUserIds) {
+			if err := tx.Delete(models.UserGroupBinding{},
+				constants.ColumnGroupId+" = ? AND "+constants.ColumnUserId+" in (?)", groupId, userIds).Error; err != nil {
+				tx.Rollback()
+				logger.Errorf(ctx, "Remove users from dynamic group [%s] failed: %+v", groupId, err)
+				return err
+			}
+		}
+		var bindings []interface{}
+		for _, userId := range joinedUserIds {
+			bindings = append(bindings, models.NewUserGroupBinding(userId, groupId))
+		}
+		if err := db.BatchInsert(tx, bindings); err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Add users to dynamic group [%s] failed: %+v", groupId, err)
+			return err
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf(ctx, "Reconcile dynamic group [%s] failed: %+v", groupId, err)
+		return err
+	}
+	logger.Infof(ctx, "Dynamic group [%s] gained %d and lost %d users", groupId, len(joinedUserIds), len(leftUserIds))
+	return nil
+}
+
+// splitRuleUserIds splits the user ids into batches of ruleUserBatchSize.
+func splitRuleUserIds(userIds []string) [][]string {
+	var batches [][]string
+	for len(userIds) > ruleUserBatchSize {
+		batches = append(batches, userIds[:ruleUserBatchSize])
+		userIds = userIds[ruleUserBatchSize:]
+	}
+	if len(userIds) > 0 {
+		batches = append(batches, userIds)
+	}
+	return batches
+}
+
+// diffRuleGroupUsers evaluates the users again in the transaction, it returns the ones bound
+// to the group that no longer match its rule and the ones matching it that are not bound.
+func diffRuleGroupUsers(ctx context.Context, tx *gorm.DB, g *ruleGroup, userIds []string) ([]string, []string, error) {
+	var leftUserIds, joinedUserIds []string
+	for _, batchUserIds := range splitRuleUserIds(userIds) {
+		var users []*models.User
+		if err := tx.Table(constants.TableUser).
+			Where(constants.ColumnUserId+" in (?)", batchUserIds).
+			Find(&users).Error; err != nil {
+			logger.Errorf(ctx, "Get users %v failed: %+v", batchUserIds, err)
+			return nil, nil, err
+		}
+		var boundUserIds []string
+		if err := tx.Table(constants.TableUserGroupBinding).
+			Where(constants.ColumnGroupId+" = ?", g.group.GroupId).
+			Where(constants.ColumnUserId+" in (?)", batchUserIds).
+			Pluck(constants.ColumnUserId, &boundUserIds).Error; err != nil {
+			logger.Errorf(ctx, "Get users of group [%s] failed: %+v", g.group.GroupId, err)
+			return nil, nil, err
+		}
+		var matchedUserIds []string
+		for _, user := range users {
+			if matchRule(g.rule, user) {
+				matchedUserIds = append(matchedUserIds, user.UserId)
+			}
+		}
+		leftUserIds = append(leftUserIds, stringutil.Diff(boundUserIds, matchedUserIds)...)
+		joinedUserIds = append(joinedUserIds, stringutil.Diff(matchedUserIds, boundUserIds)...)
+	}
+	return leftUserIds, joinedUserIds, nil
+}
+
+// reconcileGroupRule queues the group for ServeRuleGroupReconciler if it is dynamic, it is
+// called once the rule is changed; if the queue is full the periodic reconcile applies the rule.
+func reconcileGroupRule(ctx context.Context, group *models.Group) {
+	if group.MembershipRule == "" {
+		return
+	}
+	select {
+	case ruleGroupQueue <- group.GroupId:
+	default:
+		logger.Warnf(ctx, "Reconcile queue is full, dynamic group [%s] waits for the periodic reconcile", group.GroupId)
+	}
+}
+
+// ReconcileRuleGroups recomputes the members of all dynamic groups, a failed group
+// does not stop the others.
+func ReconcileRuleGroups(ctx context.Context) error {
+	groupIds, err := getRuleGroupIds(ctx)
+	if err != nil {
+		return err
+	}
+	var lastErr error
+	for _, groupId := range groupIds {
+		if err := reconcileRuleGroup(ctx, groupId); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// ServeRuleGroupReconciler reconciles all dynamic groups periodically,
+// and the queued groups as soon as their rules are changed.
+func ServeRuleGroupReconciler(ctx context.Context) {
+	ticker := time.NewTicker(global.Global().Config.DynamicGroup.ReconcileInterval)
+	defer ticker.Stop()
+	if err := ReconcileRuleGroups(ctx); err != nil {
+		logger.Errorf(ctx, "Reconcile dynamic groups failed: %+v", err)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case groupId := <-ruleGroupQueue:
+			if err := reconcileRuleGroup(ctx, groupId); err != nil {
+				logger.Errorf(ctx, "Reconcile dynamic group [%s] failed: %+v", groupId, err)
+			}
+		case <-ticker.C:
+			if err := ReconcileRuleGroups(ctx); err != nil {
+				logger.Errorf(ctx, "Reconcile dynamic groups failed: %+v", err)
+			}
+		}
+	}
+}
+
+func PreviewRule(ctx context.Context, req *pb.PreviewRuleRequest) (*pb.PreviewRuleResponse, error) {
+	rule, err := parseMembershipRule(ctx, constants.ColumnMembershipRule, req.MembershipRule)
+	if err != nil {
+		return nil, err
+	}
+	limit := db.GetLimitFromRequest(req)
+	offset := db.GetOffsetFromRequest(req)
+
+	// only the users of the page are kept, the others are counted
+	var total uint32
+	var userSet []*pb.User
+	if err := scanRuleUsers(ctx, global.Global().Database.DB, rule, func(user *models.User) {
+		if total >= offset && total < offset+limit {
+			userSet = append(userSet, user.ToPB())
+		}
+		total++
+	}); err != nil {
+		return nil, err
+	}
+	return &pb.PreviewRuleResponse{
+		Total:   total,
+		UserSet: userSet,
+	}, nil
+}
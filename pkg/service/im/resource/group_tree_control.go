@@ -0,0 +1,164 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/global"
+	"kubesphere.io/im/pkg/models"
+	"kubesphere.io/im/pkg/pb"
+)
+
+type groupCount struct {
+	GroupId string
+	Count   uint32
+}
+
+func GetGroupTree(ctx context.Context, req *pb.GetGroupTreeRequest) (*pb.GetGroupTreeResponse, error) {
+	var roots []*models.Group
+	if req.RootGroupId != "" {
+		root, err := GetGroup(ctx, req.RootGroupId)
+		if err != nil {
+			return nil, err
+		}
+		roots = append(roots, root)
+	} else {
+		if err := global.Global().Database.Table(constants.TableGroup).
+			Where(constants.ColumnParentGroupId+" = ?", "").
+			Where(constants.ColumnStatus+" = ?", constants.StatusActive).
+			Order(constants.ColumnCreateTime).
+			Find(&roots).Error; err != nil {
+			logger.Errorf(ctx, "Get root groups failed: %+v", err)
+			return nil, err
+		}
+	}
+	if len(roots) == 0 {
+		return &pb.GetGroupTreeResponse{}, nil
+	}
+
+	var rootGroupIds []string
+	for _, root := range roots {
+		rootGroupIds = append(rootGroupIds, root.GroupId)
+	}
+	var descendants []*models.Group
+	tx := global.Global().Database.Table(constants.TableGroup).
+		Select("`group`.*").
+		Joins("JOIN `group_closure` on `group_closure`.descendant_group_id=`group`.group_id").
+		Where("`group_closure`.ancestor_group_id in (?) AND `group_closure`.depth > 0", rootGroupIds).
+		Where("`group`.status = ?", constants.StatusActive)
+	if req.MaxDepth > 0 {
+		tx = tx.Where("`group_closure`.depth <= ?", req.MaxDepth)
+	}
+	if err := tx.Order("`group`.create_time").Scan(&descendants).Error; err != nil {
+		logger.Errorf(ctx, "Get sub groups of groups %v failed: %+v", rootGroupIds, err)
+		return nil, err
+	}
+
+	nodes := make(map[string]*pb.GroupTreeNode)
+	var groupIds []string
+	for _, group := range append(roots, descendants...) {
+		if _, ok := nodes[group.GroupId]; ok {
+			continue
+		}
+		nodes[group.GroupId] = &pb.GroupTreeNode{Group: group.ToPB()}
+		groupIds = append(groupIds, group.GroupId)
+	}
+	for _, group := range descendants {
+		if parent, ok := nodes[group.ParentGroupId]; ok {
+			parent.Children = append(parent.Children, nodes[group.GroupId])
+		}
+	}
+
+	childCounts, err := countGroupChildren(ctx, groupIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, count := range childCounts {
+		nodes[count.GroupId].ChildCount = count.Count
+	}
+
+	if req.WithMemberCount {
+		if err := setGroupMemberCounts(ctx, nodes, groupIds); err != nil {
+			return nil, err
+		}
+	}
+
+	var nodeSet []*pb.GroupTreeNode
+	for _, root := range roots {
+		nodeSet = append(nodeSet, nodes[root.GroupId])
+	}
+	return &pb.GetGroupTreeResponse{
+		NodeSet: nodeSet,
+	}, nil
+}
+
+func countGroupChildren(ctx context.Context, groupIds []string) ([]*groupCount, error) {
+	var counts []*groupCount
+	if err := global.Global().Database.Table(constants.TableGroup).
+		Select(constants.ColumnParentGroupId+" AS group_id, COUNT(*) AS count").
+		Where(constants.ColumnParentGroupId+" in (?)", groupIds).
+		Where(constants.ColumnStatus+" = ?", constants.StatusActive).
+		Group(constants.ColumnParentGroupId).
+		Scan(&counts).Error; err != nil {
+		logger.Errorf(ctx, "Count sub groups failed: %+v", err)
+		return nil, err
+	}
+	return counts, nil
+}
+
+// setGroupMemberCounts sets the members bound to each group and the distinct
+// members of its active subtree, a user in several groups is counted once.
+func setGroupMemberCounts(ctx context.Context, nodes map[string]*pb.GroupTreeNode, groupIds []string) error {
+	for _, groupId := range groupIds {
+		nodes[groupId].DirectMemberCount = &wrappers.UInt32Value{}
+		nodes[groupId].TotalMemberCount = &wrappers.UInt32Value{}
+	}
+
+	var directCounts []*groupCount
+	if err := activeBindings(global.Global().Database.Table(constants.TableUserGroupBinding)).
+		Select(constants.ColumnGroupId+", COUNT(*) AS count").
+		Where(constants.ColumnGroupId+" in (?)", groupIds).
+		Group(constants.ColumnGroupId).
+		Scan(&directCounts).Error; err != nil {
+		logger.Errorf(ctx, "Count group members failed: %+v", err)
+		return err
+	}
+	for _, count := range directCounts {
+		nodes[count.GroupId].DirectMemberCount.Value = count.Count
+	}
+
+	var totalCounts []*groupCount
+	if err := activeBindings(global.Global().Database.Table(constants.TableGroupClosure)).
+		Select("`group_closure`.ancestor_group_id AS group_id, COUNT(DISTINCT `user_group_binding`.user_id) AS count").
+		Joins("JOIN `group` on `group`.group_id=`group_closure`.descendant_group_id AND `group`.status = ?", constants.StatusActive).
+		Joins("JOIN `user_group_binding` on `user_group_binding`.group_id=`group_closure`.descendant_group_id").
+		Where("`group_closure`.ancestor_group_id in (?)", groupIds).
+		Group("`group_closure`.ancestor_group_id").
+		Scan(&totalCounts).Error; err != nil {
+		logger.Errorf(ctx, "Count subtree members failed: %+v", err)
+		return err
+	}
+	for _, count := range totalCounts {
+		nodes[count.GroupId].TotalMemberCount.Value = count.Count
+	}
+	return nil
+}
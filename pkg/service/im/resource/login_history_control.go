@@ -0,0 +1,139 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/db"
+	"kubesphere.io/im/pkg/gerr"
+	"kubesphere.io/im/pkg/global"
+	"kubesphere.io/im/pkg/models"
+	"kubesphere.io/im/pkg/pb"
+	"kubesphere.io/im/pkg/util/ctxutil"
+	"kubesphere.io/im/pkg/util/stringutil"
+)
+
+// recordLogin saves the outcome of the password check, a failure to record
+// is logged only so that it never blocks the login.
+func recordLogin(ctx context.Context, userId string, success bool) {
+	trustedProxies := stringutil.SimplifyStringList(strings.Split(global.Global().Config.Login.TrustedProxies, ","))
+	history := models.NewLoginHistory(userId, success, ctxutil.GetClientIp(ctx, trustedProxies), ctxutil.GetUserAgent(ctx))
+	if err := global.Global().Database.Create(history).Error; err != nil {
+		logger.Errorf(ctx, "Insert login history of user [%s] failed: %+v", userId, err)
+		return
+	}
+	if !success {
+		return
+	}
+	if err := global.Global().Database.Table(constants.TableUser).
+		Where(constants.ColumnUserId+" = ?", userId).
+		UpdateColumn(constants.ColumnLastLoginTime, history.CreateTime).Error; err != nil {
+		logger.Errorf(ctx, "Update last login time of user [%s] failed: %+v", userId, err)
+	}
+}
+
+func ListLoginHistory(ctx context.Context, req *pb.ListLoginHistoryRequest) (*pb.ListLoginHistoryResponse, error) {
+	limit := db.GetLimitFromRequest(req)
+	offset := db.GetOffsetFromRequest(req)
+
+	chain := db.GetChain(global.Global().Database.Table(constants.TableLoginHistory)).
+		BuildFilterConditions(req, constants.TableLoginHistory)
+	if req.StartTime != nil {
+		startTime, err := ptypes.Timestamp(req.StartTime)
+		if err != nil {
+			err := gerr.NewInvalidArgument("start_time", "invalid start time: %v", err)
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+		chain.DB = chain.Where(constants.ColumnCreateTime+" >= ?", startTime)
+	}
+	if req.EndTime != nil {
+		endTime, err := ptypes.Timestamp(req.EndTime)
+		if err != nil {
+			err := gerr.NewInvalidArgument("end_time", "invalid end time: %v", err)
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+		chain.DB = chain.Where(constants.ColumnCreateTime+" < ?", endTime)
+	}
+
+	var histories []*models.LoginHistory
+	var count int
+
+	if err := chain.Count(&count).Error; err != nil {
+		logger.Errorf(ctx, "List login history count failed: %+v", err)
+		return nil, err
+	}
+
+	if err := chain.
+		AddQueryOrderDir(req, constants.ColumnCreateTime).
+		Offset(offset).
+		Limit(limit).
+		Find(&histories).Error; err != nil {
+		logger.Errorf(ctx, "List login history failed: %+v", err)
+		return nil, err
+	}
+
+	var pbHistories []*pb.LoginHistory
+	for _, history := range histories {
+		pbHistories = append(pbHistories, history.ToPB())
+	}
+
+	return &pb.ListLoginHistoryResponse{
+		LoginHistorySet: pbHistories,
+		Total:           uint32(count),
+	}, nil
+}
+
+// CleanLoginHistory deletes the login history older than the retention.
+func CleanLoginHistory(ctx context.Context) error {
+	retention := global.Global().Config.Login.HistoryRetention
+	if retention <= 0 {
+		return nil
+	}
+	result := global.Global().Database.
+		Delete(models.LoginHistory{}, constants.ColumnCreateTime+" < ?", time.Now().Add(-retention))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		logger.Infof(ctx, "Cleaned [%d] login history older than [%s]", result.RowsAffected, retention)
+	}
+	return nil
+}
+
+func ServeLoginHistoryCleaner(ctx context.Context) {
+	ticker := time.NewTicker(global.Global().Config.Login.HistoryCleanInterval)
+	defer ticker.Stop()
+	for {
+		if err := CleanLoginHistory(ctx); err != nil {
+			logger.Errorf(ctx, "Clean login history failed: %+v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
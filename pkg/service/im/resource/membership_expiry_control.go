@@ -0,0 +1,321 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/db"
+	"kubesphere.io/im/pkg/gerr"
+	"kubesphere.io/im/pkg/global"
+	"kubesphere.io/im/pkg/models"
+	"kubesphere.io/im/pkg/pb"
+)
+
+// sweepBatchSize is the number of expired bindings removed at a time.
+const sweepBatchSize = 100
+
+// activeBindings filters out the expired bindings of query, they grant no
+// membership even before the sweeper removes them.
+func activeBindings(query *gorm.DB) *gorm.DB {
+	return query.Where("(`user_group_binding`.expire_time IS NULL OR `user_group_binding`.expire_time > ?)", time.Now())
+}
+
+// removeExpiredBindings removes the expired bindings of the users in the transaction and records
+// the removals like the sweeper does, so that the users can be bound to the groups again;
+// the bindings in all groups are removed if groupIds is nil.
+func removeExpiredBindings(ctx context.Context, tx *gorm.DB, userIds, groupIds []string) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+	now := time.Now()
+	query := tx.Table(constants.TableUserGroupBinding).
+		Where(constants.ColumnUserId+" in (?)", userIds).
+		Where(constants.ColumnExpireTime+" <= ?", now)
+	if groupIds != nil {
+		query = query.Where(constants.ColumnGroupId+" in (?)", groupIds)
+	}
+	var bindings []*models.UserGroupBinding
+	if err := db.GetChain(query).ForUpdate().Find(&bindings).Error; err != nil {
+		logger.Errorf(ctx, "Get expired user group bindings failed: %+v", err)
+		return err
+	}
+	for _, binding := range bindings {
+		if err := tx.Delete(models.UserGroupBinding{}, constants.ColumnId+" = ?", binding.Id).Error; err != nil {
+			logger.Errorf(ctx, "Delete expired user group binding [%s] failed: %+v", binding.Id, err)
+			return err
+		}
+		if err := tx.Create(models.NewMembershipRemoval(binding, constants.RemoveReasonExpired)).Error; err != nil {
+			logger.Errorf(ctx, "Insert membership removal failed: %+v", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// getExpireTime returns the requested expire time of memberships, which must be in the future.
+func getExpireTime(ctx context.Context, field string, t *timestamp.Timestamp) (*time.Time, error) {
+	if t == nil {
+		return nil, nil
+	}
+	expireTime, err := ptypes.Timestamp(t)
+	if err != nil {
+		err := gerr.NewInvalidArgument(field, "invalid expire time: %v", err)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	if !expireTime.After(time.Now()) {
+		err := gerr.NewInvalidArgument(field, "expire time [%s] is not in the future", expireTime)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	return &expireTime, nil
+}
+
+func ExtendMembership(ctx context.Context, req *pb.ExtendMembershipRequest) (*pb.ExtendMembershipResponse, error) {
+	if len(req.UserId) == 0 || len(req.GroupId) == 0 {
+		err := status.Errorf(codes.InvalidArgument, "empty user id or group id")
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	if req.ExpireTime == nil {
+		err := gerr.NewInvalidArgument("expire_time", "empty expire time")
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	expireTime, err := getExpireTime(ctx, "expire_time", req.ExpireTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var bindings []*models.UserGroupBinding
+	tx := global.Global().Database.Begin()
+	{
+		// an expired membership can not be extended
+		if err := db.GetChain(activeBindings(tx.Table(constants.TableUserGroupBinding))).
+			ForUpdate().
+			Where(constants.ColumnGroupId+" in (?)", req.GroupId).
+			Where(constants.ColumnUserId+" in (?)", req.UserId).
+			Find(&bindings).Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Get user group binding failed: %+v", err)
+			return nil, err
+		}
+		if len(bindings) != len(req.UserId)*len(req.GroupId) {
+			tx.Rollback()
+			err := status.Errorf(codes.PermissionDenied, "user not in group")
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+
+		var bindingIds []string
+		for _, binding := range bindings {
+			// extending can not turn a permanent membership into an expiring one
+			if binding.ExpireTime == nil {
+				tx.Rollback()
+				err := gerr.NewInvalidArgument("expire_time", "membership of user [%s] in group [%s] never expires", binding.UserId, binding.GroupId)
+				logger.Errorf(ctx, "%+v", err)
+				return nil, err
+			}
+			if expireTime.Before(*binding.ExpireTime) {
+				tx.Rollback()
+				err := gerr.NewInvalidArgument("expire_time", "membership of user [%s] in group [%s] expires later at [%s]",
+					binding.UserId, binding.GroupId, binding.ExpireTime)
+				logger.Errorf(ctx, "%+v", err)
+				return nil, err
+			}
+			binding.ExpireTime = expireTime
+			bindingIds = append(bindingIds, binding.Id)
+		}
+
+		if err := tx.Table(constants.TableUserGroupBinding).
+			Where(constants.ColumnId+" in (?)", bindingIds).
+			UpdateColumn(constants.ColumnExpireTime, *expireTime).Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Extend user group binding failed: %+v", err)
+			return nil, err
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf(ctx, "Extend user group binding failed: %+v", err)
+		return nil, err
+	}
+
+	var pbBindings []*pb.UserGroupBinding
+	for _, binding := range bindings {
+		pbBindings = append(pbBindings, binding.ToPB())
+	}
+	return &pb.ExtendMembershipResponse{
+		BindingSet: pbBindings,
+	}, nil
+}
+
+func ListExpiringMemberships(ctx context.Context, req *pb.ListExpiringMembershipsRequest) (*pb.ListExpiringMembershipsResponse, error) {
+	limit := db.GetLimitFromRequest(req)
+	offset := db.GetOffsetFromRequest(req)
+
+	chain := db.GetChain(global.Global().Database.Table(constants.TableUserGroupBinding)).
+		BuildFilterConditions(req, constants.TableUserGroupBinding)
+	chain.DB = chain.Where(constants.ColumnExpireTime+" > ?", time.Now())
+	if req.ExpireBefore != nil {
+		expireBefore, err := ptypes.Timestamp(req.ExpireBefore)
+		if err != nil {
+			err := gerr.NewInvalidArgument("expire_before", "invalid expire time: %v", err)
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+		chain.DB = chain.Where(constants.ColumnExpireTime+" < ?", expireBefore)
+	}
+
+	var bindings []*models.UserGroupBinding
+	var count int
+
+	if err := chain.Count(&count).Error; err != nil {
+		logger.Errorf(ctx, "List expiring memberships count failed: %+v", err)
+		return nil, err
+	}
+
+	if err := chain.
+		Order(constants.ColumnExpireTime).
+		Offset(offset).
+		Limit(limit).
+		Find(&bindings).Error; err != nil {
+		logger.Errorf(ctx, "List expiring memberships failed: %+v", err)
+		return nil, err
+	}
+
+	var pbBindings []*pb.UserGroupBinding
+	for _, binding := range bindings {
+		pbBindings = append(pbBindings, binding.ToPB())
+	}
+
+	return &pb.ListExpiringMembershipsResponse{
+		BindingSet: pbBindings,
+		Total:      uint32(count),
+	}, nil
+}
+
+func ListMembershipRemovals(ctx context.Context, req *pb.ListMembershipRemovalsRequest) (*pb.ListMembershipRemovalsResponse, error) {
+	limit := db.GetLimitFromRequest(req)
+	offset := db.GetOffsetFromRequest(req)
+
+	chain := db.GetChain(global.Global().Database.Table(constants.TableMembershipRemoval)).
+		BuildFilterConditions(req, constants.TableMembershipRemoval)
+
+	var removals []*models.MembershipRemoval
+	var count int
+
+	if err := chain.Count(&count).Error; err != nil {
+		logger.Errorf(ctx, "List membership removals count failed: %+v", err)
+		return nil, err
+	}
+
+	if err := chain.
+		Order(constants.ColumnCreateTime + " DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&removals).Error; err != nil {
+		logger.Errorf(ctx, "List membership removals failed: %+v", err)
+		return nil, err
+	}
+
+	var pbRemovals []*pb.MembershipRemoval
+	for _, removal := range removals {
+		pbRemovals = append(pbRemovals, removal.ToPB())
+	}
+
+	return &pb.ListMembershipRemovalsResponse{
+		RemovalSet: pbRemovals,
+		Total:      uint32(count),
+	}, nil
+}
+
+// SweepExpiredMemberships removes the expired bindings and records the removals.
+func SweepExpiredMemberships(ctx context.Context) error {
+	now := time.Now()
+	for {
+		var bindings []*models.UserGroupBinding
+		if err := global.Global().Database.Table(constants.TableUserGroupBinding).
+			Where(constants.ColumnExpireTime+" <= ?", now).
+			Order(constants.ColumnExpireTime).
+			Limit(sweepBatchSize).
+			Find(&bindings).Error; err != nil {
+			logger.Errorf(ctx, "Get expired user group bindings failed: %+v", err)
+			return err
+		}
+
+		for _, binding := range bindings {
+			if err := removeExpiredBinding(ctx, binding, now); err != nil {
+				logger.Errorf(ctx, "Remove expired user group binding [%s] failed: %+v", binding.Id, err)
+				return err
+			}
+		}
+		if len(bindings) < sweepBatchSize {
+			return nil
+		}
+	}
+}
+
+func removeExpiredBinding(ctx context.Context, binding *models.UserGroupBinding, now time.Time) error {
+	tx := global.Global().Database.Begin()
+	{
+		// the binding may be extended or removed since selected
+		result := tx.Delete(models.UserGroupBinding{},
+			constants.ColumnId+" = ? AND "+constants.ColumnExpireTime+" <= ?", binding.Id, now)
+		if result.Error != nil {
+			tx.Rollback()
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			tx.Rollback()
+			return nil
+		}
+		if err := tx.Create(models.NewMembershipRemoval(binding, constants.RemoveReasonExpired)).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+	logger.Infof(ctx, "Removed user [%s] from group [%s] expired at [%s]", binding.UserId, binding.GroupId, binding.ExpireTime)
+	return nil
+}
+
+func ServeMembershipSweeper(ctx context.Context) {
+	ticker := time.NewTicker(global.Global().Config.Membership.SweepInterval)
+	defer ticker.Stop()
+	for {
+		if err := SweepExpiredMemberships(ctx); err != nil {
+			logger.Errorf(ctx, "Sweep expired memberships failed: %+v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/db"
+	"kubesphere.io/im/pkg/gerr"
+	"kubesphere.io/im/pkg/models"
+	"kubesphere.io/im/pkg/util/stringutil"
+)
+
+const extraPathPrefix = constants.ColumnExtra + "."
+
+var reExtraKey = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]{0,49}$`)
+
+// getUpdatePaths returns the paths of the update mask, or the default paths of
+// the fields that are not empty when the mask is absent.
+func getUpdatePaths(ctx context.Context, mask *field_mask.FieldMask, validPaths, defaultPaths []string) ([]string, error) {
+	if mask == nil {
+		return defaultPaths, nil
+	}
+	for _, path := range mask.Paths {
+		if stringutil.Contains(validPaths, path) {
+			continue
+		}
+		if strings.HasPrefix(path, extraPathPrefix) && reExtraKey.MatchString(strings.TrimPrefix(path, extraPathPrefix)) {
+			continue
+		}
+		err := gerr.NewInvalidArgument("update_mask", "unsupported path [%s] of update mask", path)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	return stringutil.Unique(mask.Paths), nil
+}
+
+func hasExtraPath(paths []string) bool {
+	for _, path := range paths {
+		if path == constants.ColumnExtra || strings.HasPrefix(path, extraPathPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeExtra applies the extra paths to the extra saved in database, "extra" replaces
+// the whole extra and "extra.key" sets the key, or deletes it if missing from the request.
+// The row is locked by tx so that writers of different keys do not overwrite each other.
+func mergeExtra(ctx context.Context, tx *gorm.DB, target, id string, extra map[string]string, paths []string) (*string, error) {
+	idColumn := constants.ColumnUserId
+	if target == constants.TableGroup {
+		idColumn = constants.ColumnGroupId
+	}
+
+	// the lock is only taken by the query callback of Take and Find, not by Row
+	var saved struct {
+		Extra *string
+	}
+	if err := db.GetChain(tx.Table(target)).
+		ForUpdate().
+		Where(idColumn+" = ?", id).
+		Select(constants.ColumnExtra).
+		Take(&saved).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			err = gerr.NewNotFound(target, id)
+		}
+		logger.Errorf(ctx, "Get extra of [%s] failed: %+v", id, err)
+		return nil, err
+	}
+	merged, err := models.DecodeExtra(saved.Extra)
+	if err != nil {
+		logger.Errorf(ctx, "Decode extra of [%s] failed: %+v", id, err)
+		return nil, err
+	}
+	if merged == nil {
+		merged = make(map[string]string)
+	}
+
+	if stringutil.Contains(paths, constants.ColumnExtra) {
+		merged = make(map[string]string)
+		for key, value := range extra {
+			merged[key] = value
+		}
+	}
+	for _, path := range paths {
+		if !strings.HasPrefix(path, extraPathPrefix) {
+			continue
+		}
+		key := strings.TrimPrefix(path, extraPathPrefix)
+		if value, ok := extra[key]; ok {
+			merged[key] = value
+		} else {
+			delete(merged, key)
+		}
+	}
+
+	return encodeExtra(ctx, target, id, merged)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/blob"
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/gerr"
+	"kubesphere.io/im/pkg/global"
+	"kubesphere.io/im/pkg/pb"
+	"kubesphere.io/im/pkg/util/stringutil"
+)
+
+func UploadAvatar(ctx context.Context, req *pb.UploadAvatarRequest) (*pb.UploadAvatarResponse, error) {
+	userId := req.UserId
+	user, err := GetUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := global.Global().Config.Avatar
+	if len(req.Content) == 0 {
+		err := gerr.NewInvalidArgument("content", "empty avatar")
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	if len(req.Content) > cfg.MaxSize {
+		err := gerr.NewInvalidArgument("content", "avatar size %d exceeds the limit %d", len(req.Content), cfg.MaxSize)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	// the content type is sniffed from the content, never trusted from the client
+	contentType := http.DetectContentType(req.Content)
+	contentTypes := stringutil.SimplifyStringList(strings.Split(cfg.ContentTypes, ","))
+	if !stringutil.Contains(contentTypes, contentType) {
+		err := gerr.NewInvalidArgument("content", "unsupported avatar type [%s], should be one of %v", contentType, contentTypes)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := global.Global().BlobStore.Put(ctx, constants.BlobPrefixAvatar+user.UserId, &blob.Blob{
+		Data:        req.Content,
+		ContentType: contentType,
+		UpdateTime:  now,
+	}); err != nil {
+		logger.Errorf(ctx, "Save avatar of user [%s] failed: %+v", userId, err)
+		return nil, err
+	}
+
+	if err := global.Global().Database.Table(constants.TableUser).
+		Where(constants.ColumnUserId+" = ?", userId).
+		Updates(map[string]interface{}{
+			constants.ColumnAvatarUpdateTime: now,
+			constants.ColumnUpdateTime:       now,
+		}).Error; err != nil {
+		logger.Errorf(ctx, "Update avatar time of user [%s] failed: %+v", userId, err)
+		return nil, err
+	}
+
+	updateTime, _ := ptypes.TimestampProto(now)
+	return &pb.UploadAvatarResponse{
+		UserId:      userId,
+		ContentType: contentType,
+		UpdateTime:  updateTime,
+	}, nil
+}
+
+func GetAvatar(ctx context.Context, req *pb.GetAvatarRequest) (*pb.GetAvatarResponse, error) {
+	userId := req.UserId
+	user, err := GetUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if user.AvatarUpdateTime == nil {
+		err := gerr.NewNotFound("avatar", userId)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	avatar, err := global.Global().BlobStore.Get(ctx, constants.BlobPrefixAvatar+user.UserId)
+	if err != nil {
+		if err == blob.ErrNotFound {
+			err = gerr.NewNotFound("avatar", userId)
+		}
+		logger.Errorf(ctx, "Get avatar of user [%s] failed: %+v", userId, err)
+		return nil, err
+	}
+
+	updateTime, _ := ptypes.TimestampProto(avatar.UpdateTime)
+	return &pb.GetAvatarResponse{
+		UserId:      userId,
+		Content:     avatar.Data,
+		ContentType: avatar.ContentType,
+		UpdateTime:  updateTime,
+	}, nil
+}
@@ -20,45 +20,79 @@ import (
 	"context"
 	"time"
 
+	"github.com/jinzhu/gorm"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"openpitrix.io/logger"
 
 	"kubesphere.io/im/pkg/constants"
 	"kubesphere.io/im/pkg/db"
+	"kubesphere.io/im/pkg/gerr"
 	"kubesphere.io/im/pkg/global"
 	"kubesphere.io/im/pkg/models"
 	"kubesphere.io/im/pkg/pb"
-	"kubesphere.io/im/pkg/util/jsonutil"
+	"kubesphere.io/im/pkg/util/phoneutil"
 	"kubesphere.io/im/pkg/util/stringutil"
 )
 
 func CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
-	user := models.NewUser(req.Username, req.Email, req.PhoneNumber, req.Description, req.Password, req.Extra)
+	user, err := newUser(ctx, req)
+	if err != nil {
+		return nil, err
+	}
 
-	// create new record
+	// create new record, a recreated user always gets a new user id,
+	// so the group bindings of a deleted user are never revived
 	if err := global.Global().Database.Create(user).Error; err != nil {
 		logger.Errorf(ctx, "Insert user failed: %+v", err)
 		return nil, err
 	}
+	syncUserRuleGroups(ctx, user.UserId)
 
 	return &pb.CreateUserResponse{
 		UserId: user.UserId,
 	}, nil
 }
 
+// newUser checks the request and returns the user to be inserted.
+func newUser(ctx context.Context, req *pb.CreateUserRequest) (*models.User, error) {
+	phoneNumber, err := normalizePhoneNumber(ctx, req.PhoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkTimezone(ctx, req.Timezone); err != nil {
+		return nil, err
+	}
+
+	user := models.NewUser(req.Username, req.Email, phoneNumber, req.Description, req.Password, req.Extra)
+	user.DisplayName = req.DisplayName
+	user.GivenName = req.GivenName
+	user.FamilyName = req.FamilyName
+	user.Locale = req.Locale
+	user.Timezone = req.Timezone
+	user.Extra, err = encodeExtra(ctx, constants.TableUser, user.UserId, req.Extra)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkUserConflict(ctx, user.UserId, user.Username, user.Email); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
 func DeleteUsers(ctx context.Context, req *pb.DeleteUsersRequest) (*pb.DeleteUsersResponse, error) {
 	userIds := req.UserId
 	if len(userIds) == 0 {
-		err := status.Errorf(codes.InvalidArgument, "empty user id")
+		err := gerr.NewInvalidArgument("user_id", "empty user id")
 		logger.Errorf(ctx, "%+v", err)
 		return nil, err
 	}
 
 	tx := global.Global().Database.Begin()
 	{
-		tx.Delete(models.UserGroupBinding{}, constants.ColumnUserId+" in (?)", userIds)
-		if err := tx.Error; err != nil {
+		if err := tx.Delete(models.UserGroupBinding{}, constants.ColumnUserId+" in (?)", userIds).Error; err != nil {
 			tx.Rollback()
 			logger.Errorf(ctx, "Delete user group binding failed: %+v", err)
 			return nil, err
@@ -84,52 +118,267 @@ func DeleteUsers(ctx context.Context, req *pb.DeleteUsersRequest) (*pb.DeleteUse
 		return nil, err
 	}
 
+	// the users are deleted already, a leftover avatar is not worth failing the request
+	for _, userId := range userIds {
+		if err := global.Global().BlobStore.Delete(ctx, constants.BlobPrefixAvatar+userId); err != nil {
+			logger.Errorf(ctx, "Delete avatar of user [%s] failed: %+v", userId, err)
+		}
+	}
+
 	return &pb.DeleteUsersResponse{
 		UserId: userIds,
 	}, nil
 }
 
+var userUpdatePaths = []string{
+	constants.ColumnUsername,
+	constants.ColumnEmail,
+	constants.ColumnPhoneNumber,
+	constants.ColumnDescription,
+	constants.ColumnExtra,
+	constants.ColumnDisplayName,
+	constants.ColumnGivenName,
+	constants.ColumnFamilyName,
+	constants.ColumnLocale,
+	constants.ColumnTimezone,
+	constants.ColumnStatus,
+}
+
 func ModifyUser(ctx context.Context, req *pb.ModifyUserRequest) (*pb.ModifyUserResponse, error) {
 	userId := req.UserId
-	_, err := GetUser(ctx, userId)
+	attributes, paths, err := getUserUpdates(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
-	attributes := make(map[string]interface{})
-	if req.Username != "" {
-		attributes[constants.ColumnUsername] = req.Username
+	tx := global.Global().Database.Begin()
+	if err := updateUser(ctx, tx, req, attributes, paths); err != nil {
+		tx.Rollback()
+		return nil, err
 	}
-	if req.Description != "" {
-		attributes[constants.ColumnDescription] = req.Description
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf(ctx, "Update user [%s] failed: %+v", userId, err)
+		return nil, err
+	}
+	syncUserRuleGroups(ctx, userId)
+
+	return &pb.ModifyUserResponse{
+		UserId: userId,
+	}, nil
+}
+
+// getUserUpdates checks the request and returns the columns to be written and the paths
+// of the update mask, the extra is merged by updateUser.
+func getUserUpdates(ctx context.Context, req *pb.ModifyUserRequest) (map[string]interface{}, []string, error) {
+	userId := req.UserId
+	user, err := GetUser(ctx, userId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var defaultPaths []string
+	if req.Username != "" {
+		defaultPaths = append(defaultPaths, constants.ColumnUsername)
 	}
 	if req.Email != "" {
-		attributes[constants.ColumnEmail] = stringutil.SimplifyString(req.Email)
+		defaultPaths = append(defaultPaths, constants.ColumnEmail)
 	}
 	if req.PhoneNumber != "" {
-		attributes[constants.ColumnPhoneNumber] = stringutil.SimplifyString(req.PhoneNumber)
+		defaultPaths = append(defaultPaths, constants.ColumnPhoneNumber)
+	}
+	if req.Description != "" {
+		defaultPaths = append(defaultPaths, constants.ColumnDescription)
 	}
 	if len(req.Extra) > 0 {
-		attributes[constants.ColumnExtra] = stringutil.NewString(jsonutil.ToString(req.Extra))
+		defaultPaths = append(defaultPaths, constants.ColumnExtra)
+	}
+	profile := map[string]string{
+		constants.ColumnDisplayName: req.DisplayName,
+		constants.ColumnGivenName:   req.GivenName,
+		constants.ColumnFamilyName:  req.FamilyName,
+		constants.ColumnLocale:      req.Locale,
+		constants.ColumnTimezone:    req.Timezone,
+	}
+	for column, value := range profile {
+		if value != "" {
+			defaultPaths = append(defaultPaths, column)
+		}
+	}
+	if req.Status != "" {
+		defaultPaths = append(defaultPaths, constants.ColumnStatus)
+	}
+	paths, err := getUpdatePaths(ctx, req.UpdateMask, userUpdatePaths, defaultPaths)
+	if err != nil {
+		return nil, nil, err
 	}
-	attributes[constants.ColumnUpdateTime] = time.Now()
 
-	if err := global.Global().Database.Table(constants.TableUser).
+	attributes := make(map[string]interface{})
+	username := user.Username
+	if stringutil.Contains(paths, constants.ColumnUsername) {
+		if req.Username == "" {
+			err := gerr.NewInvalidArgument(constants.ColumnUsername, "empty username")
+			logger.Errorf(ctx, "%+v", err)
+			return nil, nil, err
+		}
+		username = req.Username
+		attributes[constants.ColumnUsername] = username
+	}
+	email := user.Email
+	if stringutil.Contains(paths, constants.ColumnEmail) {
+		email = stringutil.SimplifyString(req.Email)
+		attributes[constants.ColumnEmail] = email
+		// a changed email must be verified again
+		if email != user.Email {
+			attributes[constants.ColumnEmailVerified] = false
+			attributes[constants.ColumnEmailVerifyTime] = nil
+		}
+	}
+	if err := checkUserConflict(ctx, userId, username, email); err != nil {
+		return nil, nil, err
+	}
+
+	if stringutil.Contains(paths, constants.ColumnDescription) {
+		attributes[constants.ColumnDescription] = req.Description
+	}
+	if stringutil.Contains(paths, constants.ColumnPhoneNumber) {
+		phoneNumber, err := normalizePhoneNumber(ctx, req.PhoneNumber)
+		if err != nil {
+			return nil, nil, err
+		}
+		attributes[constants.ColumnPhoneNumber] = phoneNumber
+		// a changed phone number must be verified again
+		if phoneNumber != user.PhoneNumber {
+			attributes[constants.ColumnPhoneVerified] = false
+			attributes[constants.ColumnPhoneVerifyTime] = nil
+		}
+	}
+	if stringutil.Contains(paths, constants.ColumnTimezone) {
+		if err := checkTimezone(ctx, req.Timezone); err != nil {
+			return nil, nil, err
+		}
+	}
+	for column, value := range profile {
+		if stringutil.Contains(paths, column) {
+			attributes[column] = value
+		}
+	}
+	now := time.Now()
+	if stringutil.Contains(paths, constants.ColumnStatus) && req.Status != user.Status {
+		if err := checkUserStatusChange(ctx, user, req.Status); err != nil {
+			return nil, nil, err
+		}
+		attributes[constants.ColumnStatus] = req.Status
+		attributes[constants.ColumnStatusTime] = now
+	}
+	attributes[constants.ColumnUpdateTime] = now
+	return attributes, paths, nil
+}
+
+func updateUser(ctx context.Context, tx *gorm.DB, req *pb.ModifyUserRequest, attributes map[string]interface{}, paths []string) error {
+	userId := req.UserId
+	if hasExtraPath(paths) {
+		extra, err := mergeExtra(ctx, tx, constants.TableUser, userId, req.Extra, paths)
+		if err != nil {
+			return err
+		}
+		attributes[constants.ColumnExtra] = extra
+	}
+
+	if err := tx.Table(constants.TableUser).
 		Where(constants.ColumnUserId+" = ?", userId).
 		Updates(attributes).Error; err != nil {
 		logger.Errorf(ctx, "Update user [%s] failed: %+v", userId, err)
-		return nil, err
+		return err
 	}
+	return nil
+}
 
-	return &pb.ModifyUserResponse{
-		UserId: userId,
-	}, err
+// normalizePhoneNumber converts the phone number to E.164 format, empty phone number is kept as is.
+func normalizePhoneNumber(ctx context.Context, phoneNumber string) (string, error) {
+	phoneNumber = stringutil.SimplifyString(phoneNumber)
+	if phoneNumber == "" {
+		return "", nil
+	}
+	normalized, err := phoneutil.NormalizeE164(phoneNumber, global.Global().Config.PhoneDefaultCountryCode)
+	if err != nil {
+		err = gerr.NewInvalidArgument("phone_number", "invalid phone number [%s]: %v", phoneNumber, err)
+		logger.Errorf(ctx, "%+v", err)
+		return "", err
+	}
+	return normalized, nil
+}
+
+// checkUserStatusChange only allows to disable an active user or to enable a disabled user,
+// deleted and pending users are changed by DeleteUsers and AcceptInvite.
+func checkUserStatusChange(ctx context.Context, user *models.User, newStatus string) error {
+	if newStatus != constants.StatusActive && newStatus != constants.StatusDisabled {
+		err := gerr.NewInvalidArgument(constants.ColumnStatus, "unsupported status [%s]", newStatus)
+		logger.Errorf(ctx, "%+v", err)
+		return err
+	}
+	if user.Status != constants.StatusActive && user.Status != constants.StatusDisabled {
+		err := status.Errorf(codes.FailedPrecondition, "user [%s] is %s", user.UserId, user.Status)
+		logger.Errorf(ctx, "%+v", err)
+		return err
+	}
+	return nil
+}
+
+// checkTimezone makes sure the timezone is an IANA name, such as "Asia/Shanghai".
+func checkTimezone(ctx context.Context, timezone string) error {
+	if timezone == "" {
+		return nil
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		err = gerr.NewInvalidArgument(constants.ColumnTimezone, "invalid timezone [%s]", timezone)
+		logger.Errorf(ctx, "%+v", err)
+		return err
+	}
+	return nil
+}
+
+// checkUserConflict makes sure no other user holds the username or email.
+// Deleted users are ignored unless the username reuse policy is "never".
+func checkUserConflict(ctx context.Context, userId, username, email string) error {
+	tx := global.Global().Database.Table(constants.TableUser).
+		Where(constants.ColumnUserId+" != ?", userId)
+	if global.Global().Config.UsernameReusePolicy != constants.UsernameReusePolicyNever {
+		tx = tx.Where(constants.ColumnStatus+" != ?", constants.StatusDeleted)
+	}
+	if email != "" {
+		tx = tx.Where(constants.ColumnUsername+" = ? OR "+constants.ColumnEmail+" = ?", username, email)
+	} else {
+		tx = tx.Where(constants.ColumnUsername+" = ?", username)
+	}
+
+	var users []*models.User
+	if err := tx.Find(&users).Error; err != nil {
+		logger.Errorf(ctx, "Get users by username [%s] or email [%s] failed: %+v", username, email, err)
+		return err
+	}
+
+	for _, user := range users {
+		if user.Username == username {
+			err := gerr.NewAlreadyExists(constants.TableUser, constants.ColumnUsername, username)
+			logger.Errorf(ctx, "%+v", err)
+			return err
+		}
+		if email != "" && user.Email == email {
+			err := gerr.NewAlreadyExists(constants.TableUser, constants.ColumnEmail, email)
+			logger.Errorf(ctx, "%+v", err)
+			return err
+		}
+	}
+	return nil
 }
 
 func GetUser(ctx context.Context, userId string) (*models.User, error) {
 	var user = &models.User{UserId: userId}
 	if err := global.Global().Database.Table(constants.TableUser).
 		Take(user).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			err = gerr.NewNotFound(constants.TableUser, userId)
+		}
 		logger.Errorf(ctx, "Get user [%s] failed: %+v", userId, err)
 		return nil, err
 	}
@@ -137,18 +386,27 @@ func GetUser(ctx context.Context, userId string) (*models.User, error) {
 	return user, nil
 }
 
-func GetUserWithGroup(ctx context.Context, userId string) (*models.UserWithGroup, error) {
-	user, err := GetUser(ctx, userId)
+func GetUserWithGroup(ctx context.Context, userId string, followMerged bool) (*models.UserWithGroup, error) {
+	getUser := GetUser
+	if followMerged {
+		getUser = GetMergedUser
+	}
+	user, err := getUser(ctx, userId)
 	if err != nil {
 		return nil, err
 	}
-	groups, err := GetGroupsByUserIds(ctx, []string{userId})
+	groups, err := GetGroupsByUserIds(ctx, []string{user.UserId})
+	if err != nil {
+		return nil, err
+	}
+	bindings, err := getUserBindings(ctx, user.UserId)
 	if err != nil {
 		return nil, err
 	}
 	return &models.UserWithGroup{
-		User:   user,
-		Groups: groups,
+		User:     user,
+		Groups:   groups,
+		Bindings: bindings,
 	}, nil
 }
 
@@ -160,9 +418,23 @@ func ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResp
 	req.PhoneNumber = stringutil.SimplifyStringList(req.PhoneNumber)
 	req.Status = stringutil.SimplifyStringList(req.Status)
 
+	// phone numbers are saved in E.164 format
+	for i, phoneNumber := range req.PhoneNumber {
+		normalized, err := normalizePhoneNumber(ctx, phoneNumber)
+		if err != nil {
+			return nil, err
+		}
+		req.PhoneNumber[i] = normalized
+	}
+
 	limit := db.GetLimitFromRequest(req)
 	offset := db.GetOffsetFromRequest(req)
 
+	extraConditions, err := getExtraConditions(ctx, constants.TableUser, req.AttributeFilter)
+	if err != nil {
+		return nil, err
+	}
+
 	var pbUsers []*pb.User
 
 	// get group
@@ -224,6 +496,7 @@ func ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResp
 	if err := db.GetChain(global.Global().Database.Table(constants.TableUser)).
 		AddQueryOrderDir(req, constants.ColumnCreateTime).
 		BuildFilterConditions(req, constants.TableUser).
+		BuildExtraConditions(extraConditions).
 		Offset(offset).
 		Limit(limit).
 		Find(&users).Error; err != nil {
@@ -233,6 +506,7 @@ func ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResp
 
 	if err := db.GetChain(global.Global().Database.Table(constants.TableUser)).
 		BuildFilterConditions(req, constants.TableUser).
+		BuildExtraConditions(extraConditions).
 		Count(&count).Error; err != nil {
 		logger.Errorf(ctx, "List users count failed: %+v", err)
 		return nil, err
@@ -266,9 +540,18 @@ func ListUsersWithGroup(ctx context.Context, req *pb.ListUsersRequest) (*pb.List
 		for _, group := range groups {
 			pbGroups = append(pbGroups, group.ToPB())
 		}
+		bindings, err := getUserBindings(ctx, pbUser.UserId)
+		if err != nil {
+			return nil, err
+		}
+		var pbBindings []*pb.UserGroupBinding
+		for _, binding := range bindings {
+			pbBindings = append(pbBindings, binding.ToPB())
+		}
 		userWithGroups = append(userWithGroups, &pb.UserWithGroup{
-			User:     pbUser,
-			GroupSet: pbGroups,
+			User:       pbUser,
+			GroupSet:   pbGroups,
+			BindingSet: pbBindings,
 		})
 	}
 
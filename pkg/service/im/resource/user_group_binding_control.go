@@ -31,7 +31,7 @@ import (
 
 func GetUserGroupBindings(ctx context.Context, userIds, groupIds []string) ([]*models.UserGroupBinding, error) {
 	var userGroupBindings []*models.UserGroupBinding
-	if err := global.Global().Database.Table(constants.TableUserGroupBinding).
+	if err := activeBindings(global.Global().Database.Table(constants.TableUserGroupBinding)).
 		Where(constants.ColumnGroupId+" in (?)", groupIds).
 		Where(constants.ColumnUserId+" in (?)", userIds).
 		Find(&userGroupBindings).
@@ -49,23 +49,53 @@ func JoinGroup(ctx context.Context, req *pb.JoinGroupRequest) (*pb.JoinGroupResp
 		logger.Errorf(ctx, "%+v", err)
 		return nil, err
 	}
-
-	// check user in group
-	userGroupBindings, err := GetUserGroupBindings(ctx, req.UserId, req.GroupId)
-	if err != nil {
+	if err := checkStaticGroups(ctx, req.GroupId); err != nil {
 		return nil, err
 	}
-	if len(userGroupBindings) != 0 {
-		err := status.Errorf(codes.PermissionDenied, "user already in group")
-		logger.Errorf(ctx, "%+v", err)
+	expireTime, err := getExpireTime(ctx, "expire_time", req.ExpireTime)
+	if err != nil {
 		return nil, err
 	}
 
 	tx := global.Global().Database.Begin()
 	{
+		// the groups are locked so that concurrent joins see the members of each other
+		groups, err := getGroupsForUpdate(ctx, tx, req.GroupId)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if err := removeExpiredBindings(ctx, tx, req.UserId, req.GroupId); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		// check user in group
+		var count int
+		if err := activeBindings(tx.Table(constants.TableUserGroupBinding)).
+			Where(constants.ColumnGroupId+" in (?)", req.GroupId).
+			Where(constants.ColumnUserId+" in (?)", req.UserId).
+			Count(&count).Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Get user group binding failed: %+v", err)
+			return nil, err
+		}
+		if count != 0 {
+			tx.Rollback()
+			err := status.Errorf(codes.PermissionDenied, "user already in group")
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+		if err := checkGroupMemberLimits(ctx, tx, groups, len(req.UserId)); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
 		for _, groupId := range req.GroupId {
 			for _, userId := range req.UserId {
-				if err := tx.Create(models.NewUserGroupBinding(userId, groupId)).Error; err != nil {
+				binding := models.NewUserGroupBinding(userId, groupId)
+				binding.ExpireTime = expireTime
+				if err := tx.Create(binding).Error; err != nil {
 					tx.Rollback()
 					logger.Errorf(ctx, "Insert user group binding failed: %+v", err)
 					return nil, err
@@ -90,6 +120,9 @@ func LeaveGroup(ctx context.Context, req *pb.LeaveGroupRequest) (*pb.LeaveGroupR
 		logger.Errorf(ctx, "%+v", err)
 		return nil, err
 	}
+	if err := checkStaticGroups(ctx, req.GroupId); err != nil {
+		return nil, err
+	}
 
 	// check user in group
 	userGroupBindings, err := GetUserGroupBindings(ctx, req.UserId, req.GroupId)
@@ -116,10 +149,23 @@ func LeaveGroup(ctx context.Context, req *pb.LeaveGroupRequest) (*pb.LeaveGroupR
 	}, nil
 }
 
+// getUserBindings returns the bindings of the user, the earliest first.
+func getUserBindings(ctx context.Context, userId string) ([]*models.UserGroupBinding, error) {
+	var bindings []*models.UserGroupBinding
+	if err := activeBindings(global.Global().Database.Table(constants.TableUserGroupBinding)).
+		Where(constants.ColumnUserId+" = ?", userId).
+		Order(constants.ColumnCreateTime).
+		Find(&bindings).Error; err != nil {
+		logger.Errorf(ctx, "Get user group bindings of user [%s] failed: %+v", userId, err)
+		return nil, err
+	}
+	return bindings, nil
+}
+
 func GetGroupsByUserIds(ctx context.Context, userIds []string) ([]*models.Group, error) {
 	var groups []*models.Group
-	if err := global.Global().Database.
-		Table(constants.TableGroup).
+	if err := activeBindings(global.Global().Database.
+		Table(constants.TableGroup)).
 		Select("`group`.*").
 		Joins("JOIN `user_group_binding` on `user_group_binding`.user_id in (?) AND `user_group_binding`.group_id=`group`.group_id", userIds).
 		Scan(&groups).Error; err != nil {
@@ -132,8 +178,8 @@ func GetGroupsByUserIds(ctx context.Context, userIds []string) ([]*models.Group,
 
 func GetUsersByGroupIds(ctx context.Context, groupIds []string) ([]*models.User, error) {
 	var users []*models.User
-	if err := global.Global().Database.
-		Table(constants.TableUser).
+	if err := activeBindings(global.Global().Database.
+		Table(constants.TableUser)).
 		Select("`user`.*").
 		Joins("JOIN `user_group_binding` on `user_group_binding`.group_id in (?) AND `user_group_binding`.user_id=`user`.user_id", groupIds).
 		Scan(&users).Error; err != nil {
@@ -145,7 +191,7 @@ func GetUsersByGroupIds(ctx context.Context, groupIds []string) ([]*models.User,
 }
 
 func GetUserIdsByGroupIds(ctx context.Context, groupIds []string) ([]string, error) {
-	rows, err := global.Global().Database.Table(constants.TableUserGroupBinding).
+	rows, err := activeBindings(global.Global().Database.Table(constants.TableUserGroupBinding)).
 		Select(constants.ColumnUserId).
 		Where(constants.ColumnGroupId+" in (?)", groupIds).
 		Rows()
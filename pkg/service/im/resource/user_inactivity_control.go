@@ -0,0 +1,166 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"time"
+
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/global"
+	"kubesphere.io/im/pkg/models"
+	"kubesphere.io/im/pkg/util/stringutil"
+)
+
+// DisableInactiveUsers disables the active users that have not logged in for the
+// inactivity threshold, members of the exempt groups are skipped. If warnings are
+// enabled, a user with email is disabled only after the warning has been sent for
+// WarnBefore, so a user missed by a stopped job is still warned in time.
+func DisableInactiveUsers(ctx context.Context) error {
+	cfg := global.Global().Config.Inactivity
+	if cfg.Threshold <= 0 {
+		return nil
+	}
+	warnBefore := cfg.WarnBefore
+	if warnBefore > cfg.Threshold {
+		warnBefore = cfg.Threshold
+	}
+
+	now := time.Now()
+	inactiveSince := now.Add(warnBefore - cfg.Threshold)
+
+	var users []*models.User
+	if err := global.Global().Database.Table(constants.TableUser).
+		Where(constants.ColumnStatus+" = ?", constants.StatusActive).
+		Where("COALESCE("+constants.ColumnLastLoginTime+", "+constants.ColumnCreateTime+") < ?", inactiveSince).
+		Where(constants.ColumnStatusTime+" < ?", inactiveSince).
+		Find(&users).Error; err != nil {
+		logger.Errorf(ctx, "Get inactive users failed: %+v", err)
+		return err
+	}
+	if len(users) == 0 {
+		return nil
+	}
+
+	exemptUserIds, err := getInactivityExemptUserIds(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, user := range users {
+		if stringutil.Contains(exemptUserIds, user.UserId) {
+			continue
+		}
+		lastActiveTime := user.GetLastActiveTime()
+		disableTime := lastActiveTime.Add(cfg.Threshold)
+
+		if warnBefore > 0 && user.Email != "" {
+			warned := user.InactivityWarnTime != nil && user.InactivityWarnTime.After(lastActiveTime)
+			if !warned {
+				if disableTime.Before(now.Add(warnBefore)) {
+					disableTime = now.Add(warnBefore)
+				}
+				if err := sendInactivityWarning(ctx, user, disableTime, now); err != nil {
+					logger.Errorf(ctx, "Send inactivity warning to user [%s] failed: %+v", user.UserId, err)
+				}
+				continue
+			}
+			if now.Before(user.InactivityWarnTime.Add(warnBefore)) {
+				continue
+			}
+		}
+		if now.Before(disableTime) {
+			continue
+		}
+
+		if err := disableInactiveUser(ctx, user, now); err != nil {
+			logger.Errorf(ctx, "Disable inactive user [%s] failed: %+v", user.UserId, err)
+		}
+	}
+	return nil
+}
+
+// getInactivityExemptUserIds returns the users of the exempt groups and their sub groups.
+func getInactivityExemptUserIds(ctx context.Context) ([]string, error) {
+	var groupIds []string
+	if err := global.Global().Database.Table(constants.TableGroup).
+		Where(constants.ColumnStatus+" = ?", constants.StatusActive).
+		Where(constants.ColumnInactivityExempt+" = ?", true).
+		Pluck(constants.ColumnGroupId, &groupIds).Error; err != nil {
+		logger.Errorf(ctx, "Get inactivity exempt groups failed: %+v", err)
+		return nil, err
+	}
+	if len(groupIds) == 0 {
+		return nil, nil
+	}
+
+	subGroupIds, err := getAllSubGroupIds(ctx, groupIds, constants.StatusActive)
+	if err != nil {
+		return nil, err
+	}
+	return GetUserIdsByGroupIds(ctx, append(groupIds, subGroupIds...))
+}
+
+func sendInactivityWarning(ctx context.Context, user *models.User, disableTime, now time.Time) error {
+	if _, err := global.Global().Notifier.SendMail(ctx, user.Email, constants.TemplateInactivityWarning, user.Locale, map[string]interface{}{
+		"Username":    user.Username,
+		"DisableTime": disableTime,
+	}); err != nil {
+		return err
+	}
+	return global.Global().Database.Table(constants.TableUser).
+		Where(constants.ColumnUserId+" = ?", user.UserId).
+		UpdateColumn(constants.ColumnInactivityWarnTime, now).Error
+}
+
+func disableInactiveUser(ctx context.Context, user *models.User, now time.Time) error {
+	// the user may login or be changed since selected
+	result := global.Global().Database.Table(constants.TableUser).
+		Where(constants.ColumnUserId+" = ?", user.UserId).
+		Where(constants.ColumnStatus+" = ?", constants.StatusActive).
+		Where(constants.ColumnStatusTime+" = ?", user.StatusTime).
+		Where("COALESCE("+constants.ColumnLastLoginTime+", "+constants.ColumnCreateTime+") < ?", now.Add(-global.Global().Config.Inactivity.Threshold)).
+		Updates(map[string]interface{}{
+			constants.ColumnStatus:     constants.StatusDisabled,
+			constants.ColumnStatusTime: now,
+			constants.ColumnUpdateTime: now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		logger.Infof(ctx, "Disabled user [%s] inactive since [%s]", user.UserId, user.GetLastActiveTime())
+	}
+	return nil
+}
+
+func ServeInactivityChecker(ctx context.Context) {
+	ticker := time.NewTicker(global.Global().Config.Inactivity.CheckInterval)
+	defer ticker.Stop()
+	for {
+		if err := DisableInactiveUsers(ctx); err != nil {
+			logger.Errorf(ctx, "Disable inactive users failed: %+v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,379 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/db"
+	"kubesphere.io/im/pkg/gerr"
+	"kubesphere.io/im/pkg/global"
+	"kubesphere.io/im/pkg/models"
+	"kubesphere.io/im/pkg/pb"
+	"kubesphere.io/im/pkg/util/stringutil"
+)
+
+func InviteUser(ctx context.Context, req *pb.InviteUserRequest) (*pb.InviteUserResponse, error) {
+	email := stringutil.SimplifyString(req.Email)
+	if email == "" {
+		err := gerr.NewInvalidArgument("email", "empty email")
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	expire := global.Global().Config.InviteExpire
+	if req.Expiry != nil {
+		d, err := ptypes.Duration(req.Expiry)
+		if err != nil || d <= 0 {
+			err := gerr.NewInvalidArgument("expiry", "invalid invite expiry [%s]", req.Expiry.String())
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+		expire = d
+	}
+
+	groupIds := stringutil.Unique(stringutil.SimplifyStringList(req.GroupId))
+	if len(groupIds) > 0 {
+		activeGroupIds, err := getActiveGroupIds(ctx, groupIds)
+		if err != nil {
+			return nil, err
+		}
+		if len(activeGroupIds) != len(groupIds) {
+			err := gerr.NewInvalidArgument("group_id", "some group in %v do not exists", groupIds)
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+		if err := checkStaticGroups(ctx, groupIds); err != nil {
+			return nil, err
+		}
+	}
+
+	// the email is used as username until the invitee chooses one
+	user := models.NewUser(email, email, "", req.Description, "", nil)
+	user.Status = constants.StatusPending
+	extra, err := encodeExtra(ctx, constants.TableUser, user.UserId, req.Extra)
+	if err != nil {
+		return nil, err
+	}
+	user.Extra = extra
+	if err := checkUserConflict(ctx, user.UserId, user.Username, user.Email); err != nil {
+		return nil, err
+	}
+
+	invite, token := models.NewUserInvite(user.UserId, email, groupIds, expire)
+
+	tx := global.Global().Database.Begin()
+	{
+		if err := tx.Create(user).Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Insert user failed: %+v", err)
+			return nil, err
+		}
+		if err := tx.Create(invite).Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Insert user invite failed: %+v", err)
+			return nil, err
+		}
+		// the token is only sent by mail
+		if _, err := global.Global().Notifier.SendMailTx(ctx, tx, email, constants.TemplateUserInvite, req.Locale, map[string]interface{}{
+			"Email":      email,
+			"Token":      token,
+			"ExpireTime": invite.ExpireTime,
+		}); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf(ctx, "Invite user failed: %+v", err)
+		return nil, err
+	}
+
+	expireTime, _ := ptypes.TimestampProto(invite.ExpireTime)
+	return &pb.InviteUserResponse{
+		InviteId:   invite.InviteId,
+		UserId:     user.UserId,
+		ExpireTime: expireTime,
+	}, nil
+}
+
+func AcceptInvite(ctx context.Context, req *pb.AcceptInviteRequest) (*pb.AcceptInviteResponse, error) {
+	if req.Token == "" {
+		err := gerr.NewInvalidArgument("token", "empty invite token")
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	if req.Password == "" {
+		err := gerr.NewInvalidArgument("password", "empty password")
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	var invite = &models.UserInvite{}
+	if err := global.Global().Database.Table(constants.TableUserInvite).
+		Where(constants.ColumnTokenHash+" = ?", models.GetTokenHash(req.Token)).
+		Take(invite).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			err = gerr.NewInvalidArgument("token", "invalid invite token")
+		}
+		logger.Errorf(ctx, "Get user invite failed: %+v", err)
+		return nil, err
+	}
+	if invite.Status != constants.InviteStatusPending {
+		err := status.Errorf(codes.FailedPrecondition, "invite [%s] is %s", invite.InviteId, invite.Status)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	if time.Now().After(invite.ExpireTime) {
+		err := status.Errorf(codes.FailedPrecondition, "invite [%s] expired", invite.InviteId)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	user, err := GetUser(ctx, invite.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user.Status != constants.StatusPending {
+		err := status.Errorf(codes.FailedPrecondition, "user [%s] is %s", user.UserId, user.Status)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	// attributes may have been defined or made required after the invite
+	extra, err := models.DecodeExtra(user.Extra)
+	if err != nil {
+		logger.Errorf(ctx, "Decode extra of user [%s] failed: %+v", user.UserId, err)
+		return nil, err
+	}
+	encodedExtra, err := encodeExtra(ctx, constants.TableUser, user.UserId, extra)
+	if err != nil {
+		return nil, err
+	}
+
+	username := user.Username
+	if req.Username != "" {
+		username = stringutil.SimplifyString(req.Username)
+	}
+	if err := checkUserConflict(ctx, user.UserId, username, user.Email); err != nil {
+		return nil, err
+	}
+
+	// groups deleted after the invite was sent are skipped
+	groupIds, err := getActiveGroupIds(ctx, invite.GetGroupIds())
+	if err != nil {
+		return nil, err
+	}
+
+	tx := global.Global().Database.Begin()
+	{
+		now := time.Now()
+		// guard against concurrent acceptance and revocation
+		result := tx.Table(constants.TableUserInvite).
+			Where(constants.ColumnInviteId+" = ?", invite.InviteId).
+			Where(constants.ColumnStatus+" = ?", constants.InviteStatusPending).
+			Updates(map[string]interface{}{
+				constants.ColumnStatus:     constants.InviteStatusAccepted,
+				constants.ColumnStatusTime: now,
+			})
+		if err := result.Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Update user invite [%s] status failed: %+v", invite.InviteId, err)
+			return nil, err
+		}
+		if result.RowsAffected == 0 {
+			tx.Rollback()
+			err := status.Errorf(codes.FailedPrecondition, "invite [%s] is no longer pending", invite.InviteId)
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+
+		// the invitee proved the ownership of the email by the token
+		attributes := map[string]interface{}{
+			constants.ColumnUsername:        username,
+			constants.ColumnPassword:        models.GetBcryptPassword(req.Password),
+			constants.ColumnStatus:          constants.StatusActive,
+			constants.ColumnStatusTime:      now,
+			constants.ColumnUpdateTime:      now,
+			constants.ColumnEmailVerified:   true,
+			constants.ColumnEmailVerifyTime: now,
+			constants.ColumnExtra:           encodedExtra,
+		}
+		if err := tx.Table(constants.TableUser).
+			Where(constants.ColumnUserId+" = ?", user.UserId).
+			Updates(attributes).Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Activate user [%s] failed: %+v", user.UserId, err)
+			return nil, err
+		}
+
+		if len(groupIds) > 0 {
+			groups, err := getGroupsForUpdate(ctx, tx, groupIds)
+			if err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+			if err := checkGroupMemberLimits(ctx, tx, groups, 1); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
+		for _, groupId := range groupIds {
+			if err := tx.Create(models.NewUserGroupBinding(user.UserId, groupId)).Error; err != nil {
+				tx.Rollback()
+				logger.Errorf(ctx, "Insert user group binding failed: %+v", err)
+				return nil, err
+			}
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf(ctx, "Accept invite failed: %+v", err)
+		return nil, err
+	}
+	syncUserRuleGroups(ctx, user.UserId)
+
+	return &pb.AcceptInviteResponse{
+		UserId: user.UserId,
+	}, nil
+}
+
+func ListInvites(ctx context.Context, req *pb.ListInvitesRequest) (*pb.ListInvitesResponse, error) {
+	req.InviteId = stringutil.SimplifyStringList(req.InviteId)
+	req.UserId = stringutil.SimplifyStringList(req.UserId)
+	req.Email = stringutil.SimplifyStringList(req.Email)
+	req.Status = stringutil.SimplifyStringList(req.Status)
+
+	limit := db.GetLimitFromRequest(req)
+	offset := db.GetOffsetFromRequest(req)
+
+	var invites []*models.UserInvite
+	var count int
+
+	if err := db.GetChain(global.Global().Database.Table(constants.TableUserInvite)).
+		AddQueryOrderDir(req, constants.ColumnCreateTime).
+		BuildFilterConditions(req, constants.TableUserInvite).
+		Offset(offset).
+		Limit(limit).
+		Find(&invites).Error; err != nil {
+		logger.Errorf(ctx, "List invites failed: %+v", err)
+		return nil, err
+	}
+
+	if err := db.GetChain(global.Global().Database.Table(constants.TableUserInvite)).
+		BuildFilterConditions(req, constants.TableUserInvite).
+		Count(&count).Error; err != nil {
+		logger.Errorf(ctx, "List invites count failed: %+v", err)
+		return nil, err
+	}
+
+	var pbInvites []*pb.Invite
+	for _, invite := range invites {
+		pbInvites = append(pbInvites, invite.ToPB())
+	}
+
+	return &pb.ListInvitesResponse{
+		InviteSet: pbInvites,
+		Total:     uint32(count),
+	}, nil
+}
+
+func RevokeInvite(ctx context.Context, req *pb.RevokeInviteRequest) (*pb.RevokeInviteResponse, error) {
+	inviteId := stringutil.SimplifyString(req.InviteId)
+	if inviteId == "" {
+		err := gerr.NewInvalidArgument("invite_id", "empty invite id")
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	var invite = &models.UserInvite{InviteId: inviteId}
+	if err := global.Global().Database.Table(constants.TableUserInvite).
+		Take(invite).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			err = gerr.NewNotFound(constants.TableUserInvite, inviteId)
+		}
+		logger.Errorf(ctx, "Get user invite [%s] failed: %+v", inviteId, err)
+		return nil, err
+	}
+
+	tx := global.Global().Database.Begin()
+	{
+		now := time.Now()
+		result := tx.Table(constants.TableUserInvite).
+			Where(constants.ColumnInviteId+" = ?", inviteId).
+			Where(constants.ColumnStatus+" = ?", constants.InviteStatusPending).
+			Updates(map[string]interface{}{
+				constants.ColumnStatus:     constants.InviteStatusRevoked,
+				constants.ColumnStatusTime: now,
+			})
+		if err := result.Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Update user invite [%s] status failed: %+v", inviteId, err)
+			return nil, err
+		}
+		if result.RowsAffected == 0 {
+			tx.Rollback()
+			err := status.Errorf(codes.FailedPrecondition, "invite [%s] is no longer pending", inviteId)
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+
+		// release the email held by the pending user
+		if err := tx.Table(constants.TableUser).
+			Where(constants.ColumnUserId+" = ?", invite.UserId).
+			Where(constants.ColumnStatus+" = ?", constants.StatusPending).
+			Updates(map[string]interface{}{
+				constants.ColumnStatus:     constants.StatusDeleted,
+				constants.ColumnStatusTime: now,
+				constants.ColumnUpdateTime: now,
+			}).Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Delete pending user [%s] failed: %+v", invite.UserId, err)
+			return nil, err
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf(ctx, "Revoke invite failed: %+v", err)
+		return nil, err
+	}
+
+	return &pb.RevokeInviteResponse{
+		InviteId: inviteId,
+	}, nil
+}
+
+func getActiveGroupIds(ctx context.Context, groupIds []string) ([]string, error) {
+	if len(groupIds) == 0 {
+		return nil, nil
+	}
+	var activeGroupIds []string
+	if err := global.Global().Database.Table(constants.TableGroup).
+		Where(constants.ColumnGroupId+" in (?)", groupIds).
+		Where(constants.ColumnStatus+" = ?", constants.StatusActive).
+		Pluck(constants.ColumnGroupId, &activeGroupIds).Error; err != nil {
+		logger.Errorf(ctx, "Get active group ids failed: %+v", err)
+		return nil, err
+	}
+	return activeGroupIds, nil
+}
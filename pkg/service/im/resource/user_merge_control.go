@@ -0,0 +1,273 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/db"
+	"kubesphere.io/im/pkg/gerr"
+	"kubesphere.io/im/pkg/global"
+	"kubesphere.io/im/pkg/models"
+	"kubesphere.io/im/pkg/pb"
+	"kubesphere.io/im/pkg/util/jsonutil"
+	"kubesphere.io/im/pkg/util/stringutil"
+)
+
+// tables whose rows of the source user are moved to the target user, the login
+// history stays with the source user as a record of who actually logged in
+var mergeUserTables = []string{
+	constants.TableUserVerification,
+	constants.TableUserInvite,
+}
+
+// MergeUsers moves the groups, tokens and extra of the source user to the target user,
+// then deletes the source user with a pointer to the target.
+func MergeUsers(ctx context.Context, req *pb.MergeUsersRequest) (*pb.MergeUsersResponse, error) {
+	sourceUserId := req.SourceUserId
+	targetUserId := req.TargetUserId
+	if sourceUserId == targetUserId {
+		err := gerr.NewInvalidArgument("target_user_id", "can not merge user [%s] into itself", sourceUserId)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	strategy := req.ConflictStrategy
+	if strategy == "" {
+		strategy = constants.MergeConflictKeepTarget
+	}
+
+	var groupIds []string
+	tx := global.Global().Database.Begin()
+	{
+		// lock both users in the order of user id, so that merges
+		// in opposite directions can not deadlock
+		lockUserIds := []string{sourceUserId, targetUserId}
+		sort.Strings(lockUserIds)
+		users := make(map[string]*models.User)
+		for _, userId := range lockUserIds {
+			user, err := getMergeUser(ctx, tx, userId)
+			if err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+			users[userId] = user
+		}
+		source, target := users[sourceUserId], users[targetUserId]
+
+		extra, err := mergeUserExtra(ctx, source, target, strategy)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		groupIds, err = mergeUserGroupBindings(ctx, tx, sourceUserId, targetUserId)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		for _, table := range mergeUserTables {
+			if err := tx.Table(table).
+				Where(constants.ColumnUserId+" = ?", sourceUserId).
+				UpdateColumn(constants.ColumnUserId, targetUserId).Error; err != nil {
+				tx.Rollback()
+				logger.Errorf(ctx, "Move [%s] of user [%s] failed: %+v", table, sourceUserId, err)
+				return nil, err
+			}
+		}
+
+		now := time.Now()
+		if err := tx.Table(constants.TableUser).
+			Where(constants.ColumnUserId+" = ?", sourceUserId).
+			Updates(map[string]interface{}{
+				constants.ColumnStatus:     constants.StatusDeleted,
+				constants.ColumnStatusTime: now,
+				constants.ColumnUpdateTime: now,
+				constants.ColumnMergedInto: targetUserId,
+			}).Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Update user [%s] status failed: %+v", sourceUserId, err)
+			return nil, err
+		}
+
+		if err := tx.Table(constants.TableUser).
+			Where(constants.ColumnUserId+" = ?", targetUserId).
+			Updates(map[string]interface{}{
+				constants.ColumnExtra:      extra,
+				constants.ColumnUpdateTime: now,
+			}).Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Update user [%s] failed: %+v", targetUserId, err)
+			return nil, err
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf(ctx, "Merge user [%s] into [%s] failed: %+v", sourceUserId, targetUserId, err)
+		return nil, err
+	}
+	syncUserRuleGroups(ctx, targetUserId)
+
+	return &pb.MergeUsersResponse{
+		SourceUserId: sourceUserId,
+		TargetUserId: targetUserId,
+		GroupId:      groupIds,
+	}, nil
+}
+
+// getMergeUser locks the user to be merged, only active or disabled users can be merged.
+func getMergeUser(ctx context.Context, tx *gorm.DB, userId string) (*models.User, error) {
+	var user = &models.User{UserId: userId}
+	if err := db.GetChain(tx.Table(constants.TableUser)).
+		ForUpdate().
+		Take(user).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			err = gerr.NewNotFound(constants.TableUser, userId)
+		}
+		logger.Errorf(ctx, "Get user [%s] failed: %+v", userId, err)
+		return nil, err
+	}
+	if user.Status != constants.StatusActive && user.Status != constants.StatusDisabled {
+		err := status.Errorf(codes.FailedPrecondition, "user [%s] is %s", user.UserId, user.Status)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	return user, nil
+}
+
+// mergeUserExtra merges the typed json of both users, the values were checked
+// against the attributes when saved so they are copied as is.
+func mergeUserExtra(ctx context.Context, source, target *models.User, strategy string) (*string, error) {
+	decode := func(user *models.User) (map[string]json.RawMessage, error) {
+		values := make(map[string]json.RawMessage)
+		if user.Extra == nil || *user.Extra == "" {
+			return values, nil
+		}
+		if err := jsonutil.Decode([]byte(*user.Extra), &values); err != nil {
+			logger.Errorf(ctx, "Decode extra of user [%s] failed: %+v", user.UserId, err)
+			return nil, err
+		}
+		if values == nil {
+			values = make(map[string]json.RawMessage)
+		}
+		return values, nil
+	}
+	sourceExtra, err := decode(source)
+	if err != nil {
+		return nil, err
+	}
+	targetExtra, err := decode(target)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range sourceExtra {
+		targetValue, ok := targetExtra[key]
+		if ok && !bytes.Equal(targetValue, value) {
+			switch strategy {
+			case constants.MergeConflictKeepTarget:
+				continue
+			case constants.MergeConflictFail:
+				err := status.Errorf(codes.FailedPrecondition, "extra [%s] of user [%s] conflicts with user [%s]",
+					key, source.UserId, target.UserId)
+				logger.Errorf(ctx, "%+v", err)
+				return nil, err
+			}
+		}
+		targetExtra[key] = value
+	}
+	return stringutil.NewString(jsonutil.ToString(targetExtra)), nil
+}
+
+// mergeUserGroupBindings replaces the bindings of the source user with bindings of the
+// target user, the groups both users are in are kept once with the binding of the target, the
+// moved bindings keep their expire times; returns the groups the target joined.
+// The member limits are not checked, a group the target joins loses the source at once.
+func mergeUserGroupBindings(ctx context.Context, tx *gorm.DB, sourceUserId, targetUserId string) ([]string, error) {
+	// expired bindings are neither moved nor kept
+	if err := removeExpiredBindings(ctx, tx, []string{sourceUserId, targetUserId}, nil); err != nil {
+		return nil, err
+	}
+	var targetGroupIds []string
+	if err := tx.Table(constants.TableUserGroupBinding).
+		Where(constants.ColumnUserId+" = ?", targetUserId).
+		Pluck(constants.ColumnGroupId, &targetGroupIds).Error; err != nil {
+		logger.Errorf(ctx, "Get groups of user [%s] failed: %+v", targetUserId, err)
+		return nil, err
+	}
+	var sourceBindings []*models.UserGroupBinding
+	if err := tx.Table(constants.TableUserGroupBinding).
+		Where(constants.ColumnUserId+" = ?", sourceUserId).
+		Find(&sourceBindings).Error; err != nil {
+		logger.Errorf(ctx, "Get groups of user [%s] failed: %+v", sourceUserId, err)
+		return nil, err
+	}
+
+	var groupIds []string
+	var bindings []*models.UserGroupBinding
+	for _, sourceBinding := range sourceBindings {
+		groupId := sourceBinding.GroupId
+		if stringutil.Contains(targetGroupIds, groupId) || stringutil.Contains(groupIds, groupId) {
+			continue
+		}
+		binding := models.NewUserGroupBinding(targetUserId, groupId)
+		binding.ExpireTime = sourceBinding.ExpireTime
+		groupIds = append(groupIds, groupId)
+		bindings = append(bindings, binding)
+	}
+
+	if err := tx.Delete(models.UserGroupBinding{}, constants.ColumnUserId+" = ?", sourceUserId).Error; err != nil {
+		logger.Errorf(ctx, "Delete user group binding failed: %+v", err)
+		return nil, err
+	}
+	for _, binding := range bindings {
+		if err := tx.Create(binding).Error; err != nil {
+			logger.Errorf(ctx, "Insert user group binding failed: %+v", err)
+			return nil, err
+		}
+	}
+	return groupIds, nil
+}
+
+// GetMergedUser returns the user, or the user it was merged into if any.
+func GetMergedUser(ctx context.Context, userId string) (*models.User, error) {
+	user, err := GetUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; user.MergedInto != ""; i++ {
+		if i >= constants.MaxMergeRedirects {
+			err := status.Errorf(codes.Internal, "too many merges from user [%s]", userId)
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+		user, err = GetUser(ctx, user.MergedInto)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return user, nil
+}
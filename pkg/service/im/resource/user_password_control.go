@@ -21,12 +21,12 @@ import (
 	"crypto/md5"
 	"time"
 
+	"github.com/jinzhu/gorm"
 	"golang.org/x/crypto/bcrypt"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"openpitrix.io/logger"
 
 	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/gerr"
 	"kubesphere.io/im/pkg/global"
 	"kubesphere.io/im/pkg/models"
 	"kubesphere.io/im/pkg/pb"
@@ -36,24 +36,35 @@ func ComparePassword(ctx context.Context, req *pb.ComparePasswordRequest) (*pb.C
 	var user = &models.User{UserId: req.UserId}
 	if err := global.Global().Database.Table(constants.TableUser).
 		Take(user).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			err = gerr.NewNotFound(constants.TableUser, req.UserId)
+		}
 		logger.Errorf(ctx, "Get user [%s] failed: %+v", req.UserId, err)
 		return nil, err
 	}
 
+	if user.Status == constants.StatusDisabled {
+		logger.Errorf(ctx, "Compare password failed, user [%s] is disabled", user.UserId)
+		recordLogin(ctx, user.UserId, false)
+		return &pb.ComparePasswordResponse{Ok: false}, nil
+	}
+
 	err := bcrypt.CompareHashAndPassword(
 		[]byte(user.Password), []byte(req.GetPassword()),
 	)
 	if err != nil {
 		logger.Errorf(ctx, "Compare password failed, md5(password): %x", md5.Sum([]byte(req.Password)))
+		recordLogin(ctx, user.UserId, false)
 		return &pb.ComparePasswordResponse{Ok: false}, nil
 	}
 
+	recordLogin(ctx, user.UserId, true)
 	return &pb.ComparePasswordResponse{Ok: true}, nil
 }
 
 func ModifyPassword(ctx context.Context, req *pb.ModifyPasswordRequest) (*pb.ModifyPasswordResponse, error) {
 	if req.Password == "" {
-		err := status.Errorf(codes.InvalidArgument, "empty password")
+		err := gerr.NewInvalidArgument("password", "empty password")
 		logger.Errorf(ctx, "%+v", err)
 		return nil, err
 	}
@@ -0,0 +1,274 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/jinzhu/gorm"
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/db"
+	"kubesphere.io/im/pkg/gerr"
+	"kubesphere.io/im/pkg/global"
+	"kubesphere.io/im/pkg/models"
+	"kubesphere.io/im/pkg/pb"
+)
+
+var userDataMarshaler = &jsonpb.Marshaler{
+	OrigName: true,
+	Indent:   "  ",
+}
+
+// ExportUserData returns everything held about the user as a json document of pb.UserData.
+func ExportUserData(ctx context.Context, req *pb.ExportUserDataRequest) (*pb.ExportUserDataResponse, error) {
+	userId := req.UserId
+	user, err := GetUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &pb.UserData{
+		User: user.ToPB(),
+	}
+	data.ExportTime, _ = ptypes.TimestampProto(time.Now())
+
+	data.GroupMembershipSet, err = getGroupMemberships(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var histories []*models.LoginHistory
+	if err := global.Global().Database.Table(constants.TableLoginHistory).
+		Where(constants.ColumnUserId+" = ?", userId).
+		Order(constants.ColumnCreateTime).
+		Find(&histories).Error; err != nil {
+		logger.Errorf(ctx, "Get login history of user [%s] failed: %+v", userId, err)
+		return nil, err
+	}
+	for _, history := range histories {
+		data.LoginHistorySet = append(data.LoginHistorySet, history.ToPB())
+	}
+
+	var verifications []*models.UserVerification
+	if err := global.Global().Database.Table(constants.TableUserVerification).
+		Where(constants.ColumnUserId+" = ?", userId).
+		Order(constants.ColumnCreateTime).
+		Find(&verifications).Error; err != nil {
+		logger.Errorf(ctx, "Get verifications of user [%s] failed: %+v", userId, err)
+		return nil, err
+	}
+	for _, verification := range verifications {
+		data.TokenSet = append(data.TokenSet, verification.ToPB())
+	}
+
+	var invites []*models.UserInvite
+	if err := global.Global().Database.Table(constants.TableUserInvite).
+		Where(constants.ColumnUserId+" = ?", userId).
+		Order(constants.ColumnCreateTime).
+		Find(&invites).Error; err != nil {
+		logger.Errorf(ctx, "Get invites of user [%s] failed: %+v", userId, err)
+		return nil, err
+	}
+	for _, invite := range invites {
+		data.InviteSet = append(data.InviteSet, invite.ToPB())
+	}
+
+	if user.Email != "" {
+		var notifications []*models.Notification
+		if err := global.Global().Database.Table(constants.TableNotification).
+			Where(constants.ColumnRecipient+" = ?", user.Email).
+			Order(constants.ColumnCreateTime).
+			Find(&notifications).Error; err != nil {
+			logger.Errorf(ctx, "Get notifications of user [%s] failed: %+v", userId, err)
+			return nil, err
+		}
+		for _, notification := range notifications {
+			data.NotificationSet = append(data.NotificationSet, notification.ToPB())
+		}
+	}
+
+	s, err := userDataMarshaler.MarshalToString(data)
+	if err != nil {
+		logger.Errorf(ctx, "Marshal data of user [%s] failed: %+v", userId, err)
+		return nil, err
+	}
+
+	return &pb.ExportUserDataResponse{
+		UserId: userId,
+		Data:   s,
+	}, nil
+}
+
+func getGroupMemberships(ctx context.Context, userId string) ([]*pb.GroupMembership, error) {
+	var bindings []*models.UserGroupBinding
+	if err := activeBindings(global.Global().Database.Table(constants.TableUserGroupBinding)).
+		Where(constants.ColumnUserId+" = ?", userId).
+		Order(constants.ColumnCreateTime).
+		Find(&bindings).Error; err != nil {
+		logger.Errorf(ctx, "Get user group binding of user [%s] failed: %+v", userId, err)
+		return nil, err
+	}
+	if len(bindings) == 0 {
+		return nil, nil
+	}
+
+	var groupIds []string
+	for _, binding := range bindings {
+		groupIds = append(groupIds, binding.GroupId)
+	}
+	var groups []*models.Group
+	if err := global.Global().Database.Table(constants.TableGroup).
+		Where(constants.ColumnGroupId+" in (?)", groupIds).
+		Find(&groups).Error; err != nil {
+		logger.Errorf(ctx, "Get groups of user [%s] failed: %+v", userId, err)
+		return nil, err
+	}
+	groupMap := make(map[string]*models.Group)
+	for _, group := range groups {
+		groupMap[group.GroupId] = group
+	}
+
+	var memberships []*pb.GroupMembership
+	for _, binding := range bindings {
+		membership := &pb.GroupMembership{GroupId: binding.GroupId}
+		if group, ok := groupMap[binding.GroupId]; ok {
+			membership.GroupName = group.GroupName
+			membership.GroupPath = group.GroupPath
+		}
+		membership.JoinTime, _ = ptypes.TimestampProto(binding.CreateTime)
+		memberships = append(memberships, membership)
+	}
+	return memberships, nil
+}
+
+// scrub clears the attributes of the rows whose column equals value
+type scrub struct {
+	table      string
+	column     string
+	value      interface{}
+	attributes map[string]interface{}
+}
+
+// AnonymizeUser irreversibly scrubs the personal data of the user and the records about it,
+// the user id, group memberships and timestamps are kept so that the history stays intact.
+func AnonymizeUser(ctx context.Context, req *pb.AnonymizeUserRequest) (*pb.AnonymizeUserResponse, error) {
+	userId := req.UserId
+	now := time.Now()
+
+	var email string
+	tx := global.Global().Database.Begin()
+	{
+		var user = &models.User{UserId: userId}
+		if err := db.GetChain(tx.Table(constants.TableUser)).
+			ForUpdate().
+			Take(user).Error; err != nil {
+			tx.Rollback()
+			if gorm.IsRecordNotFoundError(err) {
+				err = gerr.NewNotFound(constants.TableUser, userId)
+			}
+			logger.Errorf(ctx, "Get user [%s] failed: %+v", userId, err)
+			return nil, err
+		}
+		email = user.Email
+
+		if err := tx.Table(constants.TableUser).
+			Where(constants.ColumnUserId+" = ?", userId).
+			Updates(map[string]interface{}{
+				// the user id is unique and carries no personal data
+				constants.ColumnUsername:           userId,
+				constants.ColumnEmail:              "",
+				constants.ColumnPhoneNumber:        "",
+				constants.ColumnDescription:        "",
+				constants.ColumnPassword:           "",
+				constants.ColumnExtra:              nil,
+				constants.ColumnDisplayName:        "",
+				constants.ColumnGivenName:          "",
+				constants.ColumnFamilyName:         "",
+				constants.ColumnLocale:             "",
+				constants.ColumnTimezone:           "",
+				constants.ColumnEmailVerified:      false,
+				constants.ColumnEmailVerifyTime:    nil,
+				constants.ColumnPhoneVerified:      false,
+				constants.ColumnPhoneVerifyTime:    nil,
+				constants.ColumnAvatarUpdateTime:   nil,
+				constants.ColumnInactivityWarnTime: nil,
+				constants.ColumnAnonymizeTime:      now,
+				constants.ColumnUpdateTime:         now,
+			}).Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Anonymize user [%s] failed: %+v", userId, err)
+			return nil, err
+		}
+
+		scrubs := []scrub{
+			{constants.TableLoginHistory, constants.ColumnUserId, userId, map[string]interface{}{
+				constants.ColumnClientIp: "", constants.ColumnUserAgent: "",
+			}},
+			{constants.TableUserVerification, constants.ColumnUserId, userId, map[string]interface{}{
+				constants.ColumnTarget: "",
+			}},
+			{constants.TableUserInvite, constants.ColumnUserId, userId, map[string]interface{}{
+				constants.ColumnEmail: "",
+			}},
+		}
+		if email != "" {
+			// pending mails are never sent once the recipient is removed
+			if err := tx.Table(constants.TableNotification).
+				Where(constants.ColumnRecipient+" = ?", email).
+				Where(constants.ColumnStatus+" = ?", constants.NotificationStatusPending).
+				UpdateColumns(map[string]interface{}{
+					constants.ColumnStatus:     constants.NotificationStatusFailed,
+					constants.ColumnStatusTime: now,
+				}).Error; err != nil {
+				tx.Rollback()
+				logger.Errorf(ctx, "Cancel notifications of user [%s] failed: %+v", userId, err)
+				return nil, err
+			}
+			scrubs = append(scrubs, scrub{constants.TableNotification, constants.ColumnRecipient, email, map[string]interface{}{
+				constants.ColumnRecipient: "", constants.ColumnSubject: "", constants.ColumnBody: "",
+			}})
+		}
+		for _, s := range scrubs {
+			if err := tx.Table(s.table).
+				Where(s.column+" = ?", s.value).
+				UpdateColumns(s.attributes).Error; err != nil {
+				tx.Rollback()
+				logger.Errorf(ctx, "Anonymize [%s] of user [%s] failed: %+v", s.table, userId, err)
+				return nil, err
+			}
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf(ctx, "Anonymize user [%s] failed: %+v", userId, err)
+		return nil, err
+	}
+	syncUserRuleGroups(ctx, userId)
+
+	if err := global.Global().BlobStore.Delete(ctx, constants.BlobPrefixAvatar+userId); err != nil {
+		logger.Errorf(ctx, "Delete avatar of user [%s] failed: %+v", userId, err)
+		return nil, err
+	}
+
+	return &pb.AnonymizeUserResponse{
+		UserId: userId,
+	}, nil
+}
@@ -0,0 +1,372 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/gerr"
+	"kubesphere.io/im/pkg/global"
+	"kubesphere.io/im/pkg/models"
+	"kubesphere.io/im/pkg/pb"
+)
+
+func SendEmailVerification(ctx context.Context, req *pb.SendEmailVerificationRequest) (*pb.SendEmailVerificationResponse, error) {
+	user, err := GetUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user.Status == constants.StatusDeleted {
+		err := status.Errorf(codes.FailedPrecondition, "user [%s] has been deleted", user.UserId)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	if user.Email == "" {
+		err := status.Errorf(codes.FailedPrecondition, "user [%s] has no email", user.UserId)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	if user.EmailVerified {
+		err := status.Errorf(codes.FailedPrecondition, "email of user [%s] already verified", user.UserId)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	verification, token := models.NewUserVerification(
+		user.UserId, constants.VerificationKindEmail, user.Email,
+		global.Global().Config.EmailVerificationExpire,
+	)
+	// the token is only sent by mail, the mail is enqueued with the
+	// verification so that neither is saved without the other
+	tx := global.Global().Database.Begin()
+	{
+		if err := tx.Create(verification).Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Insert user verification failed: %+v", err)
+			return nil, err
+		}
+
+		if _, err := global.Global().Notifier.SendMailTx(ctx, tx, user.Email, constants.TemplateEmailVerification, req.Locale, map[string]interface{}{
+			"Username":   user.Username,
+			"Email":      user.Email,
+			"Token":      token,
+			"ExpireTime": verification.ExpireTime,
+		}); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf(ctx, "Insert user verification failed: %+v", err)
+		return nil, err
+	}
+
+	expireTime, _ := ptypes.TimestampProto(verification.ExpireTime)
+	return &pb.SendEmailVerificationResponse{
+		UserId:     user.UserId,
+		Email:      user.Email,
+		ExpireTime: expireTime,
+	}, nil
+}
+
+func ConfirmEmailVerification(ctx context.Context, req *pb.ConfirmEmailVerificationRequest) (*pb.ConfirmEmailVerificationResponse, error) {
+	verification, err := getUserVerificationByToken(ctx, constants.VerificationKindEmail, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := GetUser(ctx, verification.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user.Email != verification.Target {
+		err := status.Errorf(codes.FailedPrecondition, "email of user [%s] has changed", user.UserId)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	tx := global.Global().Database.Begin()
+	{
+		if err := markUserVerificationConsumed(ctx, tx, verification); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		now := time.Now()
+		attributes := map[string]interface{}{
+			constants.ColumnEmailVerified:   true,
+			constants.ColumnEmailVerifyTime: now,
+			constants.ColumnUpdateTime:      now,
+		}
+		result := tx.Table(constants.TableUser).
+			Where(constants.ColumnUserId+" = ?", user.UserId).
+			Where(constants.ColumnEmail+" = ?", verification.Target).
+			Updates(attributes)
+		if err := result.Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Update user [%s] email verified failed: %+v", user.UserId, err)
+			return nil, err
+		}
+		if result.RowsAffected == 0 {
+			tx.Rollback()
+			err := status.Errorf(codes.FailedPrecondition, "email of user [%s] has changed", user.UserId)
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf(ctx, "Confirm email verification failed: %+v", err)
+		return nil, err
+	}
+
+	return &pb.ConfirmEmailVerificationResponse{
+		UserId: user.UserId,
+		Email:  user.Email,
+	}, nil
+}
+
+// getUserVerificationByToken returns the verification of an unused and unexpired token,
+// the caller marks it consumed with markUserVerificationConsumed.
+func getUserVerificationByToken(ctx context.Context, kind, token string) (*models.UserVerification, error) {
+	if token == "" {
+		err := gerr.NewInvalidArgument("token", "empty verification token")
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	var verification = &models.UserVerification{}
+	if err := global.Global().Database.Table(constants.TableUserVerification).
+		Where(constants.ColumnTokenHash+" = ?", models.GetTokenHash(token)).
+		Where(constants.ColumnKind+" = ?", kind).
+		Take(verification).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			err = gerr.NewInvalidArgument("token", "invalid verification token")
+		}
+		logger.Errorf(ctx, "Get user verification failed: %+v", err)
+		return nil, err
+	}
+
+	if verification.ConsumeTime != nil {
+		err := status.Errorf(codes.FailedPrecondition, "verification token already used")
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	if time.Now().After(verification.ExpireTime) {
+		err := status.Errorf(codes.FailedPrecondition, "verification token expired")
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	return verification, nil
+}
+
+// markUserVerificationConsumed guards against concurrent consumers of the same token.
+func markUserVerificationConsumed(ctx context.Context, tx *gorm.DB, verification *models.UserVerification) error {
+	now := time.Now()
+	result := tx.Table(constants.TableUserVerification).
+		Where(constants.ColumnId+" = ?", verification.Id).
+		Where(constants.ColumnConsumeTime + " IS NULL").
+		Updates(map[string]interface{}{constants.ColumnConsumeTime: now})
+	if err := result.Error; err != nil {
+		logger.Errorf(ctx, "Consume user verification [%s] failed: %+v", verification.Id, err)
+		return err
+	}
+	if result.RowsAffected == 0 {
+		err := status.Errorf(codes.FailedPrecondition, "verification token already used")
+		logger.Errorf(ctx, "%+v", err)
+		return err
+	}
+	verification.ConsumeTime = &now
+	return nil
+}
+
+func SendPhoneCode(ctx context.Context, req *pb.SendPhoneCodeRequest) (*pb.SendPhoneCodeResponse, error) {
+	cfg := global.Global().Config
+	user, err := GetUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user.Status == constants.StatusDeleted {
+		err := status.Errorf(codes.FailedPrecondition, "user [%s] has been deleted", user.UserId)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	if user.PhoneNumber == "" {
+		err := status.Errorf(codes.FailedPrecondition, "user [%s] has no phone number", user.UserId)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	if user.PhoneVerified {
+		err := status.Errorf(codes.FailedPrecondition, "phone number of user [%s] already verified", user.UserId)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	// rate limit by the latest code sent to this user
+	last, err := getLatestUserVerification(ctx, user.UserId, constants.VerificationKindPhone)
+	if err != nil {
+		return nil, err
+	}
+	if last != nil {
+		if wait := last.CreateTime.Add(cfg.PhoneCodeInterval).Sub(time.Now()); wait > 0 {
+			err := status.Errorf(codes.ResourceExhausted, "phone code sent too frequently, retry after %s", wait.Round(time.Second))
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+	}
+
+	verification, code := models.NewUserPhoneVerification(
+		user.UserId, user.PhoneNumber, cfg.PhoneCodeLength, cfg.PhoneCodeExpire,
+	)
+	if err := global.Global().Database.Create(verification).Error; err != nil {
+		logger.Errorf(ctx, "Insert user verification failed: %+v", err)
+		return nil, err
+	}
+
+	message := fmt.Sprintf("Your verification code is %s, valid for %s.", code, cfg.PhoneCodeExpire)
+	if err := global.Global().SmsSender.Send(ctx, user.PhoneNumber, message); err != nil {
+		// do not count a failed delivery against the rate limit
+		global.Global().Database.Delete(verification)
+		err = status.Errorf(codes.Unavailable, "send phone code failed: %v", err)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	expireTime, _ := ptypes.TimestampProto(verification.ExpireTime)
+	return &pb.SendPhoneCodeResponse{
+		UserId:      user.UserId,
+		PhoneNumber: user.PhoneNumber,
+		ExpireTime:  expireTime,
+	}, nil
+}
+
+func VerifyPhoneCode(ctx context.Context, req *pb.VerifyPhoneCodeRequest) (*pb.VerifyPhoneCodeResponse, error) {
+	if req.Code == "" {
+		err := gerr.NewInvalidArgument("code", "empty phone code")
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	user, err := GetUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	verification, err := getLatestUserVerification(ctx, user.UserId, constants.VerificationKindPhone)
+	if err != nil {
+		return nil, err
+	}
+	if verification == nil || verification.ConsumeTime != nil || verification.Target != user.PhoneNumber {
+		err := status.Errorf(codes.FailedPrecondition, "no pending phone code for user [%s]", user.UserId)
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	if time.Now().After(verification.ExpireTime) {
+		err := status.Errorf(codes.FailedPrecondition, "phone code expired")
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+	// the attempt is counted before the code is compared, so that concurrent
+	// guesses can not exceed the limit
+	result := global.Global().Database.Table(constants.TableUserVerification).
+		Where(constants.ColumnId+" = ?", verification.Id).
+		Where(constants.ColumnAttempts+" < ?", global.Global().Config.PhoneCodeMaxAttempts).
+		UpdateColumn(constants.ColumnAttempts, gorm.Expr(constants.ColumnAttempts+" + 1"))
+	if err := result.Error; err != nil {
+		logger.Errorf(ctx, "Update user verification [%s] attempts failed: %+v", verification.Id, err)
+		return nil, err
+	}
+	if result.RowsAffected == 0 {
+		err := status.Errorf(codes.ResourceExhausted, "too many failed attempts, please request a new phone code")
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	codeHash := verification.GetCodeHash(req.Code)
+	if subtle.ConstantTimeCompare([]byte(codeHash), []byte(verification.TokenHash)) != 1 {
+		err := gerr.NewInvalidArgument("code", "invalid phone code")
+		logger.Errorf(ctx, "%+v", err)
+		return nil, err
+	}
+
+	tx := global.Global().Database.Begin()
+	{
+		if err := markUserVerificationConsumed(ctx, tx, verification); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		now := time.Now()
+		attributes := map[string]interface{}{
+			constants.ColumnPhoneVerified:   true,
+			constants.ColumnPhoneVerifyTime: now,
+			constants.ColumnUpdateTime:      now,
+		}
+		result := tx.Table(constants.TableUser).
+			Where(constants.ColumnUserId+" = ?", user.UserId).
+			Where(constants.ColumnPhoneNumber+" = ?", verification.Target).
+			Updates(attributes)
+		if err := result.Error; err != nil {
+			tx.Rollback()
+			logger.Errorf(ctx, "Update user [%s] phone verified failed: %+v", user.UserId, err)
+			return nil, err
+		}
+		if result.RowsAffected == 0 {
+			tx.Rollback()
+			err := status.Errorf(codes.FailedPrecondition, "phone number of user [%s] has changed", user.UserId)
+			logger.Errorf(ctx, "%+v", err)
+			return nil, err
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf(ctx, "Verify phone code failed: %+v", err)
+		return nil, err
+	}
+
+	return &pb.VerifyPhoneCodeResponse{
+		UserId:      user.UserId,
+		PhoneNumber: user.PhoneNumber,
+	}, nil
+}
+
+// getLatestUserVerification returns nil if nothing has been sent to the user.
+func getLatestUserVerification(ctx context.Context, userId, kind string) (*models.UserVerification, error) {
+	var verifications []*models.UserVerification
+	if err := global.Global().Database.Table(constants.TableUserVerification).
+		Where(constants.ColumnUserId+" = ?", userId).
+		Where(constants.ColumnKind+" = ?", kind).
+		Order(constants.ColumnCreateTime + " DESC").
+		Limit(1).
+		Find(&verifications).Error; err != nil {
+		logger.Errorf(ctx, "Get latest user [%s] verification failed: %+v", userId, err)
+		return nil, err
+	}
+	if len(verifications) == 0 {
+		return nil, nil
+	}
+	return verifications[0], nil
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package im
 
 import (
+	"context"
 	"os"
 
 	"github.com/google/gops/agent"
@@ -28,6 +29,7 @@ import (
 	"kubesphere.io/im/pkg/global"
 	"kubesphere.io/im/pkg/manager"
 	"kubesphere.io/im/pkg/pb"
+	"kubesphere.io/im/pkg/service/im/resource"
 )
 
 type Server struct {
@@ -35,6 +37,11 @@ type Server struct {
 
 func Serve(cfg *config.Config) {
 	global.SetGlobal(cfg)
+	go global.Global().Notifier.Serve(context.Background())
+	go resource.ServeLoginHistoryCleaner(context.Background())
+	go resource.ServeInactivityChecker(context.Background())
+	go resource.ServeRuleGroupReconciler(context.Background())
+	go resource.ServeMembershipSweeper(context.Background())
 	s := new(Server)
 	if err := agent.Listen(agent.Options{
 		ShutdownCleanup: true,
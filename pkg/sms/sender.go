@@ -0,0 +1,82 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sms
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"openpitrix.io/logger"
+
+	"kubesphere.io/im/pkg/config"
+)
+
+const (
+	SenderLog  = "log"
+	SenderFile = "file"
+)
+
+// SmsSender delivers a text message to a phone number in E.164 format.
+type SmsSender interface {
+	Send(ctx context.Context, phoneNumber, message string) error
+}
+
+func NewSmsSender(cfg config.SmsConfig) (SmsSender, error) {
+	switch cfg.Sender {
+	case SenderLog, "":
+		return new(LogSender), nil
+	case SenderFile:
+		return NewFileSender(cfg.File), nil
+	}
+	return nil, fmt.Errorf("unsupported sms sender [%s]", cfg.Sender)
+}
+
+// LogSender writes messages to the service log, for development only.
+type LogSender struct{}
+
+func (s *LogSender) Send(ctx context.Context, phoneNumber, message string) error {
+	logger.Infof(ctx, "Send sms to [%s]: %s", phoneNumber, message)
+	return nil
+}
+
+// FileSender appends messages to a local file, one message per line,
+// which makes delivered codes easy to read in tests.
+type FileSender struct {
+	path  string
+	mutex sync.Mutex
+}
+
+func NewFileSender(path string) *FileSender {
+	return &FileSender{path: path}
+}
+
+func (s *FileSender) Send(ctx context.Context, phoneNumber, message string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = fmt.Fprintf(f, "%s\t%s\t%s\n", time.Now().Format(time.RFC3339), phoneNumber, message)
+	return err
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sms
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"kubesphere.io/im/pkg/config"
+)
+
+func TestFileSender(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sms")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "sms.log")
+	sender, err := NewSmsSender(config.SmsConfig{Sender: SenderFile, File: path})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, sender.Send(ctx, "+8613800000000", "code 123456"))
+	require.NoError(t, sender.Send(ctx, "+8613800000001", "code 654321"))
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+	require.True(t, strings.HasSuffix(lines[0], "+8613800000000\tcode 123456"))
+	require.True(t, strings.HasSuffix(lines[1], "+8613800000001\tcode 654321"))
+}
+
+func TestUnsupportedSender(t *testing.T) {
+	_, err := NewSmsSender(config.SmsConfig{Sender: "unknown"})
+	require.Error(t, err)
+}
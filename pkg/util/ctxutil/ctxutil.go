@@ -0,0 +1,95 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctxutil
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	ForwardedForKey = "x-forwarded-for"
+	UserAgentKey    = "user-agent"
+)
+
+func getMetadata(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// GetClientIp returns the host of the grpc peer address. If the peer is one of the
+// trusted proxies, which are ip addresses or cidr blocks, the client is looked up in
+// x-forwarded-for from the right, the nearest address not of a trusted proxy is the
+// client; the left ones may be forged by the client.
+func GetClientIp(ctx context.Context, trustedProxies []string) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	ip := p.Addr.String()
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	if !isTrustedProxy(ip, trustedProxies) {
+		return ip
+	}
+
+	forwarded := getMetadata(ctx, ForwardedForKey)
+	if forwarded == "" {
+		return ip
+	}
+	addresses := strings.Split(forwarded, ",")
+	for i := len(addresses) - 1; i >= 0; i-- {
+		ip = strings.TrimSpace(addresses[i])
+		if !isTrustedProxy(ip, trustedProxies) {
+			return ip
+		}
+	}
+	return ip
+}
+
+func isTrustedProxy(ip string, trustedProxies []string) bool {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+	for _, proxy := range trustedProxies {
+		if _, ipNet, err := net.ParseCIDR(proxy); err == nil {
+			if ipNet.Contains(addr) {
+				return true
+			}
+		} else if proxyAddr := net.ParseIP(proxy); proxyAddr != nil && proxyAddr.Equal(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func GetUserAgent(ctx context.Context) string {
+	return getMetadata(ctx, UserAgentKey)
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctxutil
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestGetClientIp(t *testing.T) {
+	trustedProxies := []string{"10.0.0.0/24", "172.16.0.1"}
+
+	ctx := context.Background()
+	assert.Equal(t, "", GetClientIp(ctx, trustedProxies))
+
+	// x-forwarded-for of an untrusted peer is ignored
+	ctx = peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.168.0.1"), Port: 51234}})
+	assert.Equal(t, "192.168.0.1", GetClientIp(ctx, trustedProxies))
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(ForwardedForKey, "1.2.3.4"))
+	assert.Equal(t, "192.168.0.1", GetClientIp(ctx, trustedProxies))
+	assert.Equal(t, "192.168.0.1", GetClientIp(ctx, nil))
+
+	ctx = peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 51234}})
+	assert.Equal(t, "10.0.0.1", GetClientIp(ctx, trustedProxies))
+
+	// the nearest untrusted address is the client, the left ones may be forged
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(ForwardedForKey, "1.2.3.4, 192.168.1.1, 172.16.0.1"))
+	assert.Equal(t, "192.168.1.1", GetClientIp(ctx, trustedProxies))
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(ForwardedForKey, "10.0.0.2, 172.16.0.1"))
+	assert.Equal(t, "10.0.0.2", GetClientIp(ctx, trustedProxies))
+}
+
+func TestGetUserAgent(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, "", GetUserAgent(ctx))
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(UserAgentKey, "Mozilla/5.0"))
+	assert.Equal(t, "Mozilla/5.0", GetUserAgent(ctx))
+}
@@ -72,6 +72,7 @@ func GetUuid(prefix string) string {
 const (
 	Alphabet62 = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ1234567890"
 	Alphabet36 = "abcdefghijklmnopqrstuvwxyz1234567890"
+	Alphabet10 = "1234567890"
 )
 
 // format likes: 300m50zn91nwz5
@@ -135,6 +136,11 @@ func GetAttachmentPrefix() string {
 	return randString(Alphabet62, 30)
 }
 
+// format likes: 204817
+func GetNumericCode(n int) string {
+	return randString(Alphabet10, n)
+}
+
 func lower16BitIP() (uint16, error) {
 	ip, err := IPv4()
 	if err != nil {
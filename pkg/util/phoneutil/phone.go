@@ -0,0 +1,53 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package phoneutil
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var reE164 = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+
+var phoneSeparator = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "", ".", "", "\t", "")
+
+// NormalizeE164 converts phone number to E.164 format, such as "+8613800000000".
+// Numbers without international prefix are treated as national numbers of defaultCountryCode.
+// Empty phone number is kept as is.
+func NormalizeE164(phoneNumber, defaultCountryCode string) (string, error) {
+	s := phoneSeparator.Replace(strings.TrimSpace(phoneNumber))
+	if s == "" {
+		return "", nil
+	}
+
+	switch {
+	case strings.HasPrefix(s, "+"):
+	case strings.HasPrefix(s, "00"):
+		s = "+" + s[2:]
+	default:
+		if defaultCountryCode == "" {
+			return "", fmt.Errorf("phone number [%s] has no country code", phoneNumber)
+		}
+		s = "+" + strings.TrimPrefix(defaultCountryCode, "+") + strings.TrimLeft(s, "0")
+	}
+
+	if !reE164.MatchString(s) {
+		return "", fmt.Errorf("invalid phone number [%s]", phoneNumber)
+	}
+	return s, nil
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package phoneutil
+
+import (
+	"testing"
+
+	. "kubesphere.io/im/pkg/util/assert"
+)
+
+func TestNormalizeE164(t *testing.T) {
+	var tests = []struct{ s, code, expect string }{
+		{s: "", code: "86", expect: ""},
+		{s: "13800000000", code: "86", expect: "+8613800000000"},
+		{s: "138 0000 0000", code: "+86", expect: "+8613800000000"},
+		{s: "+1 (415) 555-2671", code: "86", expect: "+14155552671"},
+		{s: "0044 20 7946 0958", code: "86", expect: "+442079460958"},
+		{s: "020 7946 0958", code: "44", expect: "+442079460958"},
+	}
+	for _, v := range tests {
+		got, err := NormalizeE164(v.s, v.code)
+		Assertf(t, err == nil, "normalize %q failed: %v", v.s, err)
+		Assertf(t, got == v.expect, "expect = %q, got = %q", v.expect, got)
+	}
+
+	for _, s := range []string{"abc", "+0123456789", "+12", "12345678901234567890"} {
+		_, err := NormalizeE164(s, "86")
+		Assertf(t, err != nil, "expect %q to be invalid", s)
+	}
+
+	_, err := NormalizeE164("13800000000", "")
+	Assert(t, err != nil)
+}
@@ -0,0 +1,387 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ruleutil parses and evaluates the membership rules of dynamic groups, such as
+//
+//	email ends_with "@example.com" and (extra.department == "eng" or extra.level >= 3)
+//
+// A condition compares a field with a string or number literal, or a list of them for in.
+// The comparison is numeric if the literal is an unquoted number and the field is a number,
+// a quoted literal is always compared as a string; a missing field is an empty string.
+package ruleutil
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	OpEq         = "=="
+	OpNe         = "!="
+	OpGt         = ">"
+	OpGte        = ">="
+	OpLt         = "<"
+	OpLte        = "<="
+	OpContains   = "contains"
+	OpStartsWith = "starts_with"
+	OpEndsWith   = "ends_with"
+	OpIn         = "in"
+)
+
+type Rule struct {
+	expr   node
+	fields []string
+}
+
+// Parse parses the rule, an empty rule is an error.
+func Parse(s string) (*Rule, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected [%s] at offset %d", p.peek().text, p.peek().pos)
+	}
+	return &Rule{expr: expr, fields: p.fields}, nil
+}
+
+// Match evaluates the rule with the values of the fields.
+func (r *Rule) Match(fields map[string]string) bool {
+	return r.expr.match(fields)
+}
+
+// Fields returns the fields referred to by the rule.
+func (r *Rule) Fields() []string {
+	return r.fields
+}
+
+type node interface {
+	match(fields map[string]string) bool
+}
+
+type andNode struct{ left, right node }
+
+func (n *andNode) match(fields map[string]string) bool {
+	return n.left.match(fields) && n.right.match(fields)
+}
+
+type orNode struct{ left, right node }
+
+func (n *orNode) match(fields map[string]string) bool {
+	return n.left.match(fields) || n.right.match(fields)
+}
+
+type notNode struct{ expr node }
+
+func (n *notNode) match(fields map[string]string) bool {
+	return !n.expr.match(fields)
+}
+
+type conditionNode struct {
+	field  string
+	op     string
+	values []literal
+}
+
+// literal is a value of a condition, number is set if it was an unquoted number.
+type literal struct {
+	text   string
+	number bool
+}
+
+func (n *conditionNode) match(fields map[string]string) bool {
+	v := fields[n.field]
+	switch n.op {
+	case OpIn:
+		for _, value := range n.values {
+			if compare(v, value) == 0 {
+				return true
+			}
+		}
+		return false
+	case OpContains:
+		return strings.Contains(v, n.values[0].text)
+	case OpStartsWith:
+		return strings.HasPrefix(v, n.values[0].text)
+	case OpEndsWith:
+		return strings.HasSuffix(v, n.values[0].text)
+	}
+
+	c := compare(v, n.values[0])
+	switch n.op {
+	case OpEq:
+		return c == 0
+	case OpNe:
+		return c != 0
+	case OpGt:
+		return c > 0
+	case OpGte:
+		return c >= 0
+	case OpLt:
+		return c < 0
+	case OpLte:
+		return c <= 0
+	}
+	return false
+}
+
+func compare(v string, value literal) int {
+	if !value.number {
+		return strings.Compare(v, value.text)
+	}
+	x, errX := strconv.ParseFloat(v, 64)
+	y, errY := strconv.ParseFloat(value.text, 64)
+	if errX != nil || errY != nil || math.IsNaN(x) || math.IsInf(x, 0) {
+		return strings.Compare(v, value.text)
+	}
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+const (
+	tokenIdent = iota
+	tokenString
+	tokenNumber
+	tokenSymbol
+)
+
+type token struct {
+	kind int
+	text string // the unquoted value of a string
+	pos  int
+}
+
+var symbols = []string{OpEq, OpNe, OpGte, OpLte, OpGt, OpLt, "(", ")", "[", "]", ","}
+
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := rune(s[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(s) && s[j] != '"'; j++ {
+				if s[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("unterminated string at offset %d", i)
+			}
+			text, err := strconv.Unquote(s[i : j+1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid string at offset %d: %v", i, err)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: text, pos: i})
+			i = j + 1
+		case c == '-' || c == '.' || unicode.IsDigit(c):
+			j := i + 1
+			for j < len(s) && (s[j] == '.' || unicode.IsDigit(rune(s[j]))) {
+				j++
+			}
+			if _, err := strconv.ParseFloat(s[i:j], 64); err != nil {
+				return nil, fmt.Errorf("invalid number [%s] at offset %d", s[i:j], i)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: s[i:j], pos: i})
+			i = j
+		case c == '_' || unicode.IsLetter(c):
+			j := i + 1
+			for j < len(s) && (s[j] == '_' || s[j] == '.' || unicode.IsLetter(rune(s[j])) || unicode.IsDigit(rune(s[j]))) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: s[i:j], pos: i})
+			i = j
+		default:
+			matched := false
+			for _, symbol := range symbols {
+				if strings.HasPrefix(s[i:], symbol) {
+					tokens = append(tokens, token{kind: tokenSymbol, text: symbol, pos: i})
+					i += len(symbol)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected [%c] at offset %d", c, i)
+			}
+		}
+	}
+	return tokens, nil
+}
+
+type parser struct {
+	tokens []token
+	i      int
+	fields []string
+}
+
+func (p *parser) done() bool {
+	return p.i >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	if p.done() {
+		return token{kind: -1, text: "end of rule", pos: -1}
+	}
+	return p.tokens[p.i]
+}
+
+func (p *parser) next() token {
+	t := p.peek()
+	p.i++
+	return t
+}
+
+func (p *parser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t.kind == tokenIdent && t.text == keyword
+}
+
+func (p *parser) isSymbol(symbol string) bool {
+	t := p.peek()
+	return t.kind == tokenSymbol && t.text == symbol
+}
+
+func (p *parser) expectSymbol(symbol string) error {
+	if !p.isSymbol(symbol) {
+		t := p.peek()
+		return fmt.Errorf("expect [%s] but got [%s] at offset %d", symbol, t.text, t.pos)
+	}
+	p.i++
+	return nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("or") {
+		p.i++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("and") {
+		p.i++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.isKeyword("not") {
+		p.i++
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{expr: expr}, nil
+	}
+	if p.isSymbol("(") {
+		p.i++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectSymbol(")"); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	}
+	return p.parseCondition()
+}
+
+func (p *parser) parseCondition() (node, error) {
+	field := p.next()
+	if field.kind != tokenIdent {
+		return nil, fmt.Errorf("expect field but got [%s] at offset %d", field.text, field.pos)
+	}
+	op := p.next()
+	switch {
+	case op.kind == tokenSymbol && op.text != "(" && op.text != ")" && op.text != "[" && op.text != "]" && op.text != ",":
+	case op.kind == tokenIdent && (op.text == OpContains || op.text == OpStartsWith || op.text == OpEndsWith || op.text == OpIn):
+	default:
+		return nil, fmt.Errorf("expect operator but got [%s] at offset %d", op.text, op.pos)
+	}
+
+	var values []literal
+	if op.text == OpIn {
+		if err := p.expectSymbol("["); err != nil {
+			return nil, err
+		}
+		for {
+			value, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+			if !p.isSymbol(",") {
+				break
+			}
+			p.i++
+		}
+		if err := p.expectSymbol("]"); err != nil {
+			return nil, err
+		}
+	} else {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	p.fields = append(p.fields, field.text)
+	return &conditionNode{field: field.text, op: op.text, values: values}, nil
+}
+
+func (p *parser) parseValue() (literal, error) {
+	t := p.next()
+	if t.kind != tokenString && t.kind != tokenNumber {
+		return literal{}, fmt.Errorf("expect string or number but got [%s] at offset %d", t.text, t.pos)
+	}
+	return literal{text: t.text, number: t.kind == tokenNumber}, nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2019 The KubeSphere Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ruleutil
+
+import (
+	"testing"
+
+	. "kubesphere.io/im/pkg/util/assert"
+)
+
+func TestRuleMatch(t *testing.T) {
+	fields := map[string]string{
+		"email":            "alice@example.com",
+		"username":         "alice",
+		"extra.department": "eng",
+		"extra.level":      "10",
+		"extra.zip":        "1000",
+		"phone_number":     "123",
+	}
+	var tests = []struct {
+		rule   string
+		expect bool
+	}{
+		{`email ends_with "@example.com"`, true},
+		{`email ends_with "@example.org"`, false},
+		{`username starts_with "al" and extra.department == "eng"`, true},
+		{`username == "bob" or extra.department == "eng"`, true},
+		{`not extra.department == "eng"`, false},
+		{`extra.department in ["sales", "eng"]`, true},
+		{`extra.level > 9`, true},
+		{`extra.level >= 9`, true},
+		{`extra.level >= "9"`, false},
+		{`extra.level in [10, 11]`, true},
+		{`extra.level < 9.5`, false},
+		{`extra.missing == ""`, true},
+		{`extra.missing != "x"`, true},
+		{`email contains "@" and (username == "bob" or extra.level <= 10)`, true},
+		{`username == "bob" or username == "carol" and email contains "@"`, false},
+		{`username == "with \"quote\""`, false},
+		// quoted literals are compared as strings
+		{`phone_number == "0123"`, false},
+		{`phone_number == 123`, true},
+		{`extra.zip == "1e3"`, false},
+		{`extra.zip == 1000`, true},
+	}
+	for _, v := range tests {
+		rule, err := Parse(v.rule)
+		Assertf(t, err == nil, "parse %q failed: %v", v.rule, err)
+		got := rule.Match(fields)
+		Assertf(t, got == v.expect, "rule %q: expect = %v, got = %v", v.rule, v.expect, got)
+	}
+}
+
+func TestRuleMatchNotNumber(t *testing.T) {
+	var tests = []struct {
+		rule   string
+		value  string
+		expect bool
+	}{
+		{`username == "nan"`, "NaN", false},
+		{`username == "NaN"`, "NaN", true},
+		{`username == 0`, "NaN", false},
+		{`username != 0`, "NaN", true},
+		{`username > 0`, "Inf", true},
+		{`username == "1.0"`, "1", false},
+		{`username == 1.0`, "1", true},
+	}
+	for _, v := range tests {
+		rule, err := Parse(v.rule)
+		Assertf(t, err == nil, "parse %q failed: %v", v.rule, err)
+		got := rule.Match(map[string]string{"username": v.value})
+		Assertf(t, got == v.expect, "rule %q with %q: expect = %v, got = %v", v.rule, v.value, v.expect, got)
+	}
+}
+
+func TestRuleFields(t *testing.T) {
+	rule, err := Parse(`email ends_with "@example.com" and not extra.department in ["eng"]`)
+	Assert(t, err == nil)
+	fields := rule.Fields()
+	Assert(t, len(fields) == 2)
+	Assert(t, fields[0] == "email")
+	Assert(t, fields[1] == "extra.department")
+}
+
+func TestParseInvalidRule(t *testing.T) {
+	for _, s := range []string{
+		``,
+		`email`,
+		`email ==`,
+		`email == "a`,
+		`email == "a" and`,
+		`email == "a")`,
+		`(email == "a"`,
+		`email like "a"`,
+		`email in "a"`,
+		`email in ["a",]`,
+		`email == username`,
+		`email == "a" $`,
+	} {
+		_, err := Parse(s)
+		Assertf(t, err != nil, "parse %q should fail", s)
+	}
+}
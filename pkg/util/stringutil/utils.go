@@ -19,6 +19,7 @@ package stringutil
 import (
 	"regexp"
 	"strings"
+	"unicode"
 	"unicode/utf8"
 )
 
@@ -62,3 +63,54 @@ func Reverse(s string) string {
 	}
 	return string(buf)
 }
+
+// Truncate keeps at most n runes of s.
+func Truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
+
+// Unique removes duplicate strings and keeps the order of the first occurrence.
+func Unique(ss []string) []string {
+	var result []string
+	seen := make(map[string]bool)
+	for _, s := range ss {
+		if !seen[s] {
+			seen[s] = true
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// Diff returns the strings of a that are not in b, in the order of a.
+func Diff(a, b []string) []string {
+	excluded := make(map[string]bool)
+	for _, s := range b {
+		excluded[s] = true
+	}
+	var result []string
+	for _, s := range a {
+		if !excluded[s] {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// CamelCaseToUnderscore converts the go name of a field to the proto name, such as UserId => user_id.
+func CamelCaseToUnderscore(s string) string {
+	var buf strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				buf.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		buf.WriteRune(r)
+	}
+	return buf.String()
+}
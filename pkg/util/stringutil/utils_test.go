@@ -43,3 +43,43 @@ func TestSimplifyString(t *testing.T) {
 		Assertf(t, got == v.expect, "expect = %q, got = %q", v.expect, got)
 	}
 }
+
+func TestTruncate(t *testing.T) {
+	var tests = []struct {
+		s      string
+		n      int
+		expect string
+	}{
+		{s: "abc", n: 5, expect: "abc"},
+		{s: "abc", n: 2, expect: "ab"},
+		{s: "你好世界", n: 2, expect: "你好"},
+	}
+	for _, v := range tests {
+		got := Truncate(v.s, v.n)
+		Assertf(t, got == v.expect, "expect = %q, got = %q", v.expect, got)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	got := Diff([]string{"a", "b", "c", "d"}, []string{"d", "b", "e"})
+
+	Assert(t, len(got) == 2)
+	Assert(t, got[0] == "a")
+	Assert(t, got[1] == "c")
+	Assert(t, len(Diff(nil, []string{"a"})) == 0)
+}
+
+func TestCamelCaseToUnderscore(t *testing.T) {
+	var tests = []struct {
+		s      string
+		expect string
+	}{
+		{s: "UserId", expect: "user_id"},
+		{s: "Email", expect: "email"},
+		{s: "parent_group_id", expect: "parent_group_id"},
+	}
+	for _, v := range tests {
+		got := CamelCaseToUnderscore(v.s)
+		Assertf(t, got == v.expect, "expect = %q, got = %q", v.expect, got)
+	}
+}
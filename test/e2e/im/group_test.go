@@ -21,6 +21,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"kubesphere.io/im/pkg/constants"
 	"kubesphere.io/im/pkg/pb"
@@ -198,3 +201,450 @@ func TestGroup(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestGroupInactivityExempt(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	createGroupResponse, err := imClient.CreateGroup(ctx, &pb.CreateGroupRequest{
+		GroupName:        "service_accounts",
+		InactivityExempt: true,
+	})
+	require.NoError(t, err)
+	groupId := createGroupResponse.GroupId
+
+	getGroupResponse, err := imClient.GetGroup(ctx, &pb.GetGroupRequest{GroupId: groupId})
+	require.NoError(t, err)
+	require.True(t, getGroupResponse.Group.InactivityExempt)
+
+	_, err = imClient.ModifyGroup(ctx, &pb.ModifyGroupRequest{
+		GroupId:    groupId,
+		UpdateMask: &field_mask.FieldMask{Paths: []string{constants.ColumnInactivityExempt}},
+	})
+	require.NoError(t, err)
+
+	getGroupResponse, err = imClient.GetGroup(ctx, &pb.GetGroupRequest{GroupId: groupId})
+	require.NoError(t, err)
+	require.False(t, getGroupResponse.Group.InactivityExempt)
+
+	// clean up
+	_, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{
+		GroupId: []string{groupId},
+	})
+	require.NoError(t, err)
+}
+
+func TestModifyGroupUpdateMask(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	createGroupResponse, err := imClient.CreateGroup(ctx, &pb.CreateGroupRequest{
+		GroupName:   "test_group_mask",
+		Description: "for test",
+		Extra:       map[string]string{"a": "1", "b": "2"},
+	})
+	require.NoError(t, err)
+	groupId := createGroupResponse.GroupId
+
+	// clear description
+	_, err = imClient.ModifyGroup(ctx, &pb.ModifyGroupRequest{
+		GroupId:    groupId,
+		UpdateMask: &field_mask.FieldMask{Paths: []string{constants.ColumnDescription}},
+	})
+	require.NoError(t, err)
+
+	// set a and delete b, keep the others
+	_, err = imClient.ModifyGroup(ctx, &pb.ModifyGroupRequest{
+		GroupId:    groupId,
+		Extra:      map[string]string{"a": "3"},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"extra.a", "extra.b"}},
+	})
+	require.NoError(t, err)
+	_, err = imClient.ModifyGroup(ctx, &pb.ModifyGroupRequest{
+		GroupId:    groupId,
+		Extra:      map[string]string{"c": "4"},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"extra.c"}},
+	})
+	require.NoError(t, err)
+
+	getGroupResponse, err := imClient.GetGroup(ctx, &pb.GetGroupRequest{GroupId: groupId})
+	require.NoError(t, err)
+	require.Equal(t, "test_group_mask", getGroupResponse.Group.GroupName)
+	require.Empty(t, getGroupResponse.Group.Description)
+	require.Equal(t, map[string]string{"a": "3", "c": "4"}, getGroupResponse.Group.Extra)
+
+	// clean up
+	_, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{
+		GroupId: []string{groupId},
+	})
+	require.NoError(t, err)
+}
+
+func TestBatchCreateGroups(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	batchCreateGroupsResponse, err := imClient.BatchCreateGroups(ctx, &pb.BatchCreateGroupsRequest{
+		Group: []*pb.CreateGroupRequest{
+			{GroupName: "test_batch_1"},
+			{GroupName: "test_batch_2", ParentGroupId: "gid-not-exists"},
+			{GroupName: "test_batch_3"},
+			{GroupName: "TEST_BATCH_3"},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, batchCreateGroupsResponse.ResultSet, 4)
+	require.NotNil(t, batchCreateGroupsResponse.ResultSet[1].Error)
+	// names differing in case only are taken as the same
+	require.Equal(t, codes.AlreadyExists.String(), batchCreateGroupsResponse.ResultSet[3].Error.Code)
+	var groupIds []string
+	for _, i := range []int{0, 2} {
+		result := batchCreateGroupsResponse.ResultSet[i]
+		require.Nil(t, result.Error)
+		require.Equal(t, uint32(i), result.Index)
+		groupIds = append(groupIds, result.Id)
+	}
+
+	listGroupsResponse, err := imClient.ListGroups(ctx, &pb.ListGroupsRequest{
+		GroupId: groupIds,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), listGroupsResponse.Total)
+
+	// clean up
+	_, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{
+		GroupId: groupIds,
+	})
+	require.NoError(t, err)
+}
+
+func TestMoveGroup(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	createGroup := func(parentGroupId, groupName string) string {
+		createGroupResponse, err := imClient.CreateGroup(ctx, &pb.CreateGroupRequest{
+			ParentGroupId: parentGroupId,
+			GroupName:     groupName,
+		})
+		require.NoError(t, err)
+		return createGroupResponse.GroupId
+	}
+	getGroup := func(groupId string) *pb.Group {
+		getGroupResponse, err := imClient.GetGroup(ctx, &pb.GetGroupRequest{GroupId: groupId})
+		require.NoError(t, err)
+		return getGroupResponse.Group
+	}
+
+	// a -> b -> c, d
+	a := createGroup("", "test_move_a")
+	b := createGroup(a, "test_move_b")
+	c := createGroup(b, "test_move_c")
+	d := createGroup("", "test_move_d")
+
+	_, err := imClient.MoveGroup(ctx, &pb.MoveGroupRequest{GroupId: b, ParentGroupId: b})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = imClient.MoveGroup(ctx, &pb.MoveGroupRequest{GroupId: a, ParentGroupId: c})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = imClient.MoveGroup(ctx, &pb.MoveGroupRequest{GroupId: "gid-not-exists", ParentGroupId: d})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// a, d -> b -> c
+	moveGroupResponse, err := imClient.MoveGroup(ctx, &pb.MoveGroupRequest{GroupId: b, ParentGroupId: d})
+	require.NoError(t, err)
+	require.Equal(t, d+"."+b, moveGroupResponse.GroupPath)
+	require.Equal(t, uint32(2), moveGroupResponse.AffectedCount)
+	require.Equal(t, d, getGroup(b).ParentGroupId)
+	require.Equal(t, d+"."+b+"."+c, getGroup(c).GroupPath)
+
+	listGroupsResponse, err := imClient.ListGroups(ctx, &pb.ListGroupsRequest{RootGroupId: []string{d}})
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), listGroupsResponse.Total)
+	listGroupsResponse, err = imClient.ListGroups(ctx, &pb.ListGroupsRequest{RootGroupId: []string{a}})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), listGroupsResponse.Total)
+
+	// a, d, b -> c
+	_, err = imClient.ModifyGroup(ctx, &pb.ModifyGroupRequest{
+		GroupId:    b,
+		UpdateMask: &field_mask.FieldMask{Paths: []string{constants.ColumnParentGroupId}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, b, getGroup(b).GroupPath)
+	require.Equal(t, b+"."+c, getGroup(c).GroupPath)
+
+	// clean up
+	_, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{
+		GroupId: []string{a, b, c, d},
+	})
+	require.NoError(t, err)
+}
+
+func TestGroupTree(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	createGroup := func(parentGroupId, groupName string) string {
+		createGroupResponse, err := imClient.CreateGroup(ctx, &pb.CreateGroupRequest{
+			ParentGroupId: parentGroupId,
+			GroupName:     groupName,
+		})
+		require.NoError(t, err)
+		return createGroupResponse.GroupId
+	}
+
+	// the siblings may be created in the same second, so they are looked up by id
+	findNode := func(nodes []*pb.GroupTreeNode, groupId string) *pb.GroupTreeNode {
+		for _, node := range nodes {
+			if node.Group.GroupId == groupId {
+				return node
+			}
+		}
+		require.Failf(t, "node not found", "group [%s] not in the tree", groupId)
+		return nil
+	}
+
+	// root -> (a -> c), b
+	root := createGroup("", "test_tree_root")
+	a := createGroup(root, "test_tree_a")
+	b := createGroup(root, "test_tree_b")
+	c := createGroup(a, "test_tree_c")
+
+	createUserResponse, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username: "test_tree",
+	})
+	require.NoError(t, err)
+	userId := createUserResponse.UserId
+	_, err = imClient.JoinGroup(ctx, &pb.JoinGroupRequest{
+		GroupId: []string{a, c},
+		UserId:  []string{userId},
+	})
+	require.NoError(t, err)
+
+	getGroupTreeResponse, err := imClient.GetGroupTree(ctx, &pb.GetGroupTreeRequest{
+		RootGroupId:     root,
+		WithMemberCount: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, getGroupTreeResponse.NodeSet, 1)
+	rootNode := getGroupTreeResponse.NodeSet[0]
+	require.Equal(t, root, rootNode.Group.GroupId)
+	require.Equal(t, uint32(2), rootNode.ChildCount)
+	require.Len(t, rootNode.Children, 2)
+	require.Equal(t, uint32(0), rootNode.DirectMemberCount.Value)
+	require.Equal(t, uint32(1), rootNode.TotalMemberCount.Value)
+	aNode := findNode(rootNode.Children, a)
+	require.Equal(t, uint32(1), aNode.DirectMemberCount.Value)
+	require.Len(t, aNode.Children, 1)
+	require.Equal(t, c, aNode.Children[0].Group.GroupId)
+	require.Empty(t, findNode(rootNode.Children, b).Children)
+
+	// expand one level at a time
+	getGroupTreeResponse, err = imClient.GetGroupTree(ctx, &pb.GetGroupTreeRequest{
+		RootGroupId: root,
+		MaxDepth:    1,
+	})
+	require.NoError(t, err)
+	rootNode = getGroupTreeResponse.NodeSet[0]
+	require.Len(t, rootNode.Children, 2)
+	aNode = findNode(rootNode.Children, a)
+	require.Empty(t, aNode.Children)
+	require.Equal(t, uint32(1), aNode.ChildCount)
+	require.Nil(t, rootNode.DirectMemberCount)
+
+	// clean up
+	_, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{
+		GroupId: []string{root},
+		Cascade: true,
+	})
+	require.NoError(t, err)
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{
+		UserId: []string{userId},
+	})
+	require.NoError(t, err)
+}
+
+func TestGroupLimits(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	createGroup := func(req *pb.CreateGroupRequest) (string, error) {
+		createGroupResponse, err := imClient.CreateGroup(ctx, req)
+		if err != nil {
+			return "", err
+		}
+		return createGroupResponse.GroupId, nil
+	}
+
+	// root -> child, at most one sub group per group and two levels
+	root, err := createGroup(&pb.CreateGroupRequest{
+		GroupName:      "test_limit_root",
+		MaxChildGroups: 1,
+		MaxDepth:       2,
+		MaxMembers:     1,
+	})
+	require.NoError(t, err)
+	getGroupResponse, err := imClient.GetGroup(ctx, &pb.GetGroupRequest{GroupId: root})
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), getGroupResponse.Group.MaxDepth)
+
+	child, err := createGroup(&pb.CreateGroupRequest{ParentGroupId: root, GroupName: "test_limit_child"})
+	require.NoError(t, err)
+	_, err = createGroup(&pb.CreateGroupRequest{ParentGroupId: root, GroupName: "test_limit_child2"})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	_, err = createGroup(&pb.CreateGroupRequest{ParentGroupId: child, GroupName: "test_limit_grandchild"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// other -> other_child can not be moved under the child
+	other, err := createGroup(&pb.CreateGroupRequest{GroupName: "test_limit_other"})
+	require.NoError(t, err)
+	otherChild, err := createGroup(&pb.CreateGroupRequest{ParentGroupId: other, GroupName: "test_limit_other_child"})
+	require.NoError(t, err)
+	_, err = imClient.MoveGroup(ctx, &pb.MoveGroupRequest{GroupId: other, ParentGroupId: child})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = imClient.MoveGroup(ctx, &pb.MoveGroupRequest{GroupId: otherChild, ParentGroupId: root})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// a raised limit takes effect at once
+	_, err = imClient.ModifyGroup(ctx, &pb.ModifyGroupRequest{GroupId: root, MaxDepth: 4})
+	require.NoError(t, err)
+	_, err = imClient.MoveGroup(ctx, &pb.MoveGroupRequest{GroupId: other, ParentGroupId: child})
+	require.NoError(t, err)
+
+	var userIds []string
+	for _, username := range []string{"test_limit_1", "test_limit_2"} {
+		createUserResponse, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{Username: username})
+		require.NoError(t, err)
+		userIds = append(userIds, createUserResponse.UserId)
+	}
+	_, err = imClient.JoinGroup(ctx, &pb.JoinGroupRequest{GroupId: []string{root}, UserId: userIds})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	_, err = imClient.JoinGroup(ctx, &pb.JoinGroupRequest{GroupId: []string{root}, UserId: userIds[:1]})
+	require.NoError(t, err)
+	_, err = imClient.JoinGroup(ctx, &pb.JoinGroupRequest{GroupId: []string{root}, UserId: userIds[1:]})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// members moved by a delete count against the limit too
+	source, err := createGroup(&pb.CreateGroupRequest{GroupName: "test_limit_source"})
+	require.NoError(t, err)
+	_, err = imClient.JoinGroup(ctx, &pb.JoinGroupRequest{GroupId: []string{source}, UserId: userIds[1:]})
+	require.NoError(t, err)
+	_, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{
+		GroupId:       []string{source},
+		Cascade:       true,
+		MoveMembersTo: root,
+	})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// sub groups of a batch count against the limit of their parent
+	batchRoot, err := createGroup(&pb.CreateGroupRequest{
+		GroupName:      "test_limit_batch",
+		MaxChildGroups: 2,
+	})
+	require.NoError(t, err)
+	batchCreateGroupsResponse, err := imClient.BatchCreateGroups(ctx, &pb.BatchCreateGroupsRequest{
+		Group: []*pb.CreateGroupRequest{
+			{GroupName: "test_limit_batch_1", ParentGroupId: batchRoot},
+			{GroupName: "test_limit_batch_2", ParentGroupId: batchRoot},
+			{GroupName: "test_limit_batch_3", ParentGroupId: batchRoot},
+		},
+	})
+	require.NoError(t, err)
+	require.Nil(t, batchCreateGroupsResponse.ResultSet[0].Error)
+	require.Nil(t, batchCreateGroupsResponse.ResultSet[1].Error)
+	require.NotNil(t, batchCreateGroupsResponse.ResultSet[2].Error)
+	require.Equal(t, codes.ResourceExhausted.String(), batchCreateGroupsResponse.ResultSet[2].Error.Code)
+
+	// clean up
+	_, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{
+		GroupId: []string{root, source, batchRoot},
+		Cascade: true,
+	})
+	require.NoError(t, err)
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{UserId: userIds})
+	require.NoError(t, err)
+}
+
+func TestGroupNamePath(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	createGroup := func(parentGroupId, groupName string) (string, error) {
+		createGroupResponse, err := imClient.CreateGroup(ctx, &pb.CreateGroupRequest{
+			ParentGroupId: parentGroupId,
+			GroupName:     groupName,
+		})
+		if err != nil {
+			return "", err
+		}
+		return createGroupResponse.GroupId, nil
+	}
+
+	// eng -> platform -> sre, other -> sre
+	eng, err := createGroup("", "test_name_eng")
+	require.NoError(t, err)
+	platform, err := createGroup(eng, "platform")
+	require.NoError(t, err)
+	sre, err := createGroup(platform, "sre")
+	require.NoError(t, err)
+	other, err := createGroup("", "test_name_other")
+	require.NoError(t, err)
+	otherSre, err := createGroup(other, "sre")
+	require.NoError(t, err)
+
+	getGroupResponse, err := imClient.GetGroupByNamePath(ctx, &pb.GetGroupByNamePathRequest{
+		GroupNamePath: "/test_name_eng/platform/sre/",
+	})
+	require.NoError(t, err)
+	require.Equal(t, sre, getGroupResponse.Group.GroupId)
+	require.Equal(t, "/test_name_eng/platform/sre", getGroupResponse.Group.GroupNamePath)
+	require.Equal(t, eng+"."+platform+"."+sre, getGroupResponse.Group.GroupPath)
+
+	_, err = createGroup(eng, "platform")
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = createGroup(eng, "platform/sre")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = imClient.MoveGroup(ctx, &pb.MoveGroupRequest{GroupId: otherSre, ParentGroupId: platform})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// renaming rewrites the name paths of the subtree
+	_, err = imClient.ModifyGroup(ctx, &pb.ModifyGroupRequest{GroupId: platform, GroupName: "infra"})
+	require.NoError(t, err)
+	_, err = imClient.GetGroupByNamePath(ctx, &pb.GetGroupByNamePathRequest{
+		GroupNamePath: "/test_name_eng/platform/sre",
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	getGroupResponse, err = imClient.GetGroupByNamePath(ctx, &pb.GetGroupByNamePathRequest{
+		GroupNamePath: "test_name_eng/infra/sre",
+	})
+	require.NoError(t, err)
+	require.Equal(t, sre, getGroupResponse.Group.GroupId)
+
+	moveGroupResponse, err := imClient.MoveGroup(ctx, &pb.MoveGroupRequest{GroupId: platform, ParentGroupId: other})
+	require.NoError(t, err)
+	require.Equal(t, "/test_name_other/infra", moveGroupResponse.GroupNamePath)
+
+	listGroupsResponse, err := imClient.ListGroups(ctx, &pb.ListGroupsRequest{
+		GroupNamePath: []string{"/test_name_other/infra/sre"},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, listGroupsResponse.Total)
+	require.Equal(t, sre, listGroupsResponse.GroupSet[0].GroupId)
+	require.Equal(t, other+"."+platform+"."+sre, listGroupsResponse.GroupSet[0].GroupPath)
+
+	// clean up
+	_, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{
+		GroupId: []string{eng, other},
+		Cascade: true,
+	})
+	require.NoError(t, err)
+}
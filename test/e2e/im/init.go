@@ -17,13 +17,17 @@ limitations under the License.
 package im
 
 import (
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"kubesphere.io/im/pkg/client/im"
 	"kubesphere.io/im/pkg/config"
+	"kubesphere.io/im/pkg/constants"
 	"kubesphere.io/im/pkg/global"
+	"kubesphere.io/im/pkg/models"
 )
 
 var imClient *im.Client
@@ -38,3 +42,30 @@ func prepare(t *testing.T) {
 	imClient, err = im.NewClient()
 	require.NoError(t, err)
 }
+
+var mailTokenRegexp = regexp.MustCompile(`\b[0-9A-Za-z]{50}\b`)
+
+// getMailToken returns the token of the latest mail sent to recipient,
+// tokens are only delivered by mail.
+func getMailToken(t *testing.T, recipient string) string {
+	var notification models.Notification
+	err := global.Global().Database.Table(constants.TableNotification).
+		Where(constants.ColumnRecipient+" = ?", recipient).
+		Order(constants.ColumnCreateTime + " DESC").
+		Take(&notification).Error
+	require.NoError(t, err)
+	token := mailTokenRegexp.FindString(notification.Body)
+	require.NotEmpty(t, token)
+	return token
+}
+
+// waitFor polls the condition until it holds, or fails the test after timeout.
+func waitFor(t *testing.T, condition func() bool, timeout time.Duration) {
+	deadline := time.Now().Add(timeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("condition not met in %s", timeout)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
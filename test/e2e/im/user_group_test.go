@@ -19,11 +19,19 @@ package im
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/global"
+	"kubesphere.io/im/pkg/models"
 	"kubesphere.io/im/pkg/pb"
+	"kubesphere.io/im/pkg/service/im/resource"
 )
 
 func TestUserGroup(t *testing.T) {
@@ -158,3 +166,934 @@ func TestUserGroup(t *testing.T) {
 	require.NoError(t, err)
 
 }
+
+func TestUserReuseAfterDelete(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	createGroupResponse, err := imClient.CreateGroup(ctx, &pb.CreateGroupRequest{
+		GroupName:   "test reuse",
+		Description: "for test",
+	})
+	require.NoError(t, err)
+	groupId := createGroupResponse.GroupId
+
+	createUserRequest := &pb.CreateUserRequest{
+		Username:    "test_reuse",
+		Email:       "test_reuse@op.com",
+		PhoneNumber: "10000000000",
+		Description: "for test",
+		Password:    "passw0rd",
+	}
+
+	// create user and join group
+	createUserResponse, err := imClient.CreateUser(ctx, createUserRequest)
+	require.NoError(t, err)
+	oldUserId := createUserResponse.UserId
+
+	_, err = imClient.JoinGroup(ctx, &pb.JoinGroupRequest{
+		GroupId: []string{groupId},
+		UserId:  []string{oldUserId},
+	})
+	require.NoError(t, err)
+
+	// same username can not be used by active users
+	_, err = imClient.CreateUser(ctx, createUserRequest)
+	require.Error(t, err)
+
+	// delete user
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{
+		UserId: []string{oldUserId},
+	})
+	require.NoError(t, err)
+
+	// recreate user, old group bindings are not revived
+	createUserResponse, err = imClient.CreateUser(ctx, createUserRequest)
+	require.NoError(t, err)
+	newUserId := createUserResponse.UserId
+	require.NotEqual(t, oldUserId, newUserId)
+
+	getUserWithGroupResponse, err := imClient.GetUserWithGroup(ctx, &pb.GetUserRequest{
+		UserId: newUserId,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, len(getUserWithGroupResponse.User.GroupSet), 0)
+
+	// old user keeps its data
+	getUserResponse, err := imClient.GetUser(ctx, &pb.GetUserRequest{
+		UserId: oldUserId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, createUserRequest.Username, getUserResponse.User.Username)
+	require.Equal(t, constants.StatusDeleted, getUserResponse.User.Status)
+
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{
+		UserId: []string{newUserId},
+	})
+	require.NoError(t, err)
+
+	_, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{
+		GroupId: []string{groupId},
+	})
+	require.NoError(t, err)
+}
+
+func TestInviteUser(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	createGroupResponse, err := imClient.CreateGroup(ctx, &pb.CreateGroupRequest{
+		GroupName:   "test_invite",
+		Description: "for test",
+	})
+	require.NoError(t, err)
+	groupId := createGroupResponse.GroupId
+
+	inviteUserResponse, err := imClient.InviteUser(ctx, &pb.InviteUserRequest{
+		Email:   "test_invite@op.com",
+		GroupId: []string{groupId},
+	})
+	require.NoError(t, err)
+	userId := inviteUserResponse.UserId
+	token := getMailToken(t, "test_invite@op.com")
+
+	listInvitesResponse, err := imClient.ListInvites(ctx, &pb.ListInvitesRequest{
+		InviteId: []string{inviteUserResponse.InviteId},
+		Status:   []string{constants.InviteStatusPending},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, listInvitesResponse.Total)
+	require.Equal(t, []string{groupId}, listInvitesResponse.InviteSet[0].GroupId)
+
+	getUserResponse, err := imClient.GetUser(ctx, &pb.GetUserRequest{UserId: userId})
+	require.NoError(t, err)
+	require.Equal(t, constants.StatusPending, getUserResponse.User.Status)
+
+	// accept invite
+	_, err = imClient.AcceptInvite(ctx, &pb.AcceptInviteRequest{
+		Token:    token,
+		Username: "test_invite",
+		Password: "passw0rd",
+	})
+	require.NoError(t, err)
+
+	// token is single-use
+	_, err = imClient.AcceptInvite(ctx, &pb.AcceptInviteRequest{
+		Token:    token,
+		Password: "passw0rd",
+	})
+	require.Error(t, err)
+
+	getUserWithGroupResponse, err := imClient.GetUserWithGroup(ctx, &pb.GetUserRequest{UserId: userId})
+	require.NoError(t, err)
+	require.Equal(t, constants.StatusActive, getUserWithGroupResponse.User.User.Status)
+	require.Equal(t, "test_invite", getUserWithGroupResponse.User.User.Username)
+	require.True(t, getUserWithGroupResponse.User.User.EmailVerified)
+	require.Len(t, getUserWithGroupResponse.User.GroupSet, 1)
+	require.Equal(t, groupId, getUserWithGroupResponse.User.GroupSet[0].GroupId)
+
+	comparePasswordResponse, err := imClient.ComparePassword(ctx, &pb.ComparePasswordRequest{
+		UserId:   userId,
+		Password: "passw0rd",
+	})
+	require.NoError(t, err)
+	require.True(t, comparePasswordResponse.Ok)
+
+	// required attributes are checked at invite time
+	createAttributeResponse, err := imClient.CreateAttribute(ctx, &pb.CreateAttributeRequest{
+		Target: constants.TableUser, Name: "e2e_invite_level", Type: constants.AttributeTypeInt, Required: true,
+	})
+	require.NoError(t, err)
+	_, err = imClient.InviteUser(ctx, &pb.InviteUserRequest{
+		Email: "test_invite_extra@op.com",
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	extraInviteResponse, err := imClient.InviteUser(ctx, &pb.InviteUserRequest{
+		Email: "test_invite_extra@op.com",
+		Extra: map[string]string{"e2e_invite_level": "3"},
+	})
+	require.NoError(t, err)
+	_, err = imClient.AcceptInvite(ctx, &pb.AcceptInviteRequest{
+		Token:    getMailToken(t, "test_invite_extra@op.com"),
+		Password: "passw0rd",
+	})
+	require.NoError(t, err)
+	getUserResponse, err = imClient.GetUser(ctx, &pb.GetUserRequest{UserId: extraInviteResponse.UserId})
+	require.NoError(t, err)
+	require.Equal(t, "3", getUserResponse.User.Extra["e2e_invite_level"])
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{
+		UserId: []string{extraInviteResponse.UserId},
+	})
+	require.NoError(t, err)
+	_, err = imClient.DeleteAttributes(ctx, &pb.DeleteAttributesRequest{
+		AttributeId: []string{createAttributeResponse.AttributeId},
+	})
+	require.NoError(t, err)
+
+	// revoke invite
+	revokedInviteResponse, err := imClient.InviteUser(ctx, &pb.InviteUserRequest{
+		Email: "test_revoke@op.com",
+	})
+	require.NoError(t, err)
+	revokedToken := getMailToken(t, "test_revoke@op.com")
+	_, err = imClient.RevokeInvite(ctx, &pb.RevokeInviteRequest{
+		InviteId: revokedInviteResponse.InviteId,
+	})
+	require.NoError(t, err)
+	_, err = imClient.AcceptInvite(ctx, &pb.AcceptInviteRequest{
+		Token:    revokedToken,
+		Password: "passw0rd",
+	})
+	require.Error(t, err)
+
+	getUserResponse, err = imClient.GetUser(ctx, &pb.GetUserRequest{UserId: revokedInviteResponse.UserId})
+	require.NoError(t, err)
+	require.Equal(t, constants.StatusDeleted, getUserResponse.User.Status)
+
+	// clean up
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{
+		UserId: []string{userId},
+	})
+	require.NoError(t, err)
+	_, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{
+		GroupId: []string{groupId},
+	})
+	require.NoError(t, err)
+}
+
+func TestMergeUsers(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	var groupIds []string
+	for _, name := range []string{"test_merge_1", "test_merge_2"} {
+		createGroupResponse, err := imClient.CreateGroup(ctx, &pb.CreateGroupRequest{
+			GroupName: name,
+		})
+		require.NoError(t, err)
+		groupIds = append(groupIds, createGroupResponse.GroupId)
+	}
+
+	createUserResponse, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username: "test_merge_source",
+		Extra:    map[string]string{"a": "1", "b": "2"},
+	})
+	require.NoError(t, err)
+	sourceUserId := createUserResponse.UserId
+
+	createUserResponse, err = imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username: "test_merge_target",
+		Extra:    map[string]string{"a": "3"},
+	})
+	require.NoError(t, err)
+	targetUserId := createUserResponse.UserId
+
+	_, err = imClient.JoinGroup(ctx, &pb.JoinGroupRequest{
+		GroupId: groupIds,
+		UserId:  []string{sourceUserId},
+	})
+	require.NoError(t, err)
+	_, err = imClient.JoinGroup(ctx, &pb.JoinGroupRequest{
+		GroupId: groupIds[:1],
+		UserId:  []string{targetUserId},
+	})
+	require.NoError(t, err)
+
+	_, err = imClient.MergeUsers(ctx, &pb.MergeUsersRequest{
+		SourceUserId:     sourceUserId,
+		TargetUserId:     targetUserId,
+		ConflictStrategy: constants.MergeConflictFail,
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	loginHistory := models.NewLoginHistory(sourceUserId, true, "127.0.0.1", "test")
+	require.NoError(t, global.Global().Database.Create(loginHistory).Error)
+
+	mergeUsersResponse, err := imClient.MergeUsers(ctx, &pb.MergeUsersRequest{
+		SourceUserId: sourceUserId,
+		TargetUserId: targetUserId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, groupIds[1:], mergeUsersResponse.GroupId)
+
+	getUserResponse, err := imClient.GetUser(ctx, &pb.GetUserRequest{UserId: sourceUserId})
+	require.NoError(t, err)
+	require.Equal(t, constants.StatusDeleted, getUserResponse.User.Status)
+	require.Equal(t, targetUserId, getUserResponse.User.MergedInto)
+
+	getUserWithGroupResponse, err := imClient.GetUserWithGroup(ctx, &pb.GetUserRequest{
+		UserId:       sourceUserId,
+		FollowMerged: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, targetUserId, getUserWithGroupResponse.User.User.UserId)
+	require.Equal(t, map[string]string{"a": "3", "b": "2"}, getUserWithGroupResponse.User.User.Extra)
+	require.Len(t, getUserWithGroupResponse.User.GroupSet, 2)
+
+	// the login history stays with the source user
+	var count int
+	require.NoError(t, global.Global().Database.Model(&models.LoginHistory{}).
+		Where(constants.ColumnLoginId+" = ?", loginHistory.LoginId).
+		Where(constants.ColumnUserId+" = ?", sourceUserId).
+		Count(&count).Error)
+	require.Equal(t, 1, count)
+
+	_, err = imClient.MergeUsers(ctx, &pb.MergeUsersRequest{
+		SourceUserId: sourceUserId,
+		TargetUserId: targetUserId,
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// clean up
+	_, err = imClient.LeaveGroup(ctx, &pb.LeaveGroupRequest{
+		GroupId: groupIds,
+		UserId:  []string{targetUserId},
+	})
+	require.NoError(t, err)
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{
+		UserId: []string{targetUserId},
+	})
+	require.NoError(t, err)
+	_, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{
+		GroupId: groupIds,
+	})
+	require.NoError(t, err)
+}
+
+func TestDeleteGroupsCascade(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	createGroup := func(parentGroupId, groupName string) string {
+		createGroupResponse, err := imClient.CreateGroup(ctx, &pb.CreateGroupRequest{
+			ParentGroupId: parentGroupId,
+			GroupName:     groupName,
+		})
+		require.NoError(t, err)
+		return createGroupResponse.GroupId
+	}
+
+	// root -> child, other
+	root := createGroup("", "test_cascade_root")
+	child := createGroup(root, "test_cascade_child")
+	other := createGroup("", "test_cascade_other")
+
+	createUserResponse, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username: "test_cascade",
+	})
+	require.NoError(t, err)
+	userId := createUserResponse.UserId
+
+	_, err = imClient.JoinGroup(ctx, &pb.JoinGroupRequest{
+		GroupId: []string{root, child},
+		UserId:  []string{userId},
+	})
+	require.NoError(t, err)
+
+	_, err = imClient.AddGroupMembers(ctx, &pb.AddGroupMembersRequest{
+		GroupId:       other,
+		MemberGroupId: []string{child},
+	})
+	require.NoError(t, err)
+
+	_, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{GroupId: []string{root}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{
+		GroupId:       []string{root},
+		Cascade:       true,
+		MoveMembersTo: child,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	deleteGroupsResponse, err := imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{
+		GroupId:       []string{root},
+		Cascade:       true,
+		MoveMembersTo: other,
+		DryRun:        true,
+	})
+	require.NoError(t, err)
+	require.True(t, deleteGroupsResponse.DryRun)
+	require.ElementsMatch(t, []string{root, child}, deleteGroupsResponse.GroupId)
+	require.Len(t, deleteGroupsResponse.BindingSet, 2)
+	require.Len(t, deleteGroupsResponse.GroupMemberSet, 1)
+	require.Equal(t, other, deleteGroupsResponse.GroupMemberSet[0].GroupId)
+	require.Equal(t, child, deleteGroupsResponse.GroupMemberSet[0].MemberGroupId)
+
+	getGroupResponse, err := imClient.GetGroup(ctx, &pb.GetGroupRequest{GroupId: child})
+	require.NoError(t, err)
+	require.Equal(t, constants.StatusActive, getGroupResponse.Group.Status)
+
+	deleteGroupsResponse, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{
+		GroupId:       []string{root},
+		Cascade:       true,
+		MoveMembersTo: other,
+	})
+	require.NoError(t, err)
+	require.False(t, deleteGroupsResponse.DryRun)
+	require.Len(t, deleteGroupsResponse.GroupMemberSet, 1)
+
+	getGroupWithUserResponse, err := imClient.GetGroupWithUser(ctx, &pb.GetGroupRequest{GroupId: other})
+	require.NoError(t, err)
+	require.Empty(t, getGroupWithUserResponse.Group.MemberGroupSet)
+
+	getGroupResponse, err = imClient.GetGroup(ctx, &pb.GetGroupRequest{GroupId: child})
+	require.NoError(t, err)
+	require.Equal(t, constants.StatusDeleted, getGroupResponse.Group.Status)
+
+	getUserWithGroupResponse, err := imClient.GetUserWithGroup(ctx, &pb.GetUserRequest{UserId: userId})
+	require.NoError(t, err)
+	require.Len(t, getUserWithGroupResponse.User.GroupSet, 1)
+	require.Equal(t, other, getUserWithGroupResponse.User.GroupSet[0].GroupId)
+
+	// clean up
+	_, err = imClient.LeaveGroup(ctx, &pb.LeaveGroupRequest{
+		GroupId: []string{other},
+		UserId:  []string{userId},
+	})
+	require.NoError(t, err)
+	_, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{GroupId: []string{other}})
+	require.NoError(t, err)
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{
+		UserId: []string{userId},
+	})
+	require.NoError(t, err)
+}
+
+func TestEffectiveMembership(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	createGroupResponse, err := imClient.CreateGroup(ctx, &pb.CreateGroupRequest{GroupName: "test_effective_root"})
+	require.NoError(t, err)
+	root := createGroupResponse.GroupId
+	createGroupResponse, err = imClient.CreateGroup(ctx, &pb.CreateGroupRequest{GroupName: "test_effective_child", ParentGroupId: root})
+	require.NoError(t, err)
+	child := createGroupResponse.GroupId
+
+	var userIds []string
+	for _, username := range []string{"test_effective_1", "test_effective_2"} {
+		createUserResponse, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{Username: username})
+		require.NoError(t, err)
+		userIds = append(userIds, createUserResponse.UserId)
+	}
+	_, err = imClient.JoinGroup(ctx, &pb.JoinGroupRequest{GroupId: []string{child}, UserId: userIds})
+	require.NoError(t, err)
+	_, err = imClient.JoinGroup(ctx, &pb.JoinGroupRequest{GroupId: []string{root}, UserId: userIds[1:]})
+	require.NoError(t, err)
+
+	listEffectiveGroupsResponse, err := imClient.ListEffectiveGroups(ctx, &pb.ListEffectiveGroupsRequest{UserId: userIds[0]})
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), listEffectiveGroupsResponse.Total)
+	require.Equal(t, root, listEffectiveGroupsResponse.GroupSet[0].Group.GroupId)
+	require.False(t, listEffectiveGroupsResponse.GroupSet[0].Direct)
+	require.Equal(t, []string{root, child}, listEffectiveGroupsResponse.GroupSet[0].Path)
+	require.True(t, listEffectiveGroupsResponse.GroupSet[1].Direct)
+
+	listEffectiveMembersResponse, err := imClient.ListEffectiveMembers(ctx, &pb.ListEffectiveMembersRequest{GroupId: root})
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), listEffectiveMembersResponse.Total)
+	for _, member := range listEffectiveMembersResponse.UserSet {
+		require.Equal(t, member.User.UserId == userIds[1], member.Direct)
+	}
+
+	isMemberResponse, err := imClient.IsMember(ctx, &pb.IsMemberRequest{UserId: userIds[0], GroupId: root})
+	require.NoError(t, err)
+	require.False(t, isMemberResponse.IsMember)
+	isMemberResponse, err = imClient.IsMember(ctx, &pb.IsMemberRequest{UserId: userIds[0], GroupId: root, Transitive: true})
+	require.NoError(t, err)
+	require.True(t, isMemberResponse.IsMember)
+
+	// clean up
+	_, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{GroupId: []string{root}, Cascade: true})
+	require.NoError(t, err)
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{UserId: userIds})
+	require.NoError(t, err)
+}
+
+func TestNestedGroups(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	createGroup := func(parentGroupId, groupName string) string {
+		createGroupResponse, err := imClient.CreateGroup(ctx, &pb.CreateGroupRequest{
+			ParentGroupId: parentGroupId,
+			GroupName:     groupName,
+		})
+		require.NoError(t, err)
+		return createGroupResponse.GroupId
+	}
+
+	// teamA, teamB -> teamBSub, onCall contains teamA and teamB
+	teamA := createGroup("", "test_nested_a")
+	teamB := createGroup("", "test_nested_b")
+	teamBSub := createGroup(teamB, "test_nested_b_sub")
+	onCall := createGroup("", "test_nested_on_call")
+
+	var userIds []string
+	usernames := []string{"test_nested_1", "test_nested_2"}
+	for i, groupId := range []string{teamA, teamBSub} {
+		createUserResponse, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{
+			Username: usernames[i],
+		})
+		require.NoError(t, err)
+		userIds = append(userIds, createUserResponse.UserId)
+		_, err = imClient.JoinGroup(ctx, &pb.JoinGroupRequest{
+			GroupId: []string{groupId},
+			UserId:  []string{createUserResponse.UserId},
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := imClient.AddGroupMembers(ctx, &pb.AddGroupMembersRequest{
+		GroupId:       onCall,
+		MemberGroupId: []string{teamA, teamB},
+	})
+	require.NoError(t, err)
+
+	_, err = imClient.AddGroupMembers(ctx, &pb.AddGroupMembersRequest{
+		GroupId:       onCall,
+		MemberGroupId: []string{teamA},
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// cycles
+	_, err = imClient.AddGroupMembers(ctx, &pb.AddGroupMembersRequest{
+		GroupId:       teamBSub,
+		MemberGroupId: []string{onCall},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = imClient.MoveGroup(ctx, &pb.MoveGroupRequest{GroupId: onCall, ParentGroupId: teamBSub})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	isMemberResponse, err := imClient.IsMember(ctx, &pb.IsMemberRequest{UserId: userIds[1], GroupId: onCall})
+	require.NoError(t, err)
+	require.False(t, isMemberResponse.IsMember)
+	isMemberResponse, err = imClient.IsMember(ctx, &pb.IsMemberRequest{UserId: userIds[1], GroupId: onCall, Transitive: true})
+	require.NoError(t, err)
+	require.True(t, isMemberResponse.IsMember)
+
+	listEffectiveGroupsResponse, err := imClient.ListEffectiveGroups(ctx, &pb.ListEffectiveGroupsRequest{UserId: userIds[1]})
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), listEffectiveGroupsResponse.Total)
+	for _, effectiveGroup := range listEffectiveGroupsResponse.GroupSet {
+		if effectiveGroup.Group.GroupId == onCall {
+			require.Equal(t, []string{onCall, teamB, teamBSub}, effectiveGroup.Path)
+		}
+	}
+
+	listEffectiveMembersResponse, err := imClient.ListEffectiveMembers(ctx, &pb.ListEffectiveMembersRequest{GroupId: onCall})
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), listEffectiveMembersResponse.Total)
+
+	getGroupWithUserResponse, err := imClient.GetGroupWithUser(ctx, &pb.GetGroupRequest{GroupId: onCall})
+	require.NoError(t, err)
+	require.Empty(t, getGroupWithUserResponse.Group.UserSet)
+	require.Len(t, getGroupWithUserResponse.Group.MemberGroupSet, 2)
+	getGroupWithUserResponse, err = imClient.GetGroupWithUser(ctx, &pb.GetGroupRequest{GroupId: onCall, ExpandNested: true})
+	require.NoError(t, err)
+	require.Len(t, getGroupWithUserResponse.Group.UserSet, 2)
+
+	_, err = imClient.RemoveGroupMembers(ctx, &pb.RemoveGroupMembersRequest{
+		GroupId:       onCall,
+		MemberGroupId: []string{teamA},
+	})
+	require.NoError(t, err)
+	isMemberResponse, err = imClient.IsMember(ctx, &pb.IsMemberRequest{UserId: userIds[0], GroupId: onCall, Transitive: true})
+	require.NoError(t, err)
+	require.False(t, isMemberResponse.IsMember)
+
+	// clean up
+	_, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{
+		GroupId: []string{teamA, teamB, onCall},
+		Cascade: true,
+	})
+	require.NoError(t, err)
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{UserId: userIds})
+	require.NoError(t, err)
+}
+
+func TestDynamicGroup(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	rule := `email ends_with "@dyn.example.com" and extra.level >= 3`
+	_, err := imClient.CreateGroup(ctx, &pb.CreateGroupRequest{
+		GroupName:      "test_dynamic_invalid",
+		MembershipRule: `nickname == "x"`,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	createUserResponse, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username: "test_dynamic_senior",
+		Email:    "senior@dyn.example.com",
+		Extra:    map[string]string{"level": "5"},
+	})
+	require.NoError(t, err)
+	seniorUserId := createUserResponse.UserId
+
+	previewRuleResponse, err := imClient.PreviewRule(ctx, &pb.PreviewRuleRequest{MembershipRule: rule})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), previewRuleResponse.Total)
+	require.Equal(t, seniorUserId, previewRuleResponse.UserSet[0].UserId)
+
+	createGroupResponse, err := imClient.CreateGroup(ctx, &pb.CreateGroupRequest{
+		GroupName:      "test_dynamic",
+		MembershipRule: rule,
+	})
+	require.NoError(t, err)
+	groupId := createGroupResponse.GroupId
+
+	isMember := func(userId string) bool {
+		isMemberResponse, err := imClient.IsMember(ctx, &pb.IsMemberRequest{UserId: userId, GroupId: groupId})
+		require.NoError(t, err)
+		return isMemberResponse.IsMember
+	}
+
+	// the existing user is reconciled in background after the create,
+	// a new user once it is created
+	waitFor(t, func() bool { return isMember(seniorUserId) }, 10*time.Second)
+	createUserResponse, err = imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username: "test_dynamic_junior",
+		Email:    "junior@dyn.example.com",
+		Extra:    map[string]string{"level": "4"},
+	})
+	require.NoError(t, err)
+	juniorUserId := createUserResponse.UserId
+	require.True(t, isMember(juniorUserId))
+
+	_, err = imClient.ModifyUser(ctx, &pb.ModifyUserRequest{
+		UserId: juniorUserId,
+		Extra:  map[string]string{"level": "1"},
+	})
+	require.NoError(t, err)
+	require.False(t, isMember(juniorUserId))
+
+	_, err = imClient.JoinGroup(ctx, &pb.JoinGroupRequest{
+		GroupId: []string{groupId},
+		UserId:  []string{juniorUserId},
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = imClient.LeaveGroup(ctx, &pb.LeaveGroupRequest{
+		GroupId: []string{groupId},
+		UserId:  []string{seniorUserId},
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// a changed rule is applied to the existing members in background
+	_, err = imClient.ModifyGroup(ctx, &pb.ModifyGroupRequest{
+		GroupId:        groupId,
+		MembershipRule: `email ends_with "@dyn.example.com" and extra.level < 3`,
+	})
+	require.NoError(t, err)
+	waitFor(t, func() bool { return !isMember(seniorUserId) }, 10*time.Second)
+	require.True(t, isMember(juniorUserId))
+
+	// clean up
+	_, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{
+		GroupId: []string{groupId},
+		Cascade: true,
+	})
+	require.NoError(t, err)
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{UserId: []string{seniorUserId, juniorUserId}})
+	require.NoError(t, err)
+}
+
+func TestMembershipExpiry(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	createGroupResponse, err := imClient.CreateGroup(ctx, &pb.CreateGroupRequest{
+		GroupName: "test_expiry",
+	})
+	require.NoError(t, err)
+	groupId := createGroupResponse.GroupId
+
+	createUserResponse, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username: "test_expiry",
+	})
+	require.NoError(t, err)
+	userId := createUserResponse.UserId
+
+	timestampAfter := func(d time.Duration) *timestamp.Timestamp {
+		ts, err := ptypes.TimestampProto(time.Now().Add(d))
+		require.NoError(t, err)
+		return ts
+	}
+
+	_, err = imClient.JoinGroup(ctx, &pb.JoinGroupRequest{
+		GroupId:    []string{groupId},
+		UserId:     []string{userId},
+		ExpireTime: timestampAfter(-time.Hour),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	expireTime := timestampAfter(time.Hour)
+	_, err = imClient.JoinGroup(ctx, &pb.JoinGroupRequest{
+		GroupId:    []string{groupId},
+		UserId:     []string{userId},
+		ExpireTime: expireTime,
+	})
+	require.NoError(t, err)
+
+	getUserWithGroupResponse, err := imClient.GetUserWithGroup(ctx, &pb.GetUserRequest{UserId: userId})
+	require.NoError(t, err)
+	require.Len(t, getUserWithGroupResponse.User.BindingSet, 1)
+	require.Equal(t, expireTime.Seconds, getUserWithGroupResponse.User.BindingSet[0].ExpireTime.Seconds)
+
+	listExpiringMembershipsResponse, err := imClient.ListExpiringMemberships(ctx, &pb.ListExpiringMembershipsRequest{
+		GroupId:      []string{groupId},
+		ExpireBefore: timestampAfter(2 * time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), listExpiringMembershipsResponse.Total)
+	require.Equal(t, userId, listExpiringMembershipsResponse.BindingSet[0].UserId)
+
+	// extending can only push the expire time out
+	_, err = imClient.ExtendMembership(ctx, &pb.ExtendMembershipRequest{
+		GroupId:    []string{groupId},
+		UserId:     []string{userId},
+		ExpireTime: timestampAfter(30 * time.Minute),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	extendMembershipResponse, err := imClient.ExtendMembership(ctx, &pb.ExtendMembershipRequest{
+		GroupId:    []string{groupId},
+		UserId:     []string{userId},
+		ExpireTime: timestampAfter(3 * time.Hour),
+	})
+	require.NoError(t, err)
+	require.Len(t, extendMembershipResponse.BindingSet, 1)
+
+	listExpiringMembershipsResponse, err = imClient.ListExpiringMemberships(ctx, &pb.ListExpiringMembershipsRequest{
+		GroupId:      []string{groupId},
+		ExpireBefore: timestampAfter(2 * time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), listExpiringMembershipsResponse.Total)
+
+	listMembershipRemovalsResponse, err := imClient.ListMembershipRemovals(ctx, &pb.ListMembershipRemovalsRequest{
+		GroupId: []string{groupId},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), listMembershipRemovalsResponse.Total)
+
+	// an expired binding grants no membership before it is swept
+	expireBinding(t, userId, groupId)
+	isMemberResponse, err := imClient.IsMember(ctx, &pb.IsMemberRequest{
+		UserId:     userId,
+		GroupId:    groupId,
+		Transitive: true,
+	})
+	require.NoError(t, err)
+	require.False(t, isMemberResponse.IsMember)
+	getUserWithGroupResponse, err = imClient.GetUserWithGroup(ctx, &pb.GetUserRequest{UserId: userId})
+	require.NoError(t, err)
+	require.Empty(t, getUserWithGroupResponse.User.GroupSet)
+	require.Empty(t, getUserWithGroupResponse.User.BindingSet)
+	_, err = imClient.ExtendMembership(ctx, &pb.ExtendMembershipRequest{
+		GroupId:    []string{groupId},
+		UserId:     []string{userId},
+		ExpireTime: timestampAfter(3 * time.Hour),
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// joining again replaces the expired binding
+	_, err = imClient.JoinGroup(ctx, &pb.JoinGroupRequest{
+		GroupId: []string{groupId},
+		UserId:  []string{userId},
+	})
+	require.NoError(t, err)
+	isMemberResponse, err = imClient.IsMember(ctx, &pb.IsMemberRequest{
+		UserId:  userId,
+		GroupId: groupId,
+	})
+	require.NoError(t, err)
+	require.True(t, isMemberResponse.IsMember)
+	listMembershipRemovalsResponse, err = imClient.ListMembershipRemovals(ctx, &pb.ListMembershipRemovalsRequest{
+		GroupId: []string{groupId},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), listMembershipRemovalsResponse.Total)
+	require.Equal(t, constants.RemoveReasonExpired, listMembershipRemovalsResponse.RemovalSet[0].Reason)
+
+	// clean up
+	_, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{
+		GroupId: []string{groupId},
+		Cascade: true,
+	})
+	require.NoError(t, err)
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{UserId: []string{userId}})
+	require.NoError(t, err)
+}
+
+func TestSweepExpiredMemberships(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	createGroupResponse, err := imClient.CreateGroup(ctx, &pb.CreateGroupRequest{
+		GroupName: "test_sweep",
+	})
+	require.NoError(t, err)
+	groupId := createGroupResponse.GroupId
+
+	var userIds []string
+	for _, username := range []string{"test_sweep_expired", "test_sweep_extended"} {
+		createUserResponse, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{
+			Username: username,
+		})
+		require.NoError(t, err)
+		userIds = append(userIds, createUserResponse.UserId)
+	}
+	expiredUserId, extendedUserId := userIds[0], userIds[1]
+
+	expireTime, err := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	_, err = imClient.JoinGroup(ctx, &pb.JoinGroupRequest{
+		GroupId:    []string{groupId},
+		UserId:     userIds,
+		ExpireTime: expireTime,
+	})
+	require.NoError(t, err)
+
+	// both expire, then one is extended before the sweep
+	expireBinding(t, expiredUserId, groupId)
+	expireBinding(t, extendedUserId, groupId)
+	err = global.Global().Database.Table(constants.TableUserGroupBinding).
+		Where(constants.ColumnUserId+" = ?", extendedUserId).
+		Where(constants.ColumnGroupId+" = ?", groupId).
+		UpdateColumn(constants.ColumnExpireTime, time.Now().Add(time.Hour)).Error
+	require.NoError(t, err)
+
+	require.NoError(t, resource.SweepExpiredMemberships(ctx))
+
+	var boundUserIds []string
+	err = global.Global().Database.Table(constants.TableUserGroupBinding).
+		Where(constants.ColumnGroupId+" = ?", groupId).
+		Pluck(constants.ColumnUserId, &boundUserIds).Error
+	require.NoError(t, err)
+	require.Equal(t, []string{extendedUserId}, boundUserIds)
+
+	listMembershipRemovalsResponse, err := imClient.ListMembershipRemovals(ctx, &pb.ListMembershipRemovalsRequest{
+		GroupId: []string{groupId},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), listMembershipRemovalsResponse.Total)
+	require.Equal(t, expiredUserId, listMembershipRemovalsResponse.RemovalSet[0].UserId)
+	require.Equal(t, constants.RemoveReasonExpired, listMembershipRemovalsResponse.RemovalSet[0].Reason)
+
+	// clean up
+	_, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{
+		GroupId: []string{groupId},
+		Cascade: true,
+	})
+	require.NoError(t, err)
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{UserId: userIds})
+	require.NoError(t, err)
+}
+
+// expireBinding moves the expire time of the binding into the past,
+// which can not be done through the api.
+func expireBinding(t *testing.T, userId, groupId string) {
+	err := global.Global().Database.Table(constants.TableUserGroupBinding).
+		Where(constants.ColumnUserId+" = ?", userId).
+		Where(constants.ColumnGroupId+" = ?", groupId).
+		UpdateColumn(constants.ColumnExpireTime, time.Now().Add(-time.Minute)).Error
+	require.NoError(t, err)
+}
+
+func TestDisableInactiveUsers(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+	cfg := &global.Global().Config.Inactivity
+	cfg.Threshold = 30 * 24 * time.Hour
+	cfg.WarnBefore = 24 * time.Hour
+
+	createGroupResponse, err := imClient.CreateGroup(ctx, &pb.CreateGroupRequest{
+		GroupName:        "test_inactive_exempt",
+		InactivityExempt: true,
+	})
+	require.NoError(t, err)
+	groupId := createGroupResponse.GroupId
+
+	var userIds []string
+	for _, username := range []string{"test_inactive", "test_inactive_exempt"} {
+		createUserResponse, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{
+			Username: username,
+			Email:    username + "@op.com",
+		})
+		require.NoError(t, err)
+		userIds = append(userIds, createUserResponse.UserId)
+	}
+	inactiveUserId, exemptUserId := userIds[0], userIds[1]
+	_, err = imClient.JoinGroup(ctx, &pb.JoinGroupRequest{
+		GroupId: []string{groupId},
+		UserId:  []string{exemptUserId},
+	})
+	require.NoError(t, err)
+
+	// both users last logged in twice the threshold ago
+	inactiveTime := time.Now().Add(-2 * cfg.Threshold)
+	err = global.Global().Database.Table(constants.TableUser).
+		Where(constants.ColumnUserId+" in (?)", userIds).
+		UpdateColumns(map[string]interface{}{
+			constants.ColumnLastLoginTime: inactiveTime,
+			constants.ColumnStatusTime:    inactiveTime,
+		}).Error
+	require.NoError(t, err)
+
+	// mails left by earlier runs are not counted
+	since := time.Now().Add(-time.Second)
+	countWarnings := func(email string) int {
+		var count int
+		err := global.Global().Database.Table(constants.TableNotification).
+			Where(constants.ColumnRecipient+" = ?", email).
+			Where(constants.ColumnCreateTime+" > ?", since).
+			Count(&count).Error
+		require.NoError(t, err)
+		return count
+	}
+	getStatus := func(userId string) string {
+		getUserResponse, err := imClient.GetUser(ctx, &pb.GetUserRequest{UserId: userId})
+		require.NoError(t, err)
+		return getUserResponse.User.Status
+	}
+
+	// the user is warned once, and not disabled before the warning is due
+	for i := 0; i < 2; i++ {
+		require.NoError(t, resource.DisableInactiveUsers(ctx))
+		require.Equal(t, 1, countWarnings("test_inactive@op.com"))
+		require.Equal(t, constants.StatusActive, getStatus(inactiveUserId))
+	}
+
+	// the warning was sent longer than WarnBefore ago
+	err = global.Global().Database.Table(constants.TableUser).
+		Where(constants.ColumnUserId+" = ?", inactiveUserId).
+		UpdateColumn(constants.ColumnInactivityWarnTime, time.Now().Add(-2*cfg.WarnBefore)).Error
+	require.NoError(t, err)
+	require.NoError(t, resource.DisableInactiveUsers(ctx))
+	require.Equal(t, constants.StatusDisabled, getStatus(inactiveUserId))
+	require.Equal(t, 1, countWarnings("test_inactive@op.com"))
+
+	// members of the exempt group are skipped
+	require.Equal(t, constants.StatusActive, getStatus(exemptUserId))
+	require.Equal(t, 0, countWarnings("test_inactive_exempt@op.com"))
+
+	// clean up
+	_, err = imClient.DeleteGroups(ctx, &pb.DeleteGroupsRequest{
+		GroupId: []string{groupId},
+		Cascade: true,
+	})
+	require.NoError(t, err)
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{UserId: userIds})
+	require.NoError(t, err)
+}
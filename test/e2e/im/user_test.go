@@ -18,18 +18,29 @@ package im
 
 import (
 	"context"
+	"strings"
 	"testing"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"kubesphere.io/im/pkg/constants"
+	"kubesphere.io/im/pkg/gerr"
+	"kubesphere.io/im/pkg/global"
 	"kubesphere.io/im/pkg/pb"
+	"kubesphere.io/im/pkg/util/phoneutil"
 )
 
 func isUserEqual(t *testing.T, oldUser, newUser *pb.User, status string) bool {
 	require.Equal(t, oldUser.Username, newUser.Username)
 	require.Equal(t, oldUser.Email, newUser.Email)
-	require.Equal(t, oldUser.PhoneNumber, newUser.PhoneNumber)
+	phoneNumber, err := phoneutil.NormalizeE164(oldUser.PhoneNumber, global.Global().Config.PhoneDefaultCountryCode)
+	require.NoError(t, err)
+	require.Equal(t, phoneNumber, newUser.PhoneNumber)
 	require.Equal(t, oldUser.Description, newUser.Description)
 	require.Equal(t, oldUser.Extra["age"], newUser.Extra["age"])
 	require.Equal(t, status, newUser.Status)
@@ -96,6 +107,15 @@ func TestUser(t *testing.T) {
 	require.EqualValues(t, listUsersResponse.Total, 1)
 	isUserEqual(t, user, listUsersResponse.UserSet[0], constants.StatusActive)
 
+	// list user, use phone number in national format
+	listUsersResponse, err = imClient.ListUsers(ctx, &pb.ListUsersRequest{
+		PhoneNumber: []string{user.PhoneNumber},
+		Status:      []string{constants.StatusActive},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, listUsersResponse.Total, 1)
+	isUserEqual(t, user, listUsersResponse.UserSet[0], constants.StatusActive)
+
 	// list user, use user id
 	listUsersResponse, err = imClient.ListUsers(ctx, &pb.ListUsersRequest{
 		UserId: []string{user.UserId},
@@ -142,3 +162,640 @@ func TestUser(t *testing.T) {
 	isUserEqual(t, user, listUsersResponse.UserSet[0], constants.StatusDeleted)
 
 }
+
+func TestEmailVerification(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	createUserResponse, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username:    "test_verify",
+		Email:       "test_verify@op.com",
+		Description: "for test",
+		Password:    "passw0rd",
+	})
+	require.NoError(t, err)
+	userId := createUserResponse.UserId
+
+	// send and confirm verification
+	sendResponse, err := imClient.SendEmailVerification(ctx, &pb.SendEmailVerificationRequest{
+		UserId: userId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, userId, sendResponse.UserId)
+	token := getMailToken(t, "test_verify@op.com")
+
+	_, err = imClient.ConfirmEmailVerification(ctx, &pb.ConfirmEmailVerificationRequest{
+		Token: token,
+	})
+	require.NoError(t, err)
+
+	// token is single-use
+	_, err = imClient.ConfirmEmailVerification(ctx, &pb.ConfirmEmailVerificationRequest{
+		Token: token,
+	})
+	require.Error(t, err)
+
+	getUserResponse, err := imClient.GetUser(ctx, &pb.GetUserRequest{
+		UserId: userId,
+	})
+	require.NoError(t, err)
+	require.True(t, getUserResponse.User.EmailVerified)
+
+	listUsersResponse, err := imClient.ListUsers(ctx, &pb.ListUsersRequest{
+		UserId:        []string{userId},
+		EmailVerified: &wrappers.BoolValue{Value: true},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, listUsersResponse.Total, 1)
+
+	// changing email resets verification
+	_, err = imClient.ModifyUser(ctx, &pb.ModifyUserRequest{
+		UserId: userId,
+		Email:  "test_verify_new@op.com",
+	})
+	require.NoError(t, err)
+
+	getUserResponse, err = imClient.GetUser(ctx, &pb.GetUserRequest{
+		UserId: userId,
+	})
+	require.NoError(t, err)
+	require.False(t, getUserResponse.User.EmailVerified)
+
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{
+		UserId: []string{userId},
+	})
+	require.NoError(t, err)
+}
+
+func TestPhoneVerification(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	// national number is normalized with the default country code
+	createUserResponse, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username:    "test_phone",
+		Email:       "test_phone@op.com",
+		PhoneNumber: "138-0000-0000",
+		Description: "for test",
+		Password:    "passw0rd",
+	})
+	require.NoError(t, err)
+	userId := createUserResponse.UserId
+
+	getUserResponse, err := imClient.GetUser(ctx, &pb.GetUserRequest{
+		UserId: userId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "+8613800000000", getUserResponse.User.PhoneNumber)
+	require.False(t, getUserResponse.User.PhoneVerified)
+
+	_, err = imClient.ModifyUser(ctx, &pb.ModifyUserRequest{
+		UserId:      userId,
+		PhoneNumber: "not a phone",
+	})
+	require.Error(t, err)
+
+	sendResponse, err := imClient.SendPhoneCode(ctx, &pb.SendPhoneCodeRequest{
+		UserId: userId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "+8613800000000", sendResponse.PhoneNumber)
+
+	// codes are rate limited
+	_, err = imClient.SendPhoneCode(ctx, &pb.SendPhoneCodeRequest{
+		UserId: userId,
+	})
+	require.Error(t, err)
+
+	for i := 0; i < global.Global().Config.PhoneCodeMaxAttempts; i++ {
+		_, err = imClient.VerifyPhoneCode(ctx, &pb.VerifyPhoneCodeRequest{
+			UserId: userId,
+			Code:   "not-a-code",
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+	// the attempts are used up
+	_, err = imClient.VerifyPhoneCode(ctx, &pb.VerifyPhoneCodeRequest{
+		UserId: userId,
+		Code:   "not-a-code",
+	})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{
+		UserId: []string{userId},
+	})
+	require.NoError(t, err)
+}
+
+func TestUserErrorDetails(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	_, err := imClient.GetUser(ctx, &pb.GetUserRequest{
+		UserId: "uid-not-exists",
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Equal(t, gerr.ReasonNotFound, gerr.GetErrorInfo(err).Reason)
+
+	createUserResponse, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username: "test_error",
+		Email:    "test_error@op.com",
+		Password: "passw0rd",
+	})
+	require.NoError(t, err)
+
+	_, err = imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username: "test_error",
+		Email:    "test_error_new@op.com",
+		Password: "passw0rd",
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	info := gerr.GetErrorInfo(err)
+	require.Equal(t, gerr.ReasonAlreadyExists, info.Reason)
+	require.Equal(t, constants.ColumnUsername, info.Metadata[gerr.MetadataField])
+
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{
+		UserId: []string{createUserResponse.UserId},
+	})
+	require.NoError(t, err)
+}
+
+func TestUserRequestValidation(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	_, err := imClient.GetUser(ctx, &pb.GetUserRequest{
+		UserId: "uid/../x",
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	info := gerr.GetErrorInfo(err)
+	require.Equal(t, gerr.ReasonInvalidArgument, info.Reason)
+	require.Equal(t, constants.ColumnUserId, info.Metadata[gerr.MetadataField])
+
+	_, err = imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username: "test_validation",
+		Email:    "not an email",
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, constants.ColumnEmail, gerr.GetErrorInfo(err).Metadata[gerr.MetadataField])
+
+	_, err = imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username: strings.Repeat("x", 51),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// the limits count characters, not bytes
+	require.NoError(t, (&pb.CreateUserRequest{Username: strings.Repeat("用", 50)}).Validate())
+	require.Error(t, (&pb.CreateUserRequest{Username: strings.Repeat("用", 51)}).Validate())
+
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUserAttribute(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	var attributeIds []string
+	for _, req := range []*pb.CreateAttributeRequest{
+		{Target: constants.TableUser, Name: "e2e_level", Type: constants.AttributeTypeInt, Unique: true},
+		{Target: constants.TableUser, Name: "e2e_tags", Type: constants.AttributeTypeStringList},
+	} {
+		createAttributeResponse, err := imClient.CreateAttribute(ctx, req)
+		require.NoError(t, err)
+		attributeIds = append(attributeIds, createAttributeResponse.AttributeId)
+	}
+
+	_, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username: "test_attribute_bad",
+		Extra:    map[string]string{"e2e_level": "high"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	createUserResponse, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username: "test_attribute",
+		Extra:    map[string]string{"e2e_level": "3", "e2e_tags": `["a","b"]`, "note": "kept"},
+	})
+	require.NoError(t, err)
+	userId := createUserResponse.UserId
+
+	_, err = imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username: "test_attribute_dup",
+		Extra:    map[string]string{"e2e_level": "3"},
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	getUserResponse, err := imClient.GetUser(ctx, &pb.GetUserRequest{UserId: userId})
+	require.NoError(t, err)
+	require.Equal(t, "3", getUserResponse.User.Extra["e2e_level"])
+	require.Equal(t, `["a","b"]`, getUserResponse.User.Extra["e2e_tags"])
+	require.Equal(t, "kept", getUserResponse.User.Extra["note"])
+
+	listUsersResponse, err := imClient.ListUsers(ctx, &pb.ListUsersRequest{
+		AttributeFilter: []*pb.AttributeFilter{
+			{Name: "e2e_level", Op: constants.FilterOpGte, Value: "2"},
+			{Name: "e2e_tags", Op: constants.FilterOpContains, Value: "b"},
+		},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, listUsersResponse.Total)
+	require.Equal(t, userId, listUsersResponse.UserSet[0].UserId)
+
+	listUsersResponse, err = imClient.ListUsers(ctx, &pb.ListUsersRequest{
+		AttributeFilter: []*pb.AttributeFilter{
+			{Name: "e2e_level", Op: constants.FilterOpLt, Value: "3"},
+		},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, listUsersResponse.Total)
+
+	// clean up
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{
+		UserId: []string{userId},
+	})
+	require.NoError(t, err)
+	_, err = imClient.DeleteAttributes(ctx, &pb.DeleteAttributesRequest{
+		AttributeId: attributeIds,
+	})
+	require.NoError(t, err)
+}
+
+func TestModifyUserWithMask(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	createUserResponse, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username:    "test_mask",
+		PhoneNumber: "+8612222222222",
+		Description: "description",
+		Extra:       map[string]string{"a": "1", "b": "2"},
+	})
+	require.NoError(t, err)
+	userId := createUserResponse.UserId
+
+	// clear description and phone number
+	_, err = imClient.ModifyUser(ctx, &pb.ModifyUserRequest{
+		UserId:     userId,
+		UpdateMask: &field_mask.FieldMask{Paths: []string{constants.ColumnDescription, constants.ColumnPhoneNumber}},
+	})
+	require.NoError(t, err)
+
+	// set a and delete b, keep the others
+	_, err = imClient.ModifyUser(ctx, &pb.ModifyUserRequest{
+		UserId:     userId,
+		Extra:      map[string]string{"a": "3"},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"extra.a", "extra.b"}},
+	})
+	require.NoError(t, err)
+	_, err = imClient.ModifyUser(ctx, &pb.ModifyUserRequest{
+		UserId:     userId,
+		Extra:      map[string]string{"c": "4"},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"extra.c"}},
+	})
+	require.NoError(t, err)
+
+	getUserResponse, err := imClient.GetUser(ctx, &pb.GetUserRequest{UserId: userId})
+	require.NoError(t, err)
+	require.Equal(t, "test_mask", getUserResponse.User.Username)
+	require.Empty(t, getUserResponse.User.Description)
+	require.Empty(t, getUserResponse.User.PhoneNumber)
+	require.Equal(t, map[string]string{"a": "3", "c": "4"}, getUserResponse.User.Extra)
+
+	_, err = imClient.ModifyUser(ctx, &pb.ModifyUserRequest{
+		UserId:     userId,
+		UpdateMask: &field_mask.FieldMask{Paths: []string{constants.ColumnPassword}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// clean up
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{
+		UserId: []string{userId},
+	})
+	require.NoError(t, err)
+}
+
+func TestUserProfile(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	_, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username: "test_profile",
+		Timezone: "Mars/Olympus",
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	createUserResponse, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username:    "test_profile",
+		DisplayName: "Test Profile",
+		GivenName:   "Test",
+		FamilyName:  "Profile",
+		Locale:      "en_US",
+		Timezone:    "Asia/Shanghai",
+	})
+	require.NoError(t, err)
+	userId := createUserResponse.UserId
+
+	_, err = imClient.ModifyUser(ctx, &pb.ModifyUserRequest{
+		UserId:     userId,
+		Locale:     "zh-CN",
+		UpdateMask: &field_mask.FieldMask{Paths: []string{constants.ColumnLocale, constants.ColumnTimezone}},
+	})
+	require.NoError(t, err)
+
+	getUserResponse, err := imClient.GetUser(ctx, &pb.GetUserRequest{UserId: userId})
+	require.NoError(t, err)
+	require.Equal(t, "Test Profile", getUserResponse.User.DisplayName)
+	require.Equal(t, "Test", getUserResponse.User.GivenName)
+	require.Equal(t, "Profile", getUserResponse.User.FamilyName)
+	require.Equal(t, "zh-CN", getUserResponse.User.Locale)
+	require.Empty(t, getUserResponse.User.Timezone)
+	require.Nil(t, getUserResponse.User.AvatarUpdateTime)
+
+	_, err = imClient.GetAvatar(ctx, &pb.GetAvatarRequest{UserId: userId})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = imClient.UploadAvatar(ctx, &pb.UploadAvatarRequest{
+		UserId:  userId,
+		Content: []byte("not an image"),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// 1x1 transparent png
+	avatar := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x01\x00\x00\x00\x01\x08\x06\x00\x00\x00\x1f\x15\xc4\x89" +
+		"\x00\x00\x00\rIDATx\x9cc\xf8\x0f\x00\x00\x01\x01\x00\x05\x18\xd8N\x00\x00\x00\x00IEND\xaeB`\x82")
+	uploadAvatarResponse, err := imClient.UploadAvatar(ctx, &pb.UploadAvatarRequest{
+		UserId:  userId,
+		Content: avatar,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "image/png", uploadAvatarResponse.ContentType)
+
+	getAvatarResponse, err := imClient.GetAvatar(ctx, &pb.GetAvatarRequest{UserId: userId})
+	require.NoError(t, err)
+	require.Equal(t, avatar, getAvatarResponse.Content)
+	require.Equal(t, "image/png", getAvatarResponse.ContentType)
+
+	// the avatar is deleted with the user
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{
+		UserId: []string{userId},
+	})
+	require.NoError(t, err)
+	_, err = imClient.GetAvatar(ctx, &pb.GetAvatarRequest{UserId: userId})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestLoginHistory(t *testing.T) {
+	prepare(t)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-forwarded-for", "192.168.1.1")
+
+	createUserResponse, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username: "test_login",
+		Password: "passw0rd",
+	})
+	require.NoError(t, err)
+	userId := createUserResponse.UserId
+
+	comparePasswordResponse, err := imClient.ComparePassword(ctx, &pb.ComparePasswordRequest{
+		UserId:   userId,
+		Password: "wrong",
+	})
+	require.NoError(t, err)
+	require.False(t, comparePasswordResponse.Ok)
+
+	getUserResponse, err := imClient.GetUser(ctx, &pb.GetUserRequest{UserId: userId})
+	require.NoError(t, err)
+	require.Nil(t, getUserResponse.User.LastLoginTime)
+
+	comparePasswordResponse, err = imClient.ComparePassword(ctx, &pb.ComparePasswordRequest{
+		UserId:   userId,
+		Password: "passw0rd",
+	})
+	require.NoError(t, err)
+	require.True(t, comparePasswordResponse.Ok)
+
+	getUserResponse, err = imClient.GetUser(ctx, &pb.GetUserRequest{UserId: userId})
+	require.NoError(t, err)
+	require.NotNil(t, getUserResponse.User.LastLoginTime)
+
+	listLoginHistoryResponse, err := imClient.ListLoginHistory(ctx, &pb.ListLoginHistoryRequest{
+		UserId: userId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), listLoginHistoryResponse.Total)
+	// both logins may be recorded in the same second, so their order is not checked
+	var failed *pb.LoginHistory
+	var successes int
+	for _, loginHistory := range listLoginHistoryResponse.LoginHistorySet {
+		if loginHistory.Success {
+			successes++
+		} else {
+			failed = loginHistory
+		}
+		// the x-forwarded-for of an untrusted peer is ignored
+		require.NotEmpty(t, loginHistory.ClientIp)
+		require.NotEqual(t, "192.168.1.1", loginHistory.ClientIp)
+	}
+	require.Equal(t, 1, successes)
+	require.NotNil(t, failed)
+
+	// the failed login is the earliest
+	listLoginHistoryResponse, err = imClient.ListLoginHistory(ctx, &pb.ListLoginHistoryRequest{
+		UserId:  userId,
+		EndTime: failed.CreateTime,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), listLoginHistoryResponse.Total)
+
+	// clean up
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{
+		UserId: []string{userId},
+	})
+	require.NoError(t, err)
+}
+
+func TestDisableUser(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	createUserResponse, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username: "test_disable",
+		Password: "passw0rd",
+	})
+	require.NoError(t, err)
+	userId := createUserResponse.UserId
+
+	_, err = imClient.ModifyUser(ctx, &pb.ModifyUserRequest{
+		UserId: userId,
+		Status: constants.StatusDisabled,
+	})
+	require.NoError(t, err)
+
+	getUserResponse, err := imClient.GetUser(ctx, &pb.GetUserRequest{UserId: userId})
+	require.NoError(t, err)
+	require.Equal(t, constants.StatusDisabled, getUserResponse.User.Status)
+
+	comparePasswordResponse, err := imClient.ComparePassword(ctx, &pb.ComparePasswordRequest{
+		UserId:   userId,
+		Password: "passw0rd",
+	})
+	require.NoError(t, err)
+	require.False(t, comparePasswordResponse.Ok)
+
+	_, err = imClient.ModifyUser(ctx, &pb.ModifyUserRequest{
+		UserId: userId,
+		Status: constants.StatusActive,
+	})
+	require.NoError(t, err)
+
+	comparePasswordResponse, err = imClient.ComparePassword(ctx, &pb.ComparePasswordRequest{
+		UserId:   userId,
+		Password: "passw0rd",
+	})
+	require.NoError(t, err)
+	require.True(t, comparePasswordResponse.Ok)
+
+	_, err = imClient.ModifyUser(ctx, &pb.ModifyUserRequest{
+		UserId: userId,
+		Status: constants.StatusDeleted,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// clean up
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{
+		UserId: []string{userId},
+	})
+	require.NoError(t, err)
+
+	_, err = imClient.ModifyUser(ctx, &pb.ModifyUserRequest{
+		UserId: userId,
+		Status: constants.StatusActive,
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestBatchUsers(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	// the second item conflicts with the first, nothing is created
+	batchCreateUsersResponse, err := imClient.BatchCreateUsers(ctx, &pb.BatchCreateUsersRequest{
+		User: []*pb.CreateUserRequest{
+			{Username: "test_batch_1"},
+			{Username: "test_batch_1"},
+		},
+		Atomic: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, batchCreateUsersResponse.ResultSet, 2)
+	require.Equal(t, codes.Aborted.String(), batchCreateUsersResponse.ResultSet[0].Error.Code)
+	require.Equal(t, codes.AlreadyExists.String(), batchCreateUsersResponse.ResultSet[1].Error.Code)
+	require.Empty(t, batchCreateUsersResponse.ResultSet[0].Id)
+
+	batchCreateUsersResponse, err = imClient.BatchCreateUsers(ctx, &pb.BatchCreateUsersRequest{
+		User: []*pb.CreateUserRequest{
+			{Username: "test_batch_1"},
+			{Username: "test_batch_1"},
+			{Username: "test_batch_2", Email: "test_batch_2@op.com"},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, batchCreateUsersResponse.ResultSet, 3)
+	require.Nil(t, batchCreateUsersResponse.ResultSet[0].Error)
+	require.Equal(t, codes.AlreadyExists.String(), batchCreateUsersResponse.ResultSet[1].Error.Code)
+	require.Equal(t, constants.ColumnUsername, batchCreateUsersResponse.ResultSet[1].Error.Metadata[gerr.MetadataField])
+	require.Nil(t, batchCreateUsersResponse.ResultSet[2].Error)
+	userIds := []string{batchCreateUsersResponse.ResultSet[0].Id, batchCreateUsersResponse.ResultSet[2].Id}
+
+	getUserResponse, err := imClient.GetUser(ctx, &pb.GetUserRequest{UserId: userIds[1]})
+	require.NoError(t, err)
+	require.Equal(t, "test_batch_2@op.com", getUserResponse.User.Email)
+
+	batchModifyUsersResponse, err := imClient.BatchModifyUsers(ctx, &pb.BatchModifyUsersRequest{
+		User: []*pb.ModifyUserRequest{
+			{UserId: userIds[0], Description: "batch"},
+			{UserId: "uid-not-exists", Description: "batch"},
+		},
+		Atomic: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, codes.Aborted.String(), batchModifyUsersResponse.ResultSet[0].Error.Code)
+	require.Equal(t, codes.NotFound.String(), batchModifyUsersResponse.ResultSet[1].Error.Code)
+
+	batchModifyUsersResponse, err = imClient.BatchModifyUsers(ctx, &pb.BatchModifyUsersRequest{
+		User: []*pb.ModifyUserRequest{
+			{UserId: userIds[0], Description: "batch"},
+			{UserId: userIds[1], Description: "batch"},
+		},
+		Atomic: true,
+	})
+	require.NoError(t, err)
+	for i, result := range batchModifyUsersResponse.ResultSet {
+		require.Nil(t, result.Error)
+		require.Equal(t, userIds[i], result.Id)
+	}
+
+	getUserResponse, err = imClient.GetUser(ctx, &pb.GetUserRequest{UserId: userIds[0]})
+	require.NoError(t, err)
+	require.Equal(t, "batch", getUserResponse.User.Description)
+
+	// clean up
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{
+		UserId: userIds,
+	})
+	require.NoError(t, err)
+}
+
+func TestUserPrivacy(t *testing.T) {
+	prepare(t)
+
+	ctx := context.Background()
+
+	createUserResponse, err := imClient.CreateUser(ctx, &pb.CreateUserRequest{
+		Username:    "test_privacy",
+		Email:       "privacy@example.com",
+		Password:    "password",
+		DisplayName: "Test Privacy",
+		Extra:       map[string]string{"age": "18"},
+	})
+	require.NoError(t, err)
+	userId := createUserResponse.UserId
+
+	exportUserDataResponse, err := imClient.ExportUserData(ctx, &pb.ExportUserDataRequest{UserId: userId})
+	require.NoError(t, err)
+	require.Contains(t, exportUserDataResponse.Data, "test_privacy")
+	require.Contains(t, exportUserDataResponse.Data, "privacy@example.com")
+
+	_, err = imClient.AnonymizeUser(ctx, &pb.AnonymizeUserRequest{UserId: userId})
+	require.NoError(t, err)
+
+	getUserResponse, err := imClient.GetUser(ctx, &pb.GetUserRequest{UserId: userId})
+	require.NoError(t, err)
+	require.Equal(t, userId, getUserResponse.User.Username)
+	require.Empty(t, getUserResponse.User.Email)
+	require.Empty(t, getUserResponse.User.DisplayName)
+	require.Empty(t, getUserResponse.User.Extra)
+	require.NotNil(t, getUserResponse.User.AnonymizeTime)
+
+	exportUserDataResponse, err = imClient.ExportUserData(ctx, &pb.ExportUserDataRequest{UserId: userId})
+	require.NoError(t, err)
+	require.NotContains(t, exportUserDataResponse.Data, "privacy@example.com")
+
+	_, err = imClient.AnonymizeUser(ctx, &pb.AnonymizeUserRequest{UserId: "usr-not-exist"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// clean up
+	_, err = imClient.DeleteUsers(ctx, &pb.DeleteUsersRequest{
+		UserId: []string{userId},
+	})
+	require.NoError(t, err)
+}